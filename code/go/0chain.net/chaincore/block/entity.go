@@ -926,7 +926,7 @@ func (b *Block) ComputeState(ctx context.Context, c Chainer, waitC ...chan struc
 
 	beginStateRoot := bState.GetRoot()
 	b.Events = []event.Event{}
-	for _, txn := range b.Txns {
+	for i, txn := range b.Txns {
 		if datastore.IsEmpty(txn.ClientID) {
 			if err := txn.ComputeClientID(); err != nil {
 				return err
@@ -939,7 +939,7 @@ func (b *Block) ComputeState(ctx context.Context, c Chainer, waitC ...chan struc
 			Type:        event.TypeStats,
 			Tag:         event.TagAddTransactions,
 			Index:       txn.Hash,
-			Data:        transactionNodeToEventTransaction(txn, b.Hash, b.Round),
+			Data:        transactionNodeToEventTransaction(txn, b.Hash, b.Round, i),
 		})
 
 		b.Events = append(b.Events, event.Event{
@@ -1044,15 +1044,17 @@ func (b *Block) ComputeState(ctx context.Context, c Chainer, waitC ...chan struc
 	return nil
 }
 
-func transactionNodeToEventTransaction(tr *transaction.Transaction, blockHash string, round int64) event.Transaction {
+func transactionNodeToEventTransaction(tr *transaction.Transaction, blockHash string, round int64, index int) event.Transaction {
 	return event.Transaction{
 		Hash:              tr.Hash,
 		Round:             round,
+		Index:             index,
 		BlockHash:         blockHash,
 		Version:           tr.Version,
 		ClientId:          tr.ClientID,
 		ToClientId:        tr.ToClientID,
 		TransactionData:   tr.TransactionData,
+		FunctionName:      transactionFunctionName(tr),
 		Value:             tr.Value,
 		Signature:         tr.Signature,
 		CreationDate:      int64(tr.CreationDate.Duration()),
@@ -1065,6 +1067,22 @@ func transactionNodeToEventTransaction(tr *transaction.Transaction, blockHash st
 	}
 }
 
+// transactionFunctionName returns the name of the smart contract function
+// called by the transaction, it's empty for other transactions
+func transactionFunctionName(tr *transaction.Transaction) string {
+	if tr.TransactionType != transaction.TxnTypeSmartContract {
+		return ""
+	}
+	if tr.SmartContractData != nil {
+		return tr.FunctionName
+	}
+	var scData transaction.SmartContractData
+	if err := json.Unmarshal([]byte(tr.TransactionData), &scData); err != nil {
+		return ""
+	}
+	return scData.FunctionName
+}
+
 // ApplyBlockStateChange apply and merge the state changes
 func (b *Block) ApplyBlockStateChange(bsc *StateChange, c Chainer) error {
 	b.stateMutex.Lock()
@@ -122,6 +122,18 @@ func (c *ConfigImpl) IsVestingEnabled() bool {
 
 	return c.conf.IsVestingEnabled
 }
+func (c *ConfigImpl) IsPaymentEnabled() bool {
+	c.guard.RLock()
+	defer c.guard.RUnlock()
+
+	return c.conf.IsPaymentEnabled
+}
+func (c *ConfigImpl) IsHTLCEnabled() bool {
+	c.guard.RLock()
+	defer c.guard.RUnlock()
+
+	return c.conf.IsHTLCEnabled
+}
 func (c *ConfigImpl) IsZcnEnabled() bool {
 	c.guard.RLock()
 	defer c.guard.RUnlock()
@@ -408,6 +420,8 @@ type ConfigData struct {
 	IsFeeEnabled          bool          `json:"miner"` // Indicates is fees enabled
 	IsMultisigEnabled     bool          `json:"multisig"`
 	IsVestingEnabled      bool          `json:"vesting"`
+	IsPaymentEnabled      bool          `json:"payment"`
+	IsHTLCEnabled         bool          `json:"htlc"`
 	IsZcnEnabled          bool          `json:"zcn"`
 	OwnerID               datastore.Key `json:"owner_id"`                  // Client who created this chain
 	BlockSize             int32         `json:"block_size"`                // Number of transactions in a block
@@ -482,6 +496,8 @@ func (c *ConfigImpl) FromViper() error {
 	conf.IsFeeEnabled = viper.GetBool("server_chain.smart_contract.miner")
 	conf.IsMultisigEnabled = viper.GetBool("server_chain.smart_contract.multisig")
 	conf.IsVestingEnabled = viper.GetBool("server_chain.smart_contract.vesting")
+	conf.IsPaymentEnabled = viper.GetBool("server_chain.smart_contract.payment")
+	conf.IsHTLCEnabled = viper.GetBool("server_chain.smart_contract.htlc")
 	conf.IsZcnEnabled = viper.GetBool("server_chain.smart_contract.zcn")
 	conf.BlockSize = viper.GetInt32("server_chain.block.max_block_size")
 	conf.MinBlockSize = viper.GetInt32("server_chain.block.min_block_size")
@@ -644,6 +660,14 @@ func (c *ConfigImpl) Update(fields map[string]string, version int64) error {
 	if err != nil {
 		return err
 	}
+	conf.IsPaymentEnabled, err = cf.GetBool(config2.Payment)
+	if err != nil {
+		return err
+	}
+	conf.IsHTLCEnabled, err = cf.GetBool(config2.HTLC)
+	if err != nil {
+		return err
+	}
 	conf.IsZcnEnabled, err = cf.GetBool(config2.Zcn)
 	if err != nil {
 		return err
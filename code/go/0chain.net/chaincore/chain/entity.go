@@ -19,6 +19,8 @@ import (
 
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/htlcsc"
+	"0chain.net/smartcontract/paymentsc"
 	"0chain.net/smartcontract/storagesc"
 	"0chain.net/smartcontract/vestingsc"
 	"0chain.net/smartcontract/zcnsc"
@@ -621,6 +623,18 @@ func (c *Chain) setupInitialState(initStates *state.InitStates, gb *block.Block)
 		panic(err)
 	}
 
+	err = paymentsc.InitConfig(stateCtx)
+	if err != nil {
+		logging.Logger.Error("chain.stateDB paymentsc InitConfig failed", zap.Error(err))
+		panic(err)
+	}
+
+	err = htlcsc.InitConfig(stateCtx)
+	if err != nil {
+		logging.Logger.Error("chain.stateDB htlcsc InitConfig failed", zap.Error(err))
+		panic(err)
+	}
+
 	err = zcnsc.InitConfig(stateCtx)
 	if err != nil {
 		logging.Logger.Error("chain.stateDB zcnsc InitConfig failed", zap.Error(err))
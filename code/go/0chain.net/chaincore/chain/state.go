@@ -585,6 +585,8 @@ func (c *Chain) updateState(ctx context.Context, b *block.Block, bState util.Mer
 		c.emitUserEvent(sctx, e)
 	}
 
+	c.emitTransactionTransfersEvent(sctx, b.Round, txn)
+
 	// commit transaction
 	if err = bState.MergeMPTChanges(clientState); err != nil {
 		if state.DebugTxn() {
@@ -951,6 +953,58 @@ func (c *Chain) emitUserEvent(sc bcstate.StateContextI, usr *event.User) {
 	return
 }
 
+// emitTransactionTransfersEvent records the transfers and the mints made by
+// the transaction for the transaction history
+func (c *Chain) emitTransactionTransfersEvent(sc bcstate.StateContextI, round int64,
+	txn *transaction.Transaction) {
+	if c.GetEventDb() == nil {
+		return
+	}
+
+	var (
+		moved     = sc.GetTransfers()
+		signed    = sc.GetSignedTransfers()
+		mints     = sc.GetMints()
+		transfers = make([]event.TransactionTransfer, 0,
+			len(moved)+len(signed)+len(mints))
+	)
+	for _, t := range moved {
+		transfers = append(transfers, event.TransactionTransfer{
+			TxnHash:      txn.Hash,
+			Round:        round,
+			Kind:         event.TransferKind,
+			FromClientID: t.ClientID,
+			ToClientID:   t.ToClientID,
+			Amount:       t.Amount,
+		})
+	}
+	for _, t := range signed {
+		transfers = append(transfers, event.TransactionTransfer{
+			TxnHash:      txn.Hash,
+			Round:        round,
+			Kind:         event.TransferKind,
+			FromClientID: t.ClientID,
+			ToClientID:   t.ToClientID,
+			Amount:       t.Amount,
+		})
+	}
+	for _, m := range mints {
+		transfers = append(transfers, event.TransactionTransfer{
+			TxnHash:      txn.Hash,
+			Round:        round,
+			Kind:         event.MintKind,
+			FromClientID: m.Minter,
+			ToClientID:   m.ToClientID,
+			Amount:       m.Amount,
+		})
+	}
+	if len(transfers) == 0 {
+		return
+	}
+
+	sc.EmitEvent(event.TypeStats, event.TagAddTransactionTransfers, txn.Hash, transfers)
+}
+
 func (c *Chain) emitUniqueAddressEvent(sc bcstate.StateContextI, s *state.State) {
 	if c.GetEventDb() == nil {
 		return
@@ -122,6 +122,12 @@ type StateContextI interface {
 	GetMissingNodeKeys() []util.Key
 }
 
+// CallStateContextI is implemented by state contexts able to execute calls
+// a smart contract makes to other smart contracts.
+type CallStateContextI interface {
+	WithTransaction(t *transaction.Transaction) StateContextI
+}
+
 // StateContext - a context object used to manipulate global state
 type StateContext struct {
 	block           *block.Block
@@ -210,13 +216,19 @@ func (sc *StateContext) GetTransaction() *transaction.Transaction {
 
 // AddTransfer - add the transfer
 func (sc *StateContext) AddTransfer(t *state.Transfer) error {
+	return sc.addTransfer(sc.txn, t)
+}
+
+// addTransfer adds the transfer made by the given transaction, which is the
+// transaction of the context or a call made by it
+func (sc *StateContext) addTransfer(txn *transaction.Transaction, t *state.Transfer) error {
 	sc.mutex.Lock()
 	defer sc.mutex.Unlock()
 	if !encryption.IsHash(t.ToClientID) {
 		return errors.New("invalid transaction ToClientID")
 	}
 
-	if t.ClientID != sc.txn.ClientID && t.ClientID != sc.txn.ToClientID {
+	if t.ClientID != txn.ClientID && t.ClientID != txn.ToClientID {
 		return state.ErrInvalidTransfer
 	}
 	sc.transfers = append(sc.transfers, t)
@@ -227,7 +239,7 @@ func (sc *StateContext) AddTransfer(t *state.Transfer) error {
 				TxHash:      sc.txn.Hash,
 				Type:        event.TypeStats,
 				Tag:         event.TagBurn,
-				Index:       sc.txn.ClientID,
+				Index:       txn.ClientID,
 				Data: state.Burn{
 					Burner: t.ClientID,
 					Amount: t.Amount,
@@ -243,7 +255,7 @@ func (sc *StateContext) AddTransfer(t *state.Transfer) error {
 				TxHash:      sc.txn.Hash,
 				Type:        event.TypeStats,
 				Tag:         event.TagAddMint,
-				Index:       sc.txn.ClientID,
+				Index:       txn.ClientID,
 				Data: state.Mint{
 					Minter:     t.ClientID,
 					ToClientID: t.ToClientID,
@@ -264,9 +276,13 @@ func (sc *StateContext) AddSignedTransfer(st *state.SignedTransfer) {
 
 // AddMint - add the mint
 func (sc *StateContext) AddMint(m *state.Mint) error {
+	return sc.addMint(sc.txn, m)
+}
+
+func (sc *StateContext) addMint(txn *transaction.Transaction, m *state.Mint) error {
 	sc.mutex.Lock()
 	defer sc.mutex.Unlock()
-	if !sc.isApprovedMinter(m) {
+	if !isApprovedMinter(txn, m) {
 		return state.ErrInvalidMint
 	}
 	sc.mints = append(sc.mints, m)
@@ -283,15 +299,40 @@ func (sc *StateContext) AddMint(m *state.Mint) error {
 	return nil
 }
 
-func (sc *StateContext) isApprovedMinter(m *state.Mint) bool {
+func isApprovedMinter(txn *transaction.Transaction, m *state.Mint) bool {
 	for _, minter := range approvedMinters {
-		if m.Minter == minter && sc.txn.ToClientID == minter {
+		if m.Minter == minter && txn.ToClientID == minter {
 			return true
 		}
 	}
 	return false
 }
 
+// WithTransaction returns the state context of a call to a smart contract
+// made by the smart contract executing the transaction of this context. The
+// call shares the state, transfers, mints and events of this context, but
+// transfers and mints are checked against the transaction of the call.
+func (sc *StateContext) WithTransaction(t *transaction.Transaction) StateContextI {
+	return &callStateContext{StateContext: sc, txn: t}
+}
+
+type callStateContext struct {
+	*StateContext
+	txn *transaction.Transaction
+}
+
+func (cc *callStateContext) GetTransaction() *transaction.Transaction {
+	return cc.txn
+}
+
+func (cc *callStateContext) AddTransfer(t *state.Transfer) error {
+	return cc.addTransfer(cc.txn, t)
+}
+
+func (cc *callStateContext) AddMint(m *state.Mint) error {
+	return cc.addMint(cc.txn, m)
+}
+
 // GetTransfers - get all the transfers
 func (sc *StateContext) GetTransfers() []*state.Transfer {
 	return sc.transfers
@@ -8,6 +8,11 @@ import (
 	"github.com/0chain/common/core/logging"
 	"github.com/0chain/common/core/util"
 	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/encryption"
 )
 
 func init() {
@@ -187,3 +192,25 @@ func TestWrongGetItemFunc(t *testing.T) {
 	//}, nil)
 	//require.NoError(t, err)
 }
+
+func TestStateContext_WithTransaction(t *testing.T) {
+	var (
+		client = encryption.Hash("client")
+		caller = encryption.Hash("caller sc")
+		callee = encryption.Hash("callee sc")
+		wallet = encryption.Hash("wallet")
+	)
+	txn := &transaction.Transaction{ClientID: client, ToClientID: caller}
+	sc := NewStateContext(&block.Block{}, nil, txn, nil, nil, nil, nil, nil, nil)
+
+	call := sc.WithTransaction(&transaction.Transaction{ClientID: wallet, ToClientID: callee})
+	require.Equal(t, callee, call.GetTransaction().ToClientID)
+
+	require.NoError(t, call.AddTransfer(state.NewTransfer(callee, wallet, 1)))
+	require.ErrorIs(t, call.AddTransfer(state.NewTransfer(caller, wallet, 1)), state.ErrInvalidTransfer)
+	require.ErrorIs(t, sc.AddTransfer(state.NewTransfer(callee, wallet, 1)), state.ErrInvalidTransfer)
+
+	// the call shares the transfers with the calling context
+	require.Len(t, sc.GetTransfers(), 1)
+	require.Equal(t, callee, sc.GetTransfers()[0].ClientID)
+}
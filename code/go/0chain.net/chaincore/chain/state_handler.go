@@ -18,7 +18,10 @@ import (
 	"0chain.net/core/common"
 	"0chain.net/core/encryption"
 	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/htlcsc"
 	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/multisigsc"
+	"0chain.net/smartcontract/paymentsc"
 	"0chain.net/smartcontract/rest"
 	"0chain.net/smartcontract/storagesc"
 	"0chain.net/smartcontract/vestingsc"
@@ -50,8 +53,11 @@ func SetupScRestApiHandlers() {
 	if c.EventDb != nil {
 		faucetsc.SetupRestHandler(restHandler)
 		minersc.SetupRestHandler(restHandler)
+		multisigsc.SetupRestHandler(restHandler)
 		storagesc.SetupRestHandler(restHandler)
 		vestingsc.SetupRestHandler(restHandler)
+		paymentsc.SetupRestHandler(restHandler)
+		htlcsc.SetupRestHandler(restHandler)
 		zcnsc.SetupRestHandler(restHandler)
 
 	} else {
@@ -238,8 +244,14 @@ func GetFunctionNames(address string) []string {
 		endpoints = minersc.GetEndpoints(nil)
 	case faucetsc.ADDRESS:
 		endpoints = faucetsc.GetEndpoints(nil)
+	case multisigsc.Address:
+		endpoints = multisigsc.GetEndpoints(nil)
 	case vestingsc.ADDRESS:
 		endpoints = vestingsc.GetEndpoints(nil)
+	case paymentsc.ADDRESS:
+		endpoints = paymentsc.GetEndpoints(nil)
+	case htlcsc.ADDRESS:
+		endpoints = htlcsc.GetEndpoints(nil)
 	case zcnsc.ADDRESS:
 		endpoints = zcnsc.GetEndpoints(nil)
 	default:
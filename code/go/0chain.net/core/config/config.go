@@ -146,6 +146,8 @@ type ChainConfig interface {
 	IsFeeEnabled() bool
 	IsMultisigEnabled() bool
 	IsVestingEnabled() bool
+	IsPaymentEnabled() bool
+	IsHTLCEnabled() bool
 	IsZcnEnabled() bool
 	OwnerID() string
 	MinBlockSize() int32
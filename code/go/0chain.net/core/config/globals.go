@@ -14,6 +14,8 @@ const (
 	Multisig                          // todo from development
 	Vesting                           // todo from development
 	Zcn
+	Payment
+	HTLC
 
 	Owner // do we want to set this.
 
@@ -148,6 +150,8 @@ func initGlobalSettingNames() {
 	GlobalSettingName[Multisig] = "server_chain.smart_contract.multisig"
 	GlobalSettingName[Vesting] = "server_chain.smart_contract.vesting"
 	GlobalSettingName[Zcn] = "server_chain.smart_contract.zcn"
+	GlobalSettingName[Payment] = "server_chain.smart_contract.payment"
+	GlobalSettingName[HTLC] = "server_chain.smart_contract.htlc"
 
 	GlobalSettingName[Owner] = "server_chain.owner"
 
@@ -276,6 +280,8 @@ func initGlobalSettings() {
 		GlobalSettingName[Multisig]:     {Boolean, true},
 		GlobalSettingName[Vesting]:      {Boolean, true},
 		GlobalSettingName[Zcn]:          {Boolean, false},
+		GlobalSettingName[Payment]:      {Boolean, true},
+		GlobalSettingName[HTLC]:         {Boolean, true},
 
 		GlobalSettingName[Owner]: {String, false},
 
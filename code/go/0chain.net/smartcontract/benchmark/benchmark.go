@@ -30,7 +30,12 @@ const (
 	FaucetRest
 	Vesting
 	VestingRest
+	Payment
+	PaymentRest
+	HTLC
+	HTLCRest
 	MultiSig
+	MultiSigRest
 	ZCNSCBridge
 	ZCNSCBridgeRest
 	Control
@@ -50,7 +55,12 @@ var (
 		"faucet_rest",
 		"vesting",
 		"vesting_rest",
+		"payment",
+		"payment_rest",
+		"htlc",
+		"htlc_rest",
 		"multi_sig",
+		"multi_sig_rest",
 		"zcnscbridge",
 		"zcnscbridge_rest",
 		"control",
@@ -68,7 +78,12 @@ var (
 		SourceNames[FaucetRest]:              FaucetRest,
 		SourceNames[Vesting]:                 Vesting,
 		SourceNames[VestingRest]:             VestingRest,
+		SourceNames[Payment]:                 Payment,
+		SourceNames[PaymentRest]:             PaymentRest,
+		SourceNames[HTLC]:                    HTLC,
+		SourceNames[HTLCRest]:                HTLCRest,
 		SourceNames[MultiSig]:                MultiSig,
+		SourceNames[MultiSigRest]:            MultiSigRest,
 		SourceNames[ZCNSCBridge]:             ZCNSCBridge,
 		SourceNames[ZCNSCBridgeRest]:         ZCNSCBridgeRest,
 		SourceNames[Control]:                 Control,
@@ -123,6 +138,8 @@ const (
 	StorageSc     = "storagesc."
 	FaucetSc      = "faucetsc."
 	VestingSc     = "vestingsc."
+	PaymentSc     = "paymentsc."
+	HTLCSc        = "htlcsc."
 	ZcnSc         = "zcnsc."
 	DbsEvents     = "dbs.Events."
 	DbSettings    = "dbs.settings."
@@ -219,6 +236,17 @@ const (
 	VestingMaxDuration          = SmartContract + VestingSc + "max_duration"
 	VestingMaxDescriptionLength = SmartContract + VestingSc + "max_description_length"
 
+	PaymentOwner                = SmartContract + PaymentSc + "owner_id"
+	PaymentMinLock              = SmartContract + PaymentSc + "min_lock"
+	PaymentMinInterval          = SmartContract + PaymentSc + "min_interval"
+	PaymentMaxPayments          = SmartContract + PaymentSc + "max_payments"
+	PaymentMaxDescriptionLength = SmartContract + PaymentSc + "max_description_length"
+
+	HTLCOwner       = SmartContract + HTLCSc + "owner_id"
+	HTLCMinLock     = SmartContract + HTLCSc + "min_lock"
+	HTLCMinTimeLock = SmartContract + HTLCSc + "min_time_lock"
+	HTLCMaxTimeLock = SmartContract + HTLCSc + "max_time_lock"
+
 	FaucetOwner = SmartContract + FaucetSc + "owner_id"
 
 	ZcnOwner              = SmartContract + ZcnSc + "owner_id"
@@ -21,7 +21,9 @@ import (
 	"0chain.net/core/datastore"
 	"0chain.net/smartcontract/benchmark/main/cmd/control"
 	ebk "0chain.net/smartcontract/dbs/benchmark"
+	"0chain.net/smartcontract/htlcsc"
 	"0chain.net/smartcontract/multisigsc"
+	"0chain.net/smartcontract/paymentsc"
 	"0chain.net/smartcontract/vestingsc"
 
 	"0chain.net/smartcontract/dbs/event"
@@ -249,6 +251,8 @@ func setUpMpt(
 	mustAddMockSCBalances(balances, storagesc.ADDRESS, initSCTokens)
 	mustAddMockSCBalances(balances, minersc.ADDRESS, initSCTokens)
 	mustAddMockSCBalances(balances, zcnsc.ADDRESS, initSCTokens)
+	mustAddMockSCBalances(balances, paymentsc.ADDRESS, initSCTokens)
+	mustAddMockSCBalances(balances, htlcsc.ADDRESS, initSCTokens)
 
 	log.Println("created balances\t", time.Since(timer))
 
@@ -309,7 +313,7 @@ func setUpMpt(
 	go func() {
 		defer wg.Done()
 		timer := time.Now()
-		miners, _ = minersc.AddMockMiners(clients, eventDb, balances, getMockIdKeyPair)
+		miners, _ = minersc.AddMockMiners(clients, publicKeys, eventDb, balances, getMockIdKeyPair)
 		log.Println("added miners\t", time.Since(timer))
 	}()
 
@@ -444,6 +448,8 @@ func setUpMpt(
 		defer wg.Done()
 		timer := time.Now()
 		faucetsc.AddMockGlobalNode(balances)
+		faucetsc.AddMockClientLists(clients, balances)
+		faucetsc.AddMockGovernanceProposals(clients, miners, eventDb, balances)
 		log.Println("added faucet global node\t", time.Since(timer))
 	}()
 	wg.Add(1)
@@ -458,7 +464,8 @@ func setUpMpt(
 	go func() {
 		defer wg.Done()
 		timer := time.Now()
-		multisigsc.AddMockWallets(clients, publicKeys, balances)
+		multisigsc.AddMockWallets(clients, publicKeys, eventDb, balances)
+		multisigsc.AddMockProposals(clients, eventDb)
 		log.Println("added client wallets\t", time.Since(timer))
 	}()
 	wg.Add(1)
@@ -466,6 +473,7 @@ func setUpMpt(
 		defer wg.Done()
 		timer := time.Now()
 		vestingsc.AddMockClientPools(clients, balances)
+		vestingsc.AddMockPoolTransfers(clients, eventDb)
 		log.Println("added vesting client pools\t", time.Since(timer))
 	}()
 	wg.Add(1)
@@ -474,8 +482,27 @@ func setUpMpt(
 		timer := time.Now()
 		vestingsc.AddMockVestingPools(clients, balances)
 		vestingsc.AddMockConfig(balances)
+		vestingsc.AddMockGovernanceProposals(clients, miners, eventDb, balances)
 		log.Println("added vesting pools\t", time.Since(timer))
 	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		timer := time.Now()
+		paymentsc.AddMockSchedules(clients, eventDb, balances)
+		paymentsc.AddMockConfig(balances)
+		paymentsc.AddMockGovernanceProposals(clients, miners, eventDb, balances)
+		log.Println("added payment schedules\t", time.Since(timer))
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		timer := time.Now()
+		htlcsc.AddMockHTLCs(clients, eventDb, balances)
+		htlcsc.AddMockConfig(balances)
+		htlcsc.AddMockGovernanceProposals(clients, miners, eventDb, balances)
+		log.Println("added htlcs\t", time.Since(timer))
+	}()
 
 	wg.Add(1)
 	go func() {
@@ -10,8 +10,10 @@ import (
 
 	"0chain.net/smartcontract/benchmark/main/cmd/control"
 	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/htlcsc"
 	"0chain.net/smartcontract/minersc"
 	"0chain.net/smartcontract/multisigsc"
+	"0chain.net/smartcontract/paymentsc"
 	"0chain.net/smartcontract/storagesc"
 	"0chain.net/smartcontract/vestingsc"
 	"0chain.net/smartcontract/zcnsc"
@@ -33,7 +35,12 @@ var benchmarkSources = map[bk.Source]func(data bk.BenchData, sigScheme bk.Signat
 	bk.FaucetRest:      faucetsc.BenchmarkRestTests,
 	bk.Vesting:         vestingsc.BenchmarkTests,
 	bk.VestingRest:     vestingsc.BenchmarkRestTests,
+	bk.Payment:         paymentsc.BenchmarkTests,
+	bk.PaymentRest:     paymentsc.BenchmarkRestTests,
+	bk.HTLC:            htlcsc.BenchmarkTests,
+	bk.HTLCRest:        htlcsc.BenchmarkRestTests,
 	bk.MultiSig:        multisigsc.BenchmarkTests,
+	bk.MultiSigRest:    multisigsc.BenchmarkRestTests,
 	bk.ZCNSCBridge:     zcnsc.BenchmarkTests,
 	bk.ZCNSCBridgeRest: zcnsc.BenchmarkRestTests,
 	bk.Control:         control.BenchmarkTests,
@@ -22,7 +22,10 @@ import (
 	"0chain.net/smartcontract/benchmark/main/cmd/log"
 	ebk "0chain.net/smartcontract/dbs/benchmark"
 	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/htlcsc"
 	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/multisigsc"
+	"0chain.net/smartcontract/paymentsc"
 	"0chain.net/smartcontract/rest"
 	"0chain.net/smartcontract/storagesc"
 	"0chain.net/smartcontract/vestingsc"
@@ -82,8 +85,11 @@ func runSuites(
 	}
 	faucetsc.SetupRestHandler(restSetup)
 	minersc.SetupRestHandler(restSetup)
+	multisigsc.SetupRestHandler(restSetup)
 	storagesc.SetupRestHandler(restSetup)
 	vestingsc.SetupRestHandler(restSetup)
+	paymentsc.SetupRestHandler(restSetup)
+	htlcsc.SetupRestHandler(restSetup)
 	zcnsc.SetupRestHandler(restSetup)
 
 	var eventMap = make(map[string][]event.Event)
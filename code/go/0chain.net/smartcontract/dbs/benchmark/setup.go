@@ -71,10 +71,12 @@ func AddMockTransactions(
 					Hash:              GetMockTransactionHash(blockNumber, i),
 					BlockHash:         GetMockBlockHash(blockNumber),
 					Round:             blockNumber,
+					Index:             i,
 					Version:           "mock version",
 					ClientId:          clients[i%len(clients)],
 					ToClientId:        clients[int(blockNumber)%len(clients)],
 					TransactionData:   "mock transaction data",
+					FunctionName:      "mock function",
 					Signature:         "mock signature",
 					CreationDate:      int64(common.Now()),
 					Fee:               100,
@@ -93,6 +95,18 @@ func AddMockTransactions(
 				if err != nil {
 					panic(err)
 				}
+				transfer := event.TransactionTransfer{
+					TxnHash:      transaction.Hash,
+					Round:        blockNumber,
+					Kind:         event.TransferKind,
+					FromClientID: transaction.ClientId,
+					ToClientID:   transaction.ToClientId,
+					Amount:       transaction.Value,
+				}
+				err = eventDb.Store.Get().Create(&transfer).Error
+				if err != nil {
+					panic(err)
+				}
 			}
 		}
 	}
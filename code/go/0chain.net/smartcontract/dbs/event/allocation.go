@@ -47,6 +47,13 @@ type Allocation struct {
 	ThirdPartyExtendable     bool          `json:"third_party_extendable"`
 	FileOptions              uint16        `json:"file_options"`
 	MinLockDemand            float64       `json:"min_lock_demand"`
+	// AutoRenewDuration is zero if the allocation isn't renewed automatically.
+	AutoRenewDuration    int64 `json:"auto_renew_duration"`
+	AutoRenewMaxRenewals int   `json:"auto_renew_max_renewals"`
+	AutoRenewals         int   `json:"auto_renewals"`
+	// AssuranceTier weights the challenges of the allocation blobbers.
+	AssuranceTier  int     `json:"assurance_tier"`
+	AssurancePrice float64 `json:"assurance_price"`
 
 	//ref
 	User  User                    `gorm:"foreignKey:Owner;references:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
@@ -143,6 +150,11 @@ func (edb *EventDb) updateAllocations(allocs []Allocation) error {
 		"third_party_extendable",
 		"file_options",
 		"min_lock_demand",
+		"auto_renew_duration",
+		"auto_renew_max_renewals",
+		"auto_renewals",
+		"assurance_tier",
+		"assurance_price",
 	}
 
 	columns, err := Columnize(allocs)
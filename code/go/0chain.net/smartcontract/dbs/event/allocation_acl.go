@@ -0,0 +1,59 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"gorm.io/gorm/clause"
+)
+
+// AllocationACL is a per-client grant of file operations on an allocation.
+// swagger:model AllocationACL
+type AllocationACL struct {
+	model.UpdatableModel
+	AllocationID string `json:"allocation_id" gorm:"uniqueIndex:idx_alloc_acl_client"`
+	ClientID     string `json:"client_id" gorm:"uniqueIndex:idx_alloc_acl_client;index:idx_alloc_acl_client_id"`
+	// Ops is a bitmask of allowed operations, the same as the allocation FileOptions.
+	Ops uint16 `json:"ops"`
+	// ExpiresAt is the timestamp after which the grant is not valid, 0 means never.
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+func (edb *EventDb) GetAllocationACL(allocationID, clientID string, limit common.Pagination) ([]AllocationACL, error) {
+	var acl []AllocationACL
+	query := edb.Store.Get().Model(&AllocationACL{}).Where("allocation_id = ?", allocationID)
+	if clientID != "" {
+		query = query.Where("client_id = ?", clientID)
+	}
+	err := query.Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "client_id"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&acl).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving acl for allocation: %v, error: %v", allocationID, err)
+	}
+	return acl, nil
+}
+
+func (edb *EventDb) addOrOverwriteAllocationACL(acl []AllocationACL) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "allocation_id"}, {Name: "client_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ops", "expires_at", "updated_at"}),
+	}).Create(&acl).Error
+}
+
+func (edb *EventDb) deleteAllocationACL(acl []AllocationACL) error {
+	for _, a := range acl {
+		err := edb.Store.Get().
+			Where("allocation_id = ? AND client_id = ?", a.AllocationID, a.ClientID).
+			Delete(&AllocationACL{}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"gorm.io/gorm/clause"
+)
+
+// AllocationOwnershipTransfer is a completed change of an allocation owner,
+// blobbers use it to switch to the new owner public key.
+// swagger:model AllocationOwnershipTransfer
+type AllocationOwnershipTransfer struct {
+	model.UpdatableModel
+	AllocationID   string `json:"allocation_id" gorm:"index"`
+	TxnHash        string `json:"txn_hash"`
+	Round          int64  `json:"round"`
+	FromClientID   string `json:"from_client_id" gorm:"index"`
+	ToClientID     string `json:"to_client_id" gorm:"index"`
+	OwnerPublicKey string `json:"owner_public_key"` // of the new owner
+}
+
+func (edb *EventDb) GetAllocationOwnershipTransfers(allocationID string, limit common.Pagination) ([]AllocationOwnershipTransfer, error) {
+	var transfers []AllocationOwnershipTransfer
+	err := edb.Store.Get().Model(&AllocationOwnershipTransfer{}).
+		Where("allocation_id = ?", allocationID).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "id"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving ownership transfers of allocation: %v, error: %v", allocationID, err)
+	}
+	return transfers, nil
+}
+
+func (edb *EventDb) addAllocationOwnershipTransfers(transfers []AllocationOwnershipTransfer) error {
+	return edb.Store.Get().Create(&transfers).Error
+}
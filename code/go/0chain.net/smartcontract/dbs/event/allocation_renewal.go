@@ -0,0 +1,49 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// AllocationRenewal is an automatic renewal of an allocation by its
+// auto-renew policy, successful or not.
+// swagger:model AllocationRenewal
+type AllocationRenewal struct {
+	model.UpdatableModel
+	AllocationID  string `json:"allocation_id" gorm:"index"`
+	TxnHash       string `json:"txn_hash"`
+	Round         int64  `json:"round"`
+	Renewal       int    `json:"renewal"` // number of the renewal
+	OldExpiration int64  `json:"old_expiration"`
+	NewExpiration int64  `json:"new_expiration"`
+	// WritePool is the write pool balance after the renewal.
+	WritePool currency.Coin `json:"write_pool"`
+	Status    int           `json:"status"` // renewed or failed
+	// Reason of the failure.
+	Reason string `json:"reason,omitempty"`
+}
+
+func (edb *EventDb) GetAllocationRenewals(allocationID string, limit common.Pagination) ([]AllocationRenewal, error) {
+	var renewals []AllocationRenewal
+	err := edb.Store.Get().Model(&AllocationRenewal{}).
+		Where("allocation_id = ?", allocationID).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "id"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&renewals).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving renewals of allocation: %v, error: %v", allocationID, err)
+	}
+	return renewals, nil
+}
+
+func (edb *EventDb) addAllocationRenewals(renewals []AllocationRenewal) error {
+	return edb.Store.Get().Create(&renewals).Error
+}
@@ -50,6 +50,7 @@ type Blobber struct {
 	ChallengesCompleted uint64        `json:"challenges_completed"`
 	OpenChallenges      uint64        `json:"open_challenges"`
 	RankMetric          float64       `json:"rank_metric"` // currently ChallengesPassed / ChallengesCompleted
+	Reputation          float64       `json:"reputation"`  // computed by the storage SC, from 0 to 1
 	TotalBlockRewards   currency.Coin `json:"total_block_rewards"`
 	TotalStorageIncome  currency.Coin `json:"total_storage_income"`
 	TotalReadIncome     currency.Coin `json:"total_read_income"`
@@ -86,7 +87,18 @@ func (edb *EventDb) GetBlobber(id string) (*Blobber, error) {
 	return &blobber, nil
 }
 
-func (edb *EventDb) GetBlobbers(limit common2.Pagination) ([]Blobber, error) {
+// Columns the blobbers list can be ordered by
+const (
+	BlobberOrderByCapacity   = "capacity"
+	BlobberOrderByReputation = "reputation"
+)
+
+// IsValidBlobberOrderBy reports whether the blobbers can be ordered by the column
+func IsValidBlobberOrderBy(orderBy string) bool {
+	return orderBy == BlobberOrderByCapacity || orderBy == BlobberOrderByReputation
+}
+
+func (edb *EventDb) GetBlobbers(limit common2.Pagination, orderBy string) ([]Blobber, error) {
 	var blobbers []Blobber
 	result := edb.Store.Get().
 		Preload("Rewards").
@@ -94,7 +106,7 @@ func (edb *EventDb) GetBlobbers(limit common2.Pagination) ([]Blobber, error) {
 		Where("is_killed = ? AND is_shutdown = ?", false, false).
 		Limit(limit.Limit).
 		Order(clause.OrderByColumn{
-			Column: clause.Column{Name: "capacity"},
+			Column: clause.Column{Name: orderBy},
 			Desc:   limit.IsDescending,
 		}).
 		Order(clause.OrderByColumn{
@@ -106,7 +118,7 @@ func (edb *EventDb) GetBlobbers(limit common2.Pagination) ([]Blobber, error) {
 	return blobbers, result.Error
 }
 
-func (edb *EventDb) GetActiveBlobbers(limit common2.Pagination, healthCheckTimeLimit time.Duration) ([]Blobber, error) {
+func (edb *EventDb) GetActiveBlobbers(limit common2.Pagination, healthCheckTimeLimit time.Duration, orderBy string) ([]Blobber, error) {
 	now := common.Now()
 	var blobbers []Blobber
 	result := edb.Store.Get().
@@ -116,7 +128,7 @@ func (edb *EventDb) GetActiveBlobbers(limit common2.Pagination, healthCheckTimeL
 			common.ToTime(now).Add(-healthCheckTimeLimit).Unix(), false, false).
 		Limit(limit.Limit).
 		Order(clause.OrderByColumn{
-			Column: clause.Column{Name: "capacity"},
+			Column: clause.Column{Name: orderBy},
 			Desc:   limit.IsDescending,
 		}).
 		Order(clause.OrderByColumn{
@@ -136,7 +148,7 @@ func (edb *EventDb) GetBlobbersByRank(limit common2.Pagination) ([]string, error
 		Where("is_killed = ? AND is_shutdown = ?", false, false).
 		Offset(limit.Offset).Limit(limit.Limit).
 		Order(clause.OrderByColumn{
-			Column: clause.Column{Name: "rank_metric"},
+			Column: clause.Column{Name: "reputation"},
 			Desc:   true,
 		}).
 		Order(clause.OrderByColumn{
@@ -238,6 +250,7 @@ type AllocationQuery struct {
 	AllocationSize     int64
 	AllocationSizeInGB float64
 	NumberOfDataShards int
+	MinReputation      float64
 }
 
 func (edb *EventDb) GetBlobberIdsFromUrls(urls []string, data common2.Pagination) ([]string, error) {
@@ -263,6 +276,9 @@ func (edb *EventDb) GetBlobbersFromParams(allocation AllocationQuery, limit comm
 	dbStore = dbStore.Where("is_killed = false")
 	dbStore = dbStore.Where("is_shutdown = false")
 	dbStore = dbStore.Where("not_available = false")
+	if allocation.MinReputation > 0 {
+		dbStore = dbStore.Where("reputation >= ?", allocation.MinReputation)
+	}
 	dbStore = dbStore.Limit(limit.Limit).
 		Offset(limit.Offset).
 		Order(clause.OrderByColumn{
@@ -415,6 +431,10 @@ func mergeUpdateBlobberChallengesEvents() *eventsMergerImpl[Blobber] {
 	return newEventsMerger[Blobber](TagUpdateBlobberChallenge, withUniqueEventOverwrite())
 }
 
+func mergeUpdateBlobberReputationEvents() *eventsMergerImpl[Blobber] {
+	return newEventsMerger[Blobber](TagUpdateBlobberReputation, withUniqueEventOverwrite())
+}
+
 func mergeAddChallengesToBlobberEvents() *eventsMergerImpl[Blobber] {
 	return newEventsMerger[Blobber](TagUpdateBlobberOpenChallenges, withUniqueEventOverwrite())
 }
@@ -453,6 +473,20 @@ func (edb *EventDb) updateBlobberChallenges(blobbers []Blobber) error {
 		Exec(edb).Error
 }
 
+func (edb *EventDb) updateBlobberReputation(blobbers []Blobber) error {
+	blobberIdList := make([]string, 0, len(blobbers))
+	reputationList := make([]float64, 0, len(blobbers))
+
+	for _, blobber := range blobbers {
+		blobberIdList = append(blobberIdList, blobber.ID)
+		reputationList = append(reputationList, blobber.Reputation)
+	}
+
+	return CreateBuilder("blobbers", "id", blobberIdList).
+		AddUpdate("reputation", reputationList).
+		Exec(edb).Error
+}
+
 func (edb *EventDb) blobberSpecificRevenue(spus []dbs.StakePoolReward) error {
 	var (
 		ids                []string
@@ -28,6 +28,7 @@ type BlobberAggregate struct {
 	InactiveRounds      int64         `json:"InactiveRounds"`
 	RankMetric          float64       `json:"rank_metric"`
 	Downtime            uint64        `json:"downtime"`
+	Reputation          float64       `json:"reputation"`
 }
 
 func (edb *EventDb) CreateBlobberAggregates(blobbers []*Blobber, round int64) error {
@@ -53,6 +54,7 @@ func (edb *EventDb) CreateBlobberAggregates(blobbers []*Blobber, round int64) er
 		aggregate.Downtime = blobber.Downtime
 		aggregate.ChallengesPassed = blobber.ChallengesPassed
 		aggregate.ChallengesCompleted = blobber.ChallengesCompleted
+		aggregate.Reputation = blobber.Reputation
 		if blobber.ChallengesCompleted == 0 {
 			aggregate.RankMetric = 0
 		} else {
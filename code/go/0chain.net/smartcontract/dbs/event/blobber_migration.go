@@ -0,0 +1,63 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// BlobberMigration of the allocation data from a shut down blobber to its
+// replacement.
+// swagger:model BlobberMigration
+type BlobberMigration struct {
+	model.UpdatableModel
+	MigrationID    string        `json:"migration_id" gorm:"uniqueIndex"`
+	AllocationID   string        `json:"allocation_id" gorm:"index"`
+	FromBlobberID  string        `json:"from_blobber_id" gorm:"index"`
+	ToBlobberID    string        `json:"to_blobber_id" gorm:"index"`
+	Size           int64         `json:"size"`
+	UsedSize       int64         `json:"used_size"`
+	AllocationRoot string        `json:"allocation_root"`
+	Created        int64         `json:"created"`
+	Deadline       int64         `json:"deadline"`
+	Status         int           `json:"status"`
+	Penalty        currency.Coin `json:"penalty"`
+}
+
+func (edb *EventDb) GetAllocationBlobberMigrations(allocationID string, limit common.Pagination) ([]BlobberMigration, error) {
+	return edb.getBlobberMigrations(limit, "allocation_id = ?", allocationID)
+}
+
+// GetBlobberMigrations the blobber is migrated from or to
+func (edb *EventDb) GetBlobberMigrations(blobberID string, limit common.Pagination) ([]BlobberMigration, error) {
+	return edb.getBlobberMigrations(limit, "from_blobber_id = ? OR to_blobber_id = ?", blobberID, blobberID)
+}
+
+func (edb *EventDb) getBlobberMigrations(limit common.Pagination, query string, args ...interface{}) ([]BlobberMigration, error) {
+	var migrations []BlobberMigration
+	err := edb.Store.Get().Model(&BlobberMigration{}).
+		Where(query, args...).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&migrations).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving blobber migrations by %v, error: %v", args, err)
+	}
+	return migrations, nil
+}
+
+func (edb *EventDb) addOrOverwriteBlobberMigrations(migrations []BlobberMigration) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "migration_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"status", "penalty", "updated_at",
+		}),
+	}).Create(&migrations).Error
+}
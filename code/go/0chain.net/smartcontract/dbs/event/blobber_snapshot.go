@@ -27,6 +27,7 @@ type BlobberSnapshot struct {
 	OpenChallenges      uint64        `json:"open_challenges"`
 	CreationRound       int64         `json:"creation_round"`
 	RankMetric          float64       `json:"rank_metric"`
+	Reputation          float64       `json:"reputation"`
 	IsKilled            bool          `json:"is_killed"`
 	IsShutdown          bool          `json:"is_shutdown"`
 }
@@ -84,6 +85,7 @@ func createBlobberSnapshotFromBlobber(b *Blobber, round int64) *BlobberSnapshot
 		OpenChallenges:      b.OpenChallenges,
 		CreationRound:       b.CreationRound,
 		RankMetric:          b.RankMetric,
+		Reputation:          b.Reputation,
 		IsKilled:            b.IsKilled,
 		IsShutdown:          b.IsShutdown,
 	}
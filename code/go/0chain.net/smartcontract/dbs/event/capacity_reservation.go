@@ -0,0 +1,63 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// CapacityReservation is a blobber capacity reserved by a client at the
+// blobber terms of the reservation time.
+// swagger:model CapacityReservation
+type CapacityReservation struct {
+	model.UpdatableModel
+	ReservationID string        `json:"reservation_id" gorm:"uniqueIndex"`
+	ClientID      string        `json:"client_id" gorm:"index"`
+	BlobberID     string        `json:"blobber_id" gorm:"index"`
+	Size          int64         `json:"size"`
+	StartTime     int64         `json:"start_time"`
+	EndTime       int64         `json:"end_time"`
+	ReadPrice     currency.Coin `json:"read_price"`
+	WritePrice    currency.Coin `json:"write_price"`
+	Deposit       currency.Coin `json:"deposit"`
+	Status        int           `json:"status"`
+	// AllocationID the reservation is converted into.
+	AllocationID string `json:"allocation_id"`
+}
+
+func (edb *EventDb) GetBlobberCapacityReservations(blobberID string, limit common.Pagination) ([]CapacityReservation, error) {
+	return edb.getCapacityReservations("blobber_id", blobberID, limit)
+}
+
+func (edb *EventDb) GetClientCapacityReservations(clientID string, limit common.Pagination) ([]CapacityReservation, error) {
+	return edb.getCapacityReservations("client_id", clientID, limit)
+}
+
+func (edb *EventDb) getCapacityReservations(column, id string, limit common.Pagination) ([]CapacityReservation, error) {
+	var reservations []CapacityReservation
+	err := edb.Store.Get().Model(&CapacityReservation{}).
+		Where(column+" = ?", id).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "start_time"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&reservations).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving capacity reservations by %s: %v, error: %v", column, id, err)
+	}
+	return reservations, nil
+}
+
+func (edb *EventDb) addOrOverwriteCapacityReservations(reservations []CapacityReservation) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "reservation_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"status", "allocation_id", "updated_at",
+		}),
+	}).Create(&reservations).Error
+}
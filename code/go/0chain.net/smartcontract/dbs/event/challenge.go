@@ -27,7 +27,7 @@ type Challenge struct {
 	model.UpdatableModel
 	ChallengeID    string           `json:"challenge_id" gorm:"index:idx_cchallenge_id,unique"`
 	CreatedAt      common.Timestamp `json:"created_at" gorm:"index:idx_copen_challenge,priority:1"`
-	AllocationID   string           `json:"allocation_id"`
+	AllocationID   string           `json:"allocation_id" gorm:"index"`
 	BlobberID      string           `json:"blobber_id" gorm:"index:idx_copen_challenge,priority:2"`
 	ValidatorsID   string           `json:"validators_id"`
 	Seed           int64            `json:"seed"`
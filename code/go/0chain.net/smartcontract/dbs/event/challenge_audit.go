@@ -0,0 +1,81 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// ChallengeAudit is the result of a responded challenge with the validation
+// tickets it's decided by.
+// swagger:model ChallengeAudit
+type ChallengeAudit struct {
+	model.UpdatableModel
+	ChallengeID  string `json:"challenge_id" gorm:"uniqueIndex"`
+	AllocationID string `json:"allocation_id" gorm:"index"`
+	BlobberID    string `json:"blobber_id"`
+	TxnHash      string `json:"txn_hash"`
+	// Round and BlockHash of the block the response is in.
+	Round     int64  `json:"round"`
+	BlockHash string `json:"block_hash"`
+	Passed    bool   `json:"passed"`
+	// Reward of the blobber for the passed challenge.
+	Reward currency.Coin `json:"reward"`
+	// Penalty is slashed from the blobber stake for the failed challenge.
+	Penalty currency.Coin               `json:"penalty"`
+	Tickets []ChallengeValidationTicket `json:"tickets" gorm:"foreignKey:ChallengeID;references:ChallengeID"`
+}
+
+// ChallengeValidationTicket is signed by the validator, Hash is the signed
+// message.
+// swagger:model ChallengeValidationTicket
+type ChallengeValidationTicket struct {
+	model.UpdatableModel
+	ChallengeID  string `json:"challenge_id" gorm:"index"`
+	ValidatorID  string `json:"validator_id"`
+	ValidatorKey string `json:"validator_key"`
+	Result       bool   `json:"success"`
+	Message      string `json:"message"`
+	MessageCode  string `json:"message_code"`
+	Timestamp    int64  `json:"timestamp"`
+	Hash         string `json:"hash"`
+	Signature    string `json:"signature"`
+	// Rewarded validators share the validators reward of the challenge.
+	Rewarded bool `json:"rewarded"`
+}
+
+func (edb *EventDb) GetAllocationChallenges(allocationID string, limit common.Pagination) ([]Challenge, error) {
+	var challenges []Challenge
+	err := edb.Store.Get().Model(&Challenge{}).
+		Where("allocation_id = ?", allocationID).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "round_created_at"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&challenges).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving challenges of allocation: %v, error: %v", allocationID, err)
+	}
+	return challenges, nil
+}
+
+func (edb *EventDb) GetChallengeAudits(challengeIDs []string) ([]ChallengeAudit, error) {
+	var audits []ChallengeAudit
+	err := edb.Store.Get().Model(&ChallengeAudit{}).
+		Preload("Tickets").
+		Where("challenge_id IN ?", challengeIDs).
+		Find(&audits).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving challenge audits: %v", err)
+	}
+	return audits, nil
+}
+
+func (edb *EventDb) addChallengeAudits(audits []ChallengeAudit) error {
+	return edb.Store.Get().Create(&audits).Error
+}
@@ -29,6 +29,8 @@ type TestConfigData struct {
 	IsFeeEnabled          bool          `json:"miner"` // Indicates is fees enabled
 	IsMultisigEnabled     bool          `json:"multisig"`
 	IsVestingEnabled      bool          `json:"vesting"`
+	IsPaymentEnabled      bool          `json:"payment"`
+	IsHTLCEnabled         bool          `json:"htlc"`
 	IsZcnEnabled          bool          `json:"zcn"`
 	OwnerID               datastore.Key `json:"owner_id"`                  // Client who created this chain
 	BlockSize             int32         `json:"block_size"`                // Number of transactions in a block
@@ -121,6 +123,14 @@ func (t *TestConfig) IsVestingEnabled() bool {
 	return t.conf.IsVestingEnabled
 }
 
+func (t *TestConfig) IsPaymentEnabled() bool {
+	return t.conf.IsPaymentEnabled
+}
+
+func (t *TestConfig) IsHTLCEnabled() bool {
+	return t.conf.IsHTLCEnabled
+}
+
 func (t *TestConfig) IsZcnEnabled() bool {
 	return t.conf.IsZcnEnabled
 }
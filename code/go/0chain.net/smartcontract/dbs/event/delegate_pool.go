@@ -30,6 +30,9 @@ type DelegatePool struct {
 	RoundCreated         int64             `json:"round_created"`
 	RoundPoolLastUpdated int64             `json:"round_pool_last_updated"`
 	StakedAt             common.Timestamp  `json:"staked_at"`
+	ReleaseRound         int64             `json:"release_round"` // of the unbonding pool
+	AutoCompound         bool              `json:"auto_compound"`
+	TotalCompounded      currency.Coin     `json:"total_compounded"` // total reward rolled into the balance
 }
 
 func (edb *EventDb) GetDelegatePools(id string) ([]DelegatePool, error) {
@@ -82,6 +85,25 @@ func (edb *EventDb) GetUserDelegatePools(userId string, pType spenum.Provider, p
 	return dps, nil
 }
 
+// GetUserUnbondingPools of the delegate with the given provider types,
+// ordered by the release round
+func (edb *EventDb) GetUserUnbondingPools(userId string, pTypes []spenum.Provider, pagination common2.Pagination) ([]DelegatePool, error) {
+	var dps []DelegatePool
+	result := edb.Store.Get().
+		Model(&DelegatePool{}).
+		Where("delegate_id = ? AND provider_type IN ? AND status = ?", userId, pTypes, spenum.Unbonding).
+		Offset(pagination.Offset).Limit(pagination.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "release_round"},
+			Desc:   pagination.IsDescending,
+		}).
+		Find(&dps)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting unbonding pools, %v", result.Error)
+	}
+	return dps, nil
+}
+
 func (edb *EventDb) updateDelegatePool(updates dbs.DelegatePoolUpdate) error {
 	var dp = DelegatePool{
 		ProviderID:   updates.ID,
@@ -0,0 +1,54 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// DoubleSignEvidence of a miner signing two different blocks in the same
+// round, the miner stake is slashed for.
+// swagger:model DoubleSignEvidence
+type DoubleSignEvidence struct {
+	model.UpdatableModel
+	MinerID    string `json:"miner_id" gorm:"uniqueIndex:idx_double_sign_evidences_miner_round"`
+	Round      int64  `json:"round" gorm:"uniqueIndex:idx_double_sign_evidences_miner_round"`
+	BlockHash1 string `json:"block_hash_1"`
+	Signature1 string `json:"signature_1"`
+	BlockHash2 string `json:"block_hash_2"`
+	Signature2 string `json:"signature_2"`
+	// Reporter is the client submitted the evidence.
+	Reporter string `json:"reporter"`
+	TxnHash  string `json:"txn_hash"`
+	// Slashed from the miner stake pool.
+	Slashed currency.Coin `json:"slashed"`
+}
+
+// GetDoubleSignEvidences of the miner, or of all the miners if the id is empty
+func (edb *EventDb) GetDoubleSignEvidences(minerID string, limit common.Pagination) ([]DoubleSignEvidence, error) {
+	var evidences []DoubleSignEvidence
+	query := edb.Store.Get().Model(&DoubleSignEvidence{})
+	if minerID != "" {
+		query = query.Where("miner_id = ?", minerID)
+	}
+	err := query.
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "round"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&evidences).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving double sign evidences of miner: %v, error: %v", minerID, err)
+	}
+	return evidences, nil
+}
+
+func (edb *EventDb) addDoubleSignEvidences(evidences []DoubleSignEvidence) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&evidences).Error
+}
@@ -111,6 +111,24 @@ const (
 	TagShutdownProvider
 	TagInsertReadpool
 	TagUpdateReadpool
+	TagAddOrOverwriteAllocationACL
+	TagDeleteAllocationACL
+	TagAddOrOverwritePaymentSchedule
+	TagAddHTLC
+	TagUpdateHTLC
+	TagAddOrOverwriteMultisigProposal
+	TagAddOrOverwriteMultisigWallet
+	TagAddVestingPoolTransfer
+	TagAddTransactionTransfers
+	TagAddAllocationRenewal
+	TagTransferAllocationOwnership
+	TagUpdateBlobberReputation
+	TagAddOrOverwriteCapacityReservation
+	TagAddChallengeAudit
+	TagAddOrOverwriteBlobberMigration
+	TagAddOrOverwriteGovernanceProposal
+	TagAddOrOverwriteGovernanceVote
+	TagAddDoubleSignEvidence
 	NumberOfTags
 )
 
@@ -205,6 +223,24 @@ func initTagString() {
 	TagString[TagShutdownProvider] = "TagShutdownProvider"
 	TagString[TagInsertReadpool] = "TagInsertReadpool"
 	TagString[TagUpdateReadpool] = "TagUpdateReadpool"
+	TagString[TagAddOrOverwriteAllocationACL] = "TagAddOrOverwriteAllocationACL"
+	TagString[TagDeleteAllocationACL] = "TagDeleteAllocationACL"
+	TagString[TagAddOrOverwritePaymentSchedule] = "TagAddOrOverwritePaymentSchedule"
+	TagString[TagAddHTLC] = "TagAddHTLC"
+	TagString[TagUpdateHTLC] = "TagUpdateHTLC"
+	TagString[TagAddOrOverwriteMultisigProposal] = "TagAddOrOverwriteMultisigProposal"
+	TagString[TagAddOrOverwriteMultisigWallet] = "TagAddOrOverwriteMultisigWallet"
+	TagString[TagAddVestingPoolTransfer] = "TagAddVestingPoolTransfer"
+	TagString[TagAddTransactionTransfers] = "TagAddTransactionTransfers"
+	TagString[TagAddAllocationRenewal] = "TagAddAllocationRenewal"
+	TagString[TagTransferAllocationOwnership] = "TagTransferAllocationOwnership"
+	TagString[TagUpdateBlobberReputation] = "TagUpdateBlobberReputation"
+	TagString[TagAddOrOverwriteCapacityReservation] = "TagAddOrOverwriteCapacityReservation"
+	TagString[TagAddChallengeAudit] = "TagAddChallengeAudit"
+	TagString[TagAddOrOverwriteBlobberMigration] = "TagAddOrOverwriteBlobberMigration"
+	TagString[TagAddOrOverwriteGovernanceProposal] = "TagAddOrOverwriteGovernanceProposal"
+	TagString[TagAddOrOverwriteGovernanceVote] = "TagAddOrOverwriteGovernanceVote"
+	TagString[TagAddDoubleSignEvidence] = "TagAddDoubleSignEvidence"
 	TagString[NumberOfTags] = "invalid"
 }
 
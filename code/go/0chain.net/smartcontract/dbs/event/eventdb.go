@@ -196,6 +196,22 @@ func (edb *EventDb) AutoMigrate() error {
 		&RewardDelegate{},
 		&RewardProvider{},
 		&ReadPool{},
+		&AllocationACL{},
+		&PaymentSchedule{},
+		&HTLC{},
+		&MultisigProposal{},
+		&MultisigWallet{},
+		&VestingPoolTransfer{},
+		&TransactionTransfer{},
+		&AllocationRenewal{},
+		&AllocationOwnershipTransfer{},
+		&CapacityReservation{},
+		&ChallengeAudit{},
+		&ChallengeValidationTicket{},
+		&BlobberMigration{},
+		&GovernanceProposal{},
+		&GovernanceVote{},
+		&DoubleSignEvidence{},
 	); err != nil {
 		return err
 	}
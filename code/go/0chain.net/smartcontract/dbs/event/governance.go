@@ -0,0 +1,93 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// GovernanceProposal of the smart contract settings changes.
+// swagger:model GovernanceProposal
+type GovernanceProposal struct {
+	model.UpdatableModel
+	ProposalID    string `json:"proposal_id" gorm:"uniqueIndex"`
+	SmartContract string `json:"smart_contract" gorm:"index"`
+	ProposerID    string `json:"proposer_id"`
+	// Changes of the settings encoded in JSON.
+	Changes      string        `json:"changes"`
+	Created      int64         `json:"created"`
+	VotingEnd    int64         `json:"voting_end"`
+	ExecutableAt int64         `json:"executable_at"`
+	Status       int           `json:"status"`
+	YesWeight    currency.Coin `json:"yes_weight"`
+	NoWeight     currency.Coin `json:"no_weight"`
+
+	Votes []GovernanceVote `json:"votes,omitempty" gorm:"foreignKey:ProposalID;references:ProposalID"`
+}
+
+// GovernanceVote of a staker on a proposal.
+// swagger:model GovernanceVote
+type GovernanceVote struct {
+	model.UpdatableModel
+	ProposalID string        `json:"proposal_id" gorm:"uniqueIndex:idx_governance_votes_proposal_voter"`
+	VoterID    string        `json:"voter_id" gorm:"uniqueIndex:idx_governance_votes_proposal_voter"`
+	Approve    bool          `json:"approve"`
+	Weight     currency.Coin `json:"weight"`
+	Timestamp  int64         `json:"timestamp"`
+}
+
+// GetGovernanceProposals of the smart contract, all of them if the status
+// is negative
+func (edb *EventDb) GetGovernanceProposals(smartContract string, status int, limit common.Pagination) ([]GovernanceProposal, error) {
+	var proposals []GovernanceProposal
+	query := edb.Store.Get().Model(&GovernanceProposal{}).
+		Where("smart_contract = ?", smartContract)
+	if status >= 0 {
+		query = query.Where("status = ?", status)
+	}
+	err := query.
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&proposals).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving governance proposals of %s, error: %v", smartContract, err)
+	}
+	return proposals, nil
+}
+
+func (edb *EventDb) GetGovernanceProposal(proposalID string) (*GovernanceProposal, error) {
+	var proposal GovernanceProposal
+	err := edb.Store.Get().Model(&GovernanceProposal{}).
+		Preload("Votes").
+		Where("proposal_id = ?", proposalID).
+		First(&proposal).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving governance proposal %s, error: %v", proposalID, err)
+	}
+	return &proposal, nil
+}
+
+func (edb *EventDb) addOrOverwriteGovernanceProposals(proposals []GovernanceProposal) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "proposal_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"status", "yes_weight", "no_weight", "updated_at",
+		}),
+	}).Create(&proposals).Error
+}
+
+func (edb *EventDb) addOrOverwriteGovernanceVotes(votes []GovernanceVote) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "proposal_id"}, {Name: "voter_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"approve", "weight", "timestamp", "updated_at",
+		}),
+	}).Create(&votes).Error
+}
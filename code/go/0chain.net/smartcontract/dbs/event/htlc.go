@@ -0,0 +1,74 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// HTLC is a hash and time locked escrow of the htlc SC.
+// swagger:model HTLC
+type HTLC struct {
+	model.UpdatableModel
+	HTLCID    string        `json:"htlc_id" gorm:"uniqueIndex"`
+	HashLock  string        `json:"hash_lock" gorm:"index"`
+	Sender    string        `json:"sender" gorm:"index"`
+	Recipient string        `json:"recipient" gorm:"index"`
+	Amount    currency.Coin `json:"amount"`
+	ExpireAt  int64         `json:"expire_at"`
+	// Preimage is revealed by the claim.
+	Preimage string `json:"preimage"`
+	Status   int    `json:"status"`
+}
+
+func (edb *EventDb) GetHTLCsByHashLock(hashLock string) ([]HTLC, error) {
+	var htlcs []HTLC
+	err := edb.Store.Get().Model(&HTLC{}).
+		Where("hash_lock = ?", hashLock).
+		Order("created_at").
+		Find(&htlcs).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving htlc by hash lock: %v, error: %v", hashLock, err)
+	}
+	return htlcs, nil
+}
+
+// GetParticipantHTLCs returns contracts the client is sender or recipient of.
+func (edb *EventDb) GetParticipantHTLCs(clientID string, limit common.Pagination) ([]HTLC, error) {
+	var htlcs []HTLC
+	err := edb.Store.Get().Model(&HTLC{}).
+		Where("sender = ? OR recipient = ?", clientID, clientID).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "expire_at"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&htlcs).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving htlc for participant: %v, error: %v", clientID, err)
+	}
+	return htlcs, nil
+}
+
+func (edb *EventDb) addHTLCs(htlcs []HTLC) error {
+	return edb.Store.Get().Create(&htlcs).Error
+}
+
+func (edb *EventDb) updateHTLCs(htlcs []HTLC) error {
+	for _, h := range htlcs {
+		err := edb.Store.Get().Model(&HTLC{}).
+			Where("htlc_id = ?", h.HTLCID).
+			Updates(map[string]interface{}{
+				"preimage": h.Preimage,
+				"status":   h.Status,
+			}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"gorm.io/gorm/clause"
+)
+
+// MultisigProposal is a proposal voted by signers of a multi-sig wallet.
+// swagger:model MultisigProposal
+type MultisigProposal struct {
+	model.UpdatableModel
+	WalletID   string `json:"wallet_id" gorm:"uniqueIndex:idx_msig_wallet_proposal"`
+	ProposalID string `json:"proposal_id" gorm:"uniqueIndex:idx_msig_wallet_proposal"`
+	Type       int    `json:"type"`
+	// Data is JSON of the transfer, the wallet update or the smart contract
+	// call, depending on the type.
+	Data  string `json:"data"`
+	Votes int    `json:"votes"`
+	// SignerThresholdIDs is JSON list of threshold ids of the signers voted.
+	SignerThresholdIDs string `json:"signer_threshold_ids"`
+	ExpirationDate     int64  `json:"expiration_date"`
+	Status             int    `json:"status"`
+	ExecutedInTxnHash  string `json:"executed_in_txn_hash"`
+	Result             string `json:"result"`
+}
+
+// GetMultisigProposals returns proposals of the wallet, with the given
+// status if it isn't nil.
+func (edb *EventDb) GetMultisigProposals(walletID string, status *int, limit common.Pagination) ([]MultisigProposal, error) {
+	var proposals []MultisigProposal
+	query := edb.Store.Get().Model(&MultisigProposal{}).
+		Where("wallet_id = ?", walletID)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	err := query.
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "expiration_date"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&proposals).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving proposals for multisig wallet: %v, error: %v", walletID, err)
+	}
+	return proposals, nil
+}
+
+// GetMultisigPendingProposals returns not executed proposals of the wallet
+// which don't expire until the given time.
+func (edb *EventDb) GetMultisigPendingProposals(walletID string, now int64, status int, limit common.Pagination) ([]MultisigProposal, error) {
+	var proposals []MultisigProposal
+	err := edb.Store.Get().Model(&MultisigProposal{}).
+		Where("wallet_id = ? AND status = ? AND expiration_date > ?", walletID, status, now).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "expiration_date"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&proposals).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving pending proposals for multisig wallet: %v, error: %v", walletID, err)
+	}
+	return proposals, nil
+}
+
+func (edb *EventDb) addOrOverwriteMultisigProposals(proposals []MultisigProposal) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "wallet_id"}, {Name: "proposal_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"type", "data", "votes", "signer_threshold_ids", "expiration_date", "status",
+			"executed_in_txn_hash", "result", "updated_at",
+		}),
+	}).Create(&proposals).Error
+}
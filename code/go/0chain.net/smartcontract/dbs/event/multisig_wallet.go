@@ -0,0 +1,41 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/dbs/model"
+	"gorm.io/gorm/clause"
+)
+
+// MultisigWallet is a wallet registered in the multi-sig SC.
+// swagger:model MultisigWallet
+type MultisigWallet struct {
+	model.UpdatableModel
+	WalletID        string `json:"wallet_id" gorm:"uniqueIndex"`
+	SignatureScheme string `json:"signature_scheme"`
+	PublicKey       string `json:"public_key"`
+	// Signers is JSON list of the signers with their threshold ids, public
+	// keys and client ids.
+	Signers     string `json:"signers"`
+	NumRequired int    `json:"num_required"`
+}
+
+func (edb *EventDb) GetMultisigWallet(walletID string) (*MultisigWallet, error) {
+	var w MultisigWallet
+	err := edb.Store.Get().Model(&MultisigWallet{}).
+		Where("wallet_id = ?", walletID).
+		First(&w).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving multisig wallet: %v, error: %v", walletID, err)
+	}
+	return &w, nil
+}
+
+func (edb *EventDb) addOrOverwriteMultisigWallets(wallets []MultisigWallet) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "wallet_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"signature_scheme", "public_key", "signers", "num_required", "updated_at",
+		}),
+	}).Create(&wallets).Error
+}
@@ -0,0 +1,54 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// PaymentSchedule is a recurring payment escrowed in the payment SC.
+// swagger:model PaymentSchedule
+type PaymentSchedule struct {
+	model.UpdatableModel
+	ScheduleID  string        `json:"schedule_id" gorm:"uniqueIndex"`
+	ClientID    string        `json:"client_id" gorm:"index"`
+	Recipient   string        `json:"recipient" gorm:"index"`
+	Description string        `json:"description"`
+	Amount      currency.Coin `json:"amount"`
+	// Interval between two payments, in seconds.
+	Interval  int64         `json:"interval"`
+	StartTime int64         `json:"start_time"`
+	Count     int           `json:"count"`
+	Paid      int           `json:"paid"`
+	Balance   currency.Coin `json:"balance"`
+	Status    int           `json:"status"`
+}
+
+func (edb *EventDb) GetClientPaymentSchedules(clientID string, limit common.Pagination) ([]PaymentSchedule, error) {
+	var schedules []PaymentSchedule
+	err := edb.Store.Get().Model(&PaymentSchedule{}).
+		Where("client_id = ?", clientID).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "start_time"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&schedules).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving payment schedules for client: %v, error: %v", clientID, err)
+	}
+	return schedules, nil
+}
+
+func (edb *EventDb) addOrOverwritePaymentSchedules(schedules []PaymentSchedule) error {
+	return edb.Store.Get().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "schedule_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"count", "paid", "balance", "status", "updated_at",
+		}),
+	}).Create(&schedules).Error
+}
@@ -185,6 +185,7 @@ func mergeEvents(round int64, block string, events []Event) ([]Event, error) {
 			mergeAddChallengePoolsEvents(),
 
 			mergeUpdateBlobberChallengesEvents(),
+			mergeUpdateBlobberReputationEvents(),
 			mergeAddChallengesToBlobberEvents(),
 			mergeUpdateAllocChallengesEvents(),
 
@@ -868,6 +869,12 @@ func (edb *EventDb) addStat(event Event) (err error) {
 		}
 
 		return edb.updateBlobberChallenges(*bs)
+	case TagUpdateBlobberReputation:
+		bs, ok := fromEvent[[]Blobber](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.updateBlobberReputation(*bs)
 	case TagUpdateAllocationChallenge:
 		as, ok := fromEvent[[]Allocation](event.Data)
 		if !ok {
@@ -923,6 +930,108 @@ func (edb *EventDb) addStat(event Event) (err error) {
 			return ErrInvalidEventData
 		}
 		return edb.updateReadPool(*rps)
+	case TagAddOrOverwriteAllocationACL:
+		acl, ok := fromEvent[[]AllocationACL](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addOrOverwriteAllocationACL(*acl)
+	case TagDeleteAllocationACL:
+		acl, ok := fromEvent[[]AllocationACL](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.deleteAllocationACL(*acl)
+	case TagAddOrOverwritePaymentSchedule:
+		schedules, ok := fromEvent[[]PaymentSchedule](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addOrOverwritePaymentSchedules(*schedules)
+	case TagAddHTLC:
+		htlcs, ok := fromEvent[[]HTLC](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addHTLCs(*htlcs)
+	case TagUpdateHTLC:
+		htlcs, ok := fromEvent[[]HTLC](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.updateHTLCs(*htlcs)
+	case TagAddOrOverwriteMultisigProposal:
+		proposals, ok := fromEvent[[]MultisigProposal](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addOrOverwriteMultisigProposals(*proposals)
+	case TagAddOrOverwriteMultisigWallet:
+		wallets, ok := fromEvent[[]MultisigWallet](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addOrOverwriteMultisigWallets(*wallets)
+	case TagAddVestingPoolTransfer:
+		transfers, ok := fromEvent[[]VestingPoolTransfer](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addVestingPoolTransfers(*transfers)
+	case TagAddTransactionTransfers:
+		transfers, ok := fromEvent[[]TransactionTransfer](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addTransactionTransfers(*transfers)
+	case TagAddAllocationRenewal:
+		renewals, ok := fromEvent[[]AllocationRenewal](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addAllocationRenewals(*renewals)
+	case TagTransferAllocationOwnership:
+		transfers, ok := fromEvent[[]AllocationOwnershipTransfer](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addAllocationOwnershipTransfers(*transfers)
+	case TagAddOrOverwriteCapacityReservation:
+		reservations, ok := fromEvent[[]CapacityReservation](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addOrOverwriteCapacityReservations(*reservations)
+	case TagAddChallengeAudit:
+		audits, ok := fromEvent[[]ChallengeAudit](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addChallengeAudits(*audits)
+	case TagAddOrOverwriteBlobberMigration:
+		migrations, ok := fromEvent[[]BlobberMigration](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addOrOverwriteBlobberMigrations(*migrations)
+	case TagAddOrOverwriteGovernanceProposal:
+		proposals, ok := fromEvent[[]GovernanceProposal](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addOrOverwriteGovernanceProposals(*proposals)
+	case TagAddOrOverwriteGovernanceVote:
+		votes, ok := fromEvent[[]GovernanceVote](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addOrOverwriteGovernanceVotes(*votes)
+	case TagAddDoubleSignEvidence:
+		evidences, ok := fromEvent[[]DoubleSignEvidence](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.addDoubleSignEvidences(*evidences)
 	case TagCollectProviderReward:
 		return edb.collectRewards(event.Index)
 	case TagMinerHealthCheck:
@@ -224,6 +224,7 @@ func extractIdsFromEvents(events []Event) (ProviderIdsMap, error) {
 			TagUpdateBlobberTotalStake,
 			TagUpdateBlobberTotalOffers,
 			TagUpdateBlobberChallenge,
+			TagUpdateBlobberReputation,
 			TagUpdateBlobberOpenChallenges,
 			TagUpdateBlobberStat:
 			blobbers, ok := fromEvent[[]Blobber](event.Data)
@@ -18,6 +18,7 @@ type RewardDelegate struct {
 	ProviderID   string        `json:"provider_id"`
 	RewardType   spenum.Reward `json:"reward_type"`
 	AllocationID string        `json:"allocation_id"`
+	Compounded   currency.Coin `json:"compounded"` // part of the amount rolled into the pool balance
 }
 
 func (edb *EventDb) insertDelegateReward(inserts []dbs.StakePoolReward, round int64) error {
@@ -31,6 +32,7 @@ func (edb *EventDb) insertDelegateReward(inserts []dbs.StakePoolReward, round in
 				ProviderID:   sp.ID,
 				RewardType:   sp.RewardType,
 				AllocationID: sp.AllocationID,
+				Compounded:   sp.DelegateCompounded[poolId],
 			}
 			drs = append(drs, dr)
 		}
@@ -18,6 +18,8 @@ type providerRewardsDelegates struct {
 	rewards       map[string]currency.Coin
 	totalRewards  map[string]currency.Coin
 	delegatePools map[string]map[string]currency.Coin
+	// rewards of the delegate pools rolled into their balances
+	compounded map[string]map[string]currency.Coin
 }
 
 type providerPenaltiesDelegates struct {
@@ -29,6 +31,7 @@ func aggregateProviderRewards(spus []dbs.StakePoolReward) (*providerRewardsDeleg
 		rewardsMap      = make(map[string]currency.Coin)
 		totalRewardsMap = make(map[string]currency.Coin)
 		dpRewardsMap    = make(map[string]map[string]currency.Coin)
+		compoundedMap   = make(map[string]map[string]currency.Coin)
 	)
 	for i, sp := range spus {
 		if sp.Reward != 0 {
@@ -42,6 +45,12 @@ func aggregateProviderRewards(spus []dbs.StakePoolReward) (*providerRewardsDeleg
 			dpRewardsMap[sp.ID][poolId] = dpRewardsMap[sp.ID][poolId] + spus[i].DelegateRewards[poolId]
 			totalRewardsMap[sp.ID] = totalRewardsMap[sp.ID] + spus[i].DelegateRewards[poolId]
 		}
+		for poolId, c := range spus[i].DelegateCompounded {
+			if _, found := compoundedMap[sp.ID]; !found {
+				compoundedMap[sp.ID] = make(map[string]currency.Coin, len(spus[i].DelegateCompounded))
+			}
+			compoundedMap[sp.ID][poolId] = compoundedMap[sp.ID][poolId] + c
+		}
 		// todo https://github.com/0chain/0chain/issues/2122
 		// slash charges are no longer taken from rewards, but the stake pool. So related code has been removed.
 	}
@@ -50,6 +59,7 @@ func aggregateProviderRewards(spus []dbs.StakePoolReward) (*providerRewardsDeleg
 		rewards:       rewardsMap,
 		totalRewards:  totalRewardsMap,
 		delegatePools: dpRewardsMap,
+		compounded:    compoundedMap,
 	}, nil
 }
 
@@ -106,6 +116,14 @@ func withProviderRewardsPenaltiesAdded() eventMergeMiddleware {
 			a.DelegatePenalties[k] += v
 		}
 
+		// merge delegate pool compounded rewards
+		if len(b.DelegateCompounded) > 0 && a.DelegateCompounded == nil {
+			a.DelegateCompounded = make(map[string]currency.Coin, len(b.DelegateCompounded))
+		}
+		for k, v := range b.DelegateCompounded {
+			a.DelegateCompounded[k] += v
+		}
+
 		return a, nil
 	})
 }
@@ -153,6 +171,12 @@ func (edb *EventDb) rewardUpdate(spus []dbs.StakePoolReward, round int64) error
 		}
 	}
 
+	if len(rewards.compounded) > 0 {
+		if err := edb.compoundProviderDelegates(rewards.compounded, round); err != nil {
+			return fmt.Errorf("could not compound delegate pool rewards: %v", err)
+		}
+	}
+
 	if edb.Debug() {
 		if err := edb.insertProviderReward(spus, round); err != nil {
 			return err
@@ -252,6 +276,31 @@ func (edb *EventDb) rewardProviderDelegates(dps map[string]map[string]currency.C
 	return ret.Error
 }
 
+// compoundProviderDelegates moves the compounded rewards of the delegate pools
+// from the unclaimed reward to the balance
+func (edb *EventDb) compoundProviderDelegates(dps map[string]map[string]currency.Coin, round int64) error {
+	var poolIds []string
+	var providerIds []string
+	var compounded []uint64
+	var lastUpdated []uint64
+	for id, pools := range dps {
+		for poolId, c := range pools {
+			poolIds = append(poolIds, poolId)
+			providerIds = append(providerIds, id)
+			compounded = append(compounded, uint64(c))
+			lastUpdated = append(lastUpdated, uint64(round))
+		}
+	}
+
+	return CreateBuilder("delegate_pools", "pool_id", poolIds).
+		AddCompositeId("provider_id", providerIds).
+		AddUpdate("balance", compounded, "delegate_pools.balance + t.balance").
+		AddUpdate("reward", compounded, "delegate_pools.reward - t.reward").
+		AddUpdate("total_compounded", compounded, "delegate_pools.total_compounded + t.total_compounded").
+		AddUpdate("round_pool_last_updated", lastUpdated).
+		Exec(edb).Error
+}
+
 func (edb *EventDb) penaltyProviderDelegates(dps map[string]map[string]currency.Coin, round int64) error {
 
 	var poolIds []string
@@ -17,11 +17,13 @@ type Transaction struct {
 	model.ImmutableModel
 	Hash              string        `json:"hash" gorm:"uniqueIndex:idx_thash"`
 	BlockHash         string        `json:"block_hash" gorm:"index:idx_tblock_hash"`
-	Round             int64         `json:"round"`
+	Round             int64         `json:"round" gorm:"index:idx_tround_index"`
+	Index             int           `json:"index" gorm:"index:idx_tround_index"` // position in the block
 	Version           string        `json:"version"`
 	ClientId          string        `json:"client_id" gorm:"index:idx_tclient_id"`
 	ToClientId        string        `json:"to_client_id" gorm:"index:idx_tto_client_id"`
 	TransactionData   string        `json:"transaction_data"`
+	FunctionName      string        `json:"function_name" gorm:"index:idx_tfunction_name"`
 	Value             currency.Coin `json:"value"`
 	Signature         string        `json:"signature"`
 	CreationDate      int64         `json:"creation_date"`
@@ -0,0 +1,109 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+)
+
+// Kinds of token movements recorded for a transaction.
+const (
+	TransferKind = "transfer"
+	MintKind     = "mint"
+)
+
+// TransactionTransfer is a token transfer or a mint made by a transaction.
+// swagger:model TransactionTransfer
+type TransactionTransfer struct {
+	model.ImmutableModel
+	TxnHash      string        `json:"txn_hash" gorm:"index"`
+	Round        int64         `json:"round"`
+	Kind         string        `json:"kind"` // transfer or mint
+	FromClientID string        `json:"from_client_id" gorm:"index"`
+	ToClientID   string        `json:"to_client_id" gorm:"index"`
+	Amount       currency.Coin `json:"amount"`
+}
+
+// TransactionCursor is the position of a transaction in the chain. The
+// transactions are ordered by the round and the index within the block.
+type TransactionCursor struct {
+	Round int64 `json:"round"`
+	Index int   `json:"index"`
+}
+
+// TransactionHistoryFilter selects transactions of the history export.
+type TransactionHistoryFilter struct {
+	// ClientID selects transactions sent by or to the client, or moving
+	// tokens of the client.
+	ClientID     string
+	FunctionName string
+	// StartRound and EndRound restrict the rounds to [StartRound, EndRound),
+	// zero EndRound is unbounded.
+	StartRound int64
+	EndRound   int64
+	// After is the cursor of the last transaction of the previous page.
+	After *TransactionCursor
+	Limit int
+}
+
+// GetTransactionHistory returns the next page of transactions in the
+// (round, index) order and the transfers and mints made by them.
+// Used Index: idx_tround_index
+func (edb *EventDb) GetTransactionHistory(filter TransactionHistoryFilter) (
+	[]Transaction, []TransactionTransfer, error) {
+
+	query := edb.Store.Get().Model(&Transaction{}).
+		Where("client_id = ? OR to_client_id = ? OR hash IN (?)",
+			filter.ClientID, filter.ClientID,
+			edb.Store.Get().Model(&TransactionTransfer{}).
+				Select("txn_hash").
+				Where("from_client_id = ? OR to_client_id = ?",
+					filter.ClientID, filter.ClientID))
+
+	if filter.FunctionName != "" {
+		query = query.Where("function_name = ?", filter.FunctionName)
+	}
+	if filter.StartRound > 0 {
+		query = query.Where("round >= ?", filter.StartRound)
+	}
+	if filter.EndRound > 0 {
+		query = query.Where("round < ?", filter.EndRound)
+	}
+	if filter.After != nil {
+		query = query.Where(`(round, "index") > (?, ?)`,
+			filter.After.Round, filter.After.Index)
+	}
+
+	var txns []Transaction
+	err := query.Order("round").Order(`"index"`).
+		Limit(filter.Limit).
+		Find(&txns).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving transaction history: %v, error: %v",
+			filter.ClientID, err)
+	}
+	if len(txns) == 0 {
+		return txns, nil, nil
+	}
+
+	hashes := make([]string, 0, len(txns))
+	for _, txn := range txns {
+		hashes = append(hashes, txn.Hash)
+	}
+
+	var transfers []TransactionTransfer
+	err = edb.Store.Get().Model(&TransactionTransfer{}).
+		Where("txn_hash IN ?", hashes).
+		Order("id").
+		Find(&transfers).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving transaction transfers: %v, error: %v",
+			filter.ClientID, err)
+	}
+	return txns, transfers, nil
+}
+
+func (edb *EventDb) addTransactionTransfers(transfers []TransactionTransfer) error {
+	return edb.Store.Get().Create(&transfers).Error
+}
@@ -0,0 +1,41 @@
+package event
+
+import (
+	"fmt"
+
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"gorm.io/gorm/clause"
+)
+
+// VestingPoolTransfer is a change of owner or of a destination of a vesting pool.
+// swagger:model VestingPoolTransfer
+type VestingPoolTransfer struct {
+	model.UpdatableModel
+	PoolID       string `json:"pool_id" gorm:"index"`
+	TxnHash      string `json:"txn_hash"`
+	Type         int    `json:"type"` // owner or destination
+	FromClientID string `json:"from_client_id" gorm:"index"`
+	ToClientID   string `json:"to_client_id" gorm:"index"`
+}
+
+func (edb *EventDb) GetVestingPoolTransfers(poolID string, limit common.Pagination) ([]VestingPoolTransfer, error) {
+	var transfers []VestingPoolTransfer
+	err := edb.Store.Get().Model(&VestingPoolTransfer{}).
+		Where("pool_id = ?", poolID).
+		Offset(limit.Offset).
+		Limit(limit.Limit).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "id"},
+			Desc:   limit.IsDescending,
+		}).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving vesting pool transfers: %v, error: %v", poolID, err)
+	}
+	return transfers, nil
+}
+
+func (edb *EventDb) addVestingPoolTransfers(transfers []VestingPoolTransfer) error {
+	return edb.Store.Get().Create(&transfers).Error
+}
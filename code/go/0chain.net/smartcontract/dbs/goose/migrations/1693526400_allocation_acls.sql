@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE allocation_acls (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    allocation_id text NOT NULL,
+    client_id text NOT NULL,
+    ops smallint,
+    expires_at bigint
+);
+
+ALTER TABLE public.allocation_acls OWNER TO zchain_user;
+
+CREATE SEQUENCE public.allocation_acls_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.allocation_acls_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.allocation_acls_id_seq OWNED BY public.allocation_acls.id;
+
+ALTER TABLE ONLY public.allocation_acls ALTER COLUMN id SET DEFAULT nextval('public.allocation_acls_id_seq'::regclass);
+
+ALTER TABLE ONLY public.allocation_acls
+    ADD CONSTRAINT allocation_acls_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_alloc_acl_client ON public.allocation_acls USING btree (allocation_id, client_id);
+
+CREATE INDEX idx_alloc_acl_client_id ON public.allocation_acls USING btree (client_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE allocation_acls;
+-- +goose StatementEnd
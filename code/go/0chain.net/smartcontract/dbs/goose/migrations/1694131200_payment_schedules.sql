@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE payment_schedules (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    schedule_id text,
+    client_id text,
+    recipient text,
+    description text,
+    amount bigint,
+    "interval" bigint,
+    start_time bigint,
+    count bigint,
+    paid bigint,
+    balance bigint,
+    status bigint
+);
+
+ALTER TABLE public.payment_schedules OWNER TO zchain_user;
+
+CREATE SEQUENCE public.payment_schedules_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.payment_schedules_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.payment_schedules_id_seq OWNED BY public.payment_schedules.id;
+
+ALTER TABLE ONLY public.payment_schedules ALTER COLUMN id SET DEFAULT nextval('public.payment_schedules_id_seq'::regclass);
+
+ALTER TABLE ONLY public.payment_schedules
+    ADD CONSTRAINT payment_schedules_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_payment_schedules_schedule_id ON public.payment_schedules USING btree (schedule_id);
+
+CREATE INDEX idx_payment_schedules_client_id ON public.payment_schedules USING btree (client_id);
+
+CREATE INDEX idx_payment_schedules_recipient ON public.payment_schedules USING btree (recipient);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE payment_schedules;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE htlcs (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    htlc_id text,
+    hash_lock text,
+    sender text,
+    recipient text,
+    amount bigint,
+    expire_at bigint,
+    preimage text,
+    status bigint
+);
+
+ALTER TABLE public.htlcs OWNER TO zchain_user;
+
+CREATE SEQUENCE public.htlcs_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.htlcs_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.htlcs_id_seq OWNED BY public.htlcs.id;
+
+ALTER TABLE ONLY public.htlcs ALTER COLUMN id SET DEFAULT nextval('public.htlcs_id_seq'::regclass);
+
+ALTER TABLE ONLY public.htlcs
+    ADD CONSTRAINT htlcs_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_htlcs_htlc_id ON public.htlcs USING btree (htlc_id);
+
+CREATE INDEX idx_htlcs_hash_lock ON public.htlcs USING btree (hash_lock);
+
+CREATE INDEX idx_htlcs_sender ON public.htlcs USING btree (sender);
+
+CREATE INDEX idx_htlcs_recipient ON public.htlcs USING btree (recipient);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE htlcs;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE multisig_proposals (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    wallet_id text,
+    proposal_id text,
+    type bigint,
+    data text,
+    votes bigint,
+    expiration_date bigint,
+    status bigint,
+    executed_in_txn_hash text,
+    result text
+);
+
+ALTER TABLE public.multisig_proposals OWNER TO zchain_user;
+
+CREATE SEQUENCE public.multisig_proposals_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.multisig_proposals_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.multisig_proposals_id_seq OWNED BY public.multisig_proposals.id;
+
+ALTER TABLE ONLY public.multisig_proposals ALTER COLUMN id SET DEFAULT nextval('public.multisig_proposals_id_seq'::regclass);
+
+ALTER TABLE ONLY public.multisig_proposals
+    ADD CONSTRAINT multisig_proposals_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_msig_wallet_proposal ON public.multisig_proposals USING btree (wallet_id, proposal_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE multisig_proposals;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE multisig_wallets (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    wallet_id text,
+    signature_scheme text,
+    public_key text,
+    signers text,
+    num_required bigint
+);
+
+ALTER TABLE public.multisig_wallets OWNER TO zchain_user;
+
+CREATE SEQUENCE public.multisig_wallets_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.multisig_wallets_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.multisig_wallets_id_seq OWNED BY public.multisig_wallets.id;
+
+ALTER TABLE ONLY public.multisig_wallets ALTER COLUMN id SET DEFAULT nextval('public.multisig_wallets_id_seq'::regclass);
+
+ALTER TABLE ONLY public.multisig_wallets
+    ADD CONSTRAINT multisig_wallets_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_multisig_wallets_wallet_id ON public.multisig_wallets USING btree (wallet_id);
+
+ALTER TABLE public.multisig_proposals ADD COLUMN signer_threshold_ids text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.multisig_proposals DROP COLUMN signer_threshold_ids;
+
+DROP TABLE multisig_wallets;
+-- +goose StatementEnd
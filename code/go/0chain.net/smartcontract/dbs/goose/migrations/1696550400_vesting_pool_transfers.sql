@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE vesting_pool_transfers (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    pool_id text,
+    txn_hash text,
+    type bigint,
+    from_client_id text,
+    to_client_id text
+);
+
+ALTER TABLE public.vesting_pool_transfers OWNER TO zchain_user;
+
+CREATE SEQUENCE public.vesting_pool_transfers_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.vesting_pool_transfers_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.vesting_pool_transfers_id_seq OWNED BY public.vesting_pool_transfers.id;
+
+ALTER TABLE ONLY public.vesting_pool_transfers ALTER COLUMN id SET DEFAULT nextval('public.vesting_pool_transfers_id_seq'::regclass);
+
+ALTER TABLE ONLY public.vesting_pool_transfers
+    ADD CONSTRAINT vesting_pool_transfers_pkey PRIMARY KEY (id);
+
+CREATE INDEX idx_vesting_pool_transfers_pool_id ON public.vesting_pool_transfers USING btree (pool_id);
+
+CREATE INDEX idx_vesting_pool_transfers_from_client_id ON public.vesting_pool_transfers USING btree (from_client_id);
+
+CREATE INDEX idx_vesting_pool_transfers_to_client_id ON public.vesting_pool_transfers USING btree (to_client_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE vesting_pool_transfers;
+-- +goose StatementEnd
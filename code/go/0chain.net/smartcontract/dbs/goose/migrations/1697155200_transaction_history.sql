@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS index bigint NOT NULL DEFAULT 0;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS function_name text;
+
+-- the transactions stored before are numbered in the order they were
+-- added, so the (round, index) cursor doesn't skip any of them
+UPDATE transactions t SET "index" = n.row_index
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY round ORDER BY id) - 1 AS row_index
+    FROM transactions
+) n
+WHERE t.id = n.id;
+
+CREATE INDEX idx_tround_index ON public.transactions USING btree (round, index);
+
+CREATE INDEX idx_tfunction_name ON public.transactions USING btree (function_name);
+
+CREATE TABLE transaction_transfers (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+
+    txn_hash text,
+    round bigint,
+    kind text,
+    from_client_id text,
+    to_client_id text,
+    amount bigint
+);
+
+ALTER TABLE public.transaction_transfers OWNER TO zchain_user;
+
+CREATE SEQUENCE public.transaction_transfers_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.transaction_transfers_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.transaction_transfers_id_seq OWNED BY public.transaction_transfers.id;
+
+ALTER TABLE ONLY public.transaction_transfers ALTER COLUMN id SET DEFAULT nextval('public.transaction_transfers_id_seq'::regclass);
+
+ALTER TABLE ONLY public.transaction_transfers
+    ADD CONSTRAINT transaction_transfers_pkey PRIMARY KEY (id);
+
+CREATE INDEX idx_transaction_transfers_txn_hash ON public.transaction_transfers USING btree (txn_hash);
+
+CREATE INDEX idx_transaction_transfers_from_client_id ON public.transaction_transfers USING btree (from_client_id);
+
+CREATE INDEX idx_transaction_transfers_to_client_id ON public.transaction_transfers USING btree (to_client_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE transaction_transfers;
+
+DROP INDEX IF EXISTS idx_tfunction_name;
+DROP INDEX IF EXISTS idx_tround_index;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS function_name;
+ALTER TABLE transactions DROP COLUMN IF EXISTS index;
+-- +goose StatementEnd
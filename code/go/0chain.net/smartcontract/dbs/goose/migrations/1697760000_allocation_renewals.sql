@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE allocations ADD COLUMN IF NOT EXISTS auto_renew_duration bigint NOT NULL DEFAULT 0;
+ALTER TABLE allocations ADD COLUMN IF NOT EXISTS auto_renew_max_renewals bigint NOT NULL DEFAULT 0;
+ALTER TABLE allocations ADD COLUMN IF NOT EXISTS auto_renewals bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE allocation_renewals (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    allocation_id text,
+    txn_hash text,
+    round bigint,
+    renewal bigint,
+    old_expiration bigint,
+    new_expiration bigint,
+    write_pool bigint,
+    status bigint,
+    reason text
+);
+
+ALTER TABLE public.allocation_renewals OWNER TO zchain_user;
+
+CREATE SEQUENCE public.allocation_renewals_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.allocation_renewals_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.allocation_renewals_id_seq OWNED BY public.allocation_renewals.id;
+
+ALTER TABLE ONLY public.allocation_renewals ALTER COLUMN id SET DEFAULT nextval('public.allocation_renewals_id_seq'::regclass);
+
+ALTER TABLE ONLY public.allocation_renewals
+    ADD CONSTRAINT allocation_renewals_pkey PRIMARY KEY (id);
+
+CREATE INDEX idx_allocation_renewals_allocation_id ON public.allocation_renewals USING btree (allocation_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE allocation_renewals;
+
+ALTER TABLE allocations DROP COLUMN IF EXISTS auto_renewals;
+ALTER TABLE allocations DROP COLUMN IF EXISTS auto_renew_max_renewals;
+ALTER TABLE allocations DROP COLUMN IF EXISTS auto_renew_duration;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE allocation_ownership_transfers (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    allocation_id text,
+    txn_hash text,
+    round bigint,
+    from_client_id text,
+    to_client_id text,
+    owner_public_key text
+);
+
+ALTER TABLE public.allocation_ownership_transfers OWNER TO zchain_user;
+
+CREATE SEQUENCE public.allocation_ownership_transfers_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.allocation_ownership_transfers_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.allocation_ownership_transfers_id_seq OWNED BY public.allocation_ownership_transfers.id;
+
+ALTER TABLE ONLY public.allocation_ownership_transfers ALTER COLUMN id SET DEFAULT nextval('public.allocation_ownership_transfers_id_seq'::regclass);
+
+ALTER TABLE ONLY public.allocation_ownership_transfers
+    ADD CONSTRAINT allocation_ownership_transfers_pkey PRIMARY KEY (id);
+
+CREATE INDEX idx_allocation_ownership_transfers_allocation_id ON public.allocation_ownership_transfers USING btree (allocation_id);
+CREATE INDEX idx_allocation_ownership_transfers_from_client_id ON public.allocation_ownership_transfers USING btree (from_client_id);
+CREATE INDEX idx_allocation_ownership_transfers_to_client_id ON public.allocation_ownership_transfers USING btree (to_client_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE allocation_ownership_transfers;
+-- +goose StatementEnd
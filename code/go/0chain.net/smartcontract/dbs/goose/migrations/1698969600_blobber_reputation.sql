@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE blobbers ADD COLUMN IF NOT EXISTS reputation numeric default 0;
+ALTER TABLE blobber_aggregates ADD COLUMN IF NOT EXISTS reputation numeric default 0;
+ALTER TABLE blobber_snapshots ADD COLUMN IF NOT EXISTS reputation numeric default 0;
+CREATE INDEX IF NOT EXISTS idx_blobbers_reputation ON blobbers USING btree (reputation);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_blobbers_reputation;
+ALTER TABLE blobbers DROP COLUMN IF EXISTS reputation;
+ALTER TABLE blobber_aggregates DROP COLUMN IF EXISTS reputation;
+ALTER TABLE blobber_snapshots DROP COLUMN IF EXISTS reputation;
+-- +goose StatementEnd
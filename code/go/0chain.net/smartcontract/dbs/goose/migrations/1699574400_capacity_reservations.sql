@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE capacity_reservations (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    reservation_id text,
+    client_id text,
+    blobber_id text,
+    size bigint,
+    start_time bigint,
+    end_time bigint,
+    read_price bigint,
+    write_price bigint,
+    deposit bigint,
+    status bigint,
+    allocation_id text
+);
+
+ALTER TABLE public.capacity_reservations OWNER TO zchain_user;
+
+CREATE SEQUENCE public.capacity_reservations_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.capacity_reservations_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.capacity_reservations_id_seq OWNED BY public.capacity_reservations.id;
+
+ALTER TABLE ONLY public.capacity_reservations ALTER COLUMN id SET DEFAULT nextval('public.capacity_reservations_id_seq'::regclass);
+
+ALTER TABLE ONLY public.capacity_reservations
+    ADD CONSTRAINT capacity_reservations_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_capacity_reservations_reservation_id ON public.capacity_reservations USING btree (reservation_id);
+
+CREATE INDEX idx_capacity_reservations_client_id ON public.capacity_reservations USING btree (client_id);
+
+CREATE INDEX idx_capacity_reservations_blobber_id ON public.capacity_reservations USING btree (blobber_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE capacity_reservations;
+-- +goose StatementEnd
@@ -0,0 +1,89 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_challenges_allocation_id ON public.challenges USING btree (allocation_id);
+
+CREATE TABLE challenge_audits (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    challenge_id text,
+    allocation_id text,
+    blobber_id text,
+    txn_hash text,
+    round bigint,
+    block_hash text,
+    passed boolean,
+    reward bigint,
+    penalty bigint
+);
+
+ALTER TABLE public.challenge_audits OWNER TO zchain_user;
+
+CREATE SEQUENCE public.challenge_audits_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.challenge_audits_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.challenge_audits_id_seq OWNED BY public.challenge_audits.id;
+
+ALTER TABLE ONLY public.challenge_audits ALTER COLUMN id SET DEFAULT nextval('public.challenge_audits_id_seq'::regclass);
+
+ALTER TABLE ONLY public.challenge_audits
+    ADD CONSTRAINT challenge_audits_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_challenge_audits_challenge_id ON public.challenge_audits USING btree (challenge_id);
+
+CREATE INDEX idx_challenge_audits_allocation_id ON public.challenge_audits USING btree (allocation_id);
+
+CREATE TABLE challenge_validation_tickets (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    challenge_id text,
+    validator_id text,
+    validator_key text,
+    result boolean,
+    message text,
+    message_code text,
+    "timestamp" bigint,
+    hash text,
+    signature text,
+    rewarded boolean
+);
+
+ALTER TABLE public.challenge_validation_tickets OWNER TO zchain_user;
+
+CREATE SEQUENCE public.challenge_validation_tickets_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.challenge_validation_tickets_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.challenge_validation_tickets_id_seq OWNED BY public.challenge_validation_tickets.id;
+
+ALTER TABLE ONLY public.challenge_validation_tickets ALTER COLUMN id SET DEFAULT nextval('public.challenge_validation_tickets_id_seq'::regclass);
+
+ALTER TABLE ONLY public.challenge_validation_tickets
+    ADD CONSTRAINT challenge_validation_tickets_pkey PRIMARY KEY (id);
+
+CREATE INDEX idx_challenge_validation_tickets_challenge_id ON public.challenge_validation_tickets USING btree (challenge_id);
+
+ALTER TABLE ONLY public.challenge_validation_tickets
+    ADD CONSTRAINT fk_challenge_audits_tickets FOREIGN KEY (challenge_id) REFERENCES public.challenge_audits(challenge_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE challenge_validation_tickets;
+DROP TABLE challenge_audits;
+DROP INDEX IF EXISTS idx_challenges_allocation_id;
+-- +goose StatementEnd
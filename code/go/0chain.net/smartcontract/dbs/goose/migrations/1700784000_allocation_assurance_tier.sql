@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE allocations ADD COLUMN IF NOT EXISTS assurance_tier bigint NOT NULL DEFAULT 0;
+ALTER TABLE allocations ADD COLUMN IF NOT EXISTS assurance_price numeric NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE allocations DROP COLUMN IF EXISTS assurance_price;
+ALTER TABLE allocations DROP COLUMN IF EXISTS assurance_tier;
+-- +goose StatementEnd
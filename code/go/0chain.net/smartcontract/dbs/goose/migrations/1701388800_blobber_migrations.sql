@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE blobber_migrations (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    migration_id text,
+    allocation_id text,
+    from_blobber_id text,
+    to_blobber_id text,
+    size bigint,
+    used_size bigint,
+    allocation_root text,
+    created bigint,
+    deadline bigint,
+    status bigint,
+    penalty bigint
+);
+
+ALTER TABLE public.blobber_migrations OWNER TO zchain_user;
+
+CREATE SEQUENCE public.blobber_migrations_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.blobber_migrations_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.blobber_migrations_id_seq OWNED BY public.blobber_migrations.id;
+
+ALTER TABLE ONLY public.blobber_migrations ALTER COLUMN id SET DEFAULT nextval('public.blobber_migrations_id_seq'::regclass);
+
+ALTER TABLE ONLY public.blobber_migrations
+    ADD CONSTRAINT blobber_migrations_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_blobber_migrations_migration_id ON public.blobber_migrations USING btree (migration_id);
+
+CREATE INDEX idx_blobber_migrations_allocation_id ON public.blobber_migrations USING btree (allocation_id);
+
+CREATE INDEX idx_blobber_migrations_from_blobber_id ON public.blobber_migrations USING btree (from_blobber_id);
+
+CREATE INDEX idx_blobber_migrations_to_blobber_id ON public.blobber_migrations USING btree (to_blobber_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE blobber_migrations;
+-- +goose StatementEnd
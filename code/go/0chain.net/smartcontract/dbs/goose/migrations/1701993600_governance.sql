@@ -0,0 +1,82 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE governance_proposals (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    proposal_id text,
+    smart_contract text,
+    proposer_id text,
+    changes text,
+    created bigint,
+    voting_end bigint,
+    executable_at bigint,
+    status bigint,
+    yes_weight bigint,
+    no_weight bigint
+);
+
+ALTER TABLE public.governance_proposals OWNER TO zchain_user;
+
+CREATE SEQUENCE public.governance_proposals_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.governance_proposals_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.governance_proposals_id_seq OWNED BY public.governance_proposals.id;
+
+ALTER TABLE ONLY public.governance_proposals ALTER COLUMN id SET DEFAULT nextval('public.governance_proposals_id_seq'::regclass);
+
+ALTER TABLE ONLY public.governance_proposals
+    ADD CONSTRAINT governance_proposals_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_governance_proposals_proposal_id ON public.governance_proposals USING btree (proposal_id);
+
+CREATE INDEX idx_governance_proposals_smart_contract ON public.governance_proposals USING btree (smart_contract);
+
+CREATE TABLE governance_votes (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    proposal_id text,
+    voter_id text,
+    approve boolean,
+    weight bigint,
+    "timestamp" bigint
+);
+
+ALTER TABLE public.governance_votes OWNER TO zchain_user;
+
+CREATE SEQUENCE public.governance_votes_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.governance_votes_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.governance_votes_id_seq OWNED BY public.governance_votes.id;
+
+ALTER TABLE ONLY public.governance_votes ALTER COLUMN id SET DEFAULT nextval('public.governance_votes_id_seq'::regclass);
+
+ALTER TABLE ONLY public.governance_votes
+    ADD CONSTRAINT governance_votes_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_governance_votes_proposal_voter ON public.governance_votes USING btree (proposal_id, voter_id);
+
+ALTER TABLE ONLY public.governance_votes
+    ADD CONSTRAINT fk_governance_proposals_votes FOREIGN KEY (proposal_id) REFERENCES public.governance_proposals(proposal_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE governance_votes;
+DROP TABLE governance_proposals;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE delegate_pools ADD COLUMN IF NOT EXISTS release_round bigint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE delegate_pools DROP COLUMN IF EXISTS release_round;
+-- +goose StatementEnd
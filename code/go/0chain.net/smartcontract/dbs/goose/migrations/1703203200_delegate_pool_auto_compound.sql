@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE delegate_pools ADD COLUMN IF NOT EXISTS auto_compound boolean NOT NULL DEFAULT false;
+ALTER TABLE delegate_pools ADD COLUMN IF NOT EXISTS total_compounded bigint NOT NULL DEFAULT 0;
+ALTER TABLE reward_delegates ADD COLUMN IF NOT EXISTS compounded bigint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE delegate_pools DROP COLUMN IF EXISTS auto_compound;
+ALTER TABLE delegate_pools DROP COLUMN IF EXISTS total_compounded;
+ALTER TABLE reward_delegates DROP COLUMN IF EXISTS compounded;
+-- +goose StatementEnd
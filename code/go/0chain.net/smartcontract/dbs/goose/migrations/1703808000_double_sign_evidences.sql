@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE double_sign_evidences (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+
+    miner_id text,
+    round bigint,
+    block_hash_1 text,
+    signature_1 text,
+    block_hash_2 text,
+    signature_2 text,
+    reporter text,
+    txn_hash text,
+    slashed bigint
+);
+
+ALTER TABLE public.double_sign_evidences OWNER TO zchain_user;
+
+CREATE SEQUENCE public.double_sign_evidences_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.double_sign_evidences_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.double_sign_evidences_id_seq OWNED BY public.double_sign_evidences.id;
+
+ALTER TABLE ONLY public.double_sign_evidences ALTER COLUMN id SET DEFAULT nextval('public.double_sign_evidences_id_seq'::regclass);
+
+ALTER TABLE ONLY public.double_sign_evidences
+    ADD CONSTRAINT double_sign_evidences_pkey PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_double_sign_evidences_miner_round ON public.double_sign_evidences USING btree (miner_id, round);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE double_sign_evidences;
+-- +goose StatementEnd
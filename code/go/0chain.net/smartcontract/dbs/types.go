@@ -50,6 +50,8 @@ type StakePoolReward struct {
 	DelegateRewards map[string]currency.Coin `json:"delegate_rewards"`
 	// penalties delegate pools
 	DelegatePenalties map[string]currency.Coin `json:"delegate_penalties"`
+	// part of the delegate pools rewards rolled into their balances
+	DelegateCompounded map[string]currency.Coin `json:"delegate_compounded,omitempty"`
 	// allocation id
 	AllocationID string `json:"allocation_id"`
 }
@@ -31,6 +31,20 @@ func BenchmarkRestTests(
 				FuncName: "faucet_config",
 				Endpoint: frh.getConfig,
 			},
+			{
+				FuncName: "client-list",
+				Params: map[string]string{
+					"list": ListModeAllow,
+				},
+				Endpoint: frh.getClientList,
+			},
+			{
+				FuncName: "pow-challenge",
+				Params: map[string]string{
+					"client_id": data.Clients[0],
+				},
+				Endpoint: frh.getPowChallenge,
+			},
 		},
 		ADDRESS,
 		frh,
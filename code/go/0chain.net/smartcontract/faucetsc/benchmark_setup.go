@@ -1,9 +1,15 @@
 package faucetsc
 
 import (
+	"log"
+
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/chaincore/state"
+	sc "0chain.net/core/config"
 	"0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool/spenum"
 	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/util"
 	"github.com/spf13/viper"
@@ -16,6 +22,8 @@ func FundMockFaucetSmartContract(pMpt *util.MerklePatriciaTrie) {
 	_, _ = pMpt.Insert(util.Path(ADDRESS), is)
 }
 
+const mockGovernanceWeight = 100e10
+
 func AddMockGlobalNode(balances cstate.StateContextI) {
 	gn := &GlobalNode{
 		FaucetConfig: &FaucetConfig{
@@ -23,9 +31,46 @@ func AddMockGlobalNode(balances cstate.StateContextI) {
 		},
 		ID: ADDRESS,
 	}
+	var err error
+	gn.Governance, err = governance.ReadConfig(benchmark.SmartContract + benchmark.FaucetSc)
+	if err != nil {
+		log.Fatal(err)
+	}
 	_, _ = balances.InsertTrieNode(gn.GetKey(), gn)
 }
 
+// AddMockGovernanceProposals adds the open proposal to vote on and the one
+// voted by the first client to execute, the faucet has no stake pools so the
+// client votes with the stake in the first miner
+func AddMockGovernanceProposals(
+	clients, miners []string,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	governance.AddMockProposals(ADDRESS, clients[0], clients[0], mockGovernanceWeight,
+		[]governance.StakeRef{{ProviderType: spenum.Miner, ProviderID: miners[0]}},
+		mockGovernanceChanges("3"), eventDb, balances)
+}
+
+// mockGovernanceChanges sets all the limits as the mock global node has none
+func mockGovernanceChanges(pourAmount string) sc.StringMap {
+	return sc.StringMap{Fields: map[string]string{
+		Settings[PourAmount]:      pourAmount,
+		Settings[MaxPourAmount]:   "5",
+		Settings[PeriodicLimit]:   "7",
+		Settings[GlobalLimit]:     "11",
+		Settings[IndividualReset]: "1h",
+		Settings[GlobalReset]:     "24h",
+	}}
+}
+
+func mockGovernanceStakes(data benchmark.BenchData) []governance.StakeRef {
+	return []governance.StakeRef{{
+		ProviderType: spenum.Miner,
+		ProviderID:   data.Miners[0],
+	}}
+}
+
 func AddMockUserNodes(
 	clients []string,
 	balances cstate.StateContextI,
@@ -39,3 +84,19 @@ func AddMockUserNodes(
 		_, _ = balances.InsertTrieNode(un.GetKey(ADDRESS), un)
 	}
 }
+
+func AddMockClientLists(
+	clients []string,
+	balances cstate.StateContextI,
+) {
+	var allow, deny ClientList
+	for i, client := range clients {
+		if i%10 == 9 {
+			deny.add(client)
+		} else {
+			allow.add(client)
+		}
+	}
+	_, _ = balances.InsertTrieNode(clientListKey(ListModeAllow), &allow)
+	_, _ = balances.InsertTrieNode(clientListKey(ListModeDeny), &deny)
+}
@@ -1,6 +1,7 @@
 package faucetsc
 
 import (
+	"encoding/json"
 	"testing"
 
 	"0chain.net/core/common"
@@ -14,6 +15,7 @@ import (
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/datastore"
 	bk "0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/governance"
 )
 
 const owner = "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745802"
@@ -57,6 +59,14 @@ func (bt BenchTest) Run(balances cstate.TimedQueryStateContext, b *testing.B) er
 		_, err = fsc.pour(bt.Transaction(), bt.input, balances, gn)
 	case "refill":
 		_, err = fsc.refill(bt.Transaction(), balances, gn)
+	case "updateClientList":
+		_, err = fsc.updateClientList(bt.Transaction(), bt.input, balances, gn)
+	case "governancePropose":
+		_, err = fsc.governancePropose(bt.Transaction(), bt.input, balances)
+	case "governanceVote":
+		_, err = fsc.governanceVote(bt.Transaction(), bt.input, balances)
+	case "governanceExecute":
+		_, err = fsc.governanceExecute(bt.Transaction(), bt.input, balances)
 	default:
 		b.Errorf("unknown endpoint" + bt.endpoint)
 	}
@@ -115,6 +125,61 @@ func BenchmarkTests(
 			},
 			input: nil,
 		},
+		{
+			name:     "faucet.update-client-list",
+			endpoint: "updateClientList",
+			txn: &transaction.Transaction{
+				ClientID:     viper.GetString(bk.FaucetOwner),
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&clientListRequest{
+					List:   ListModeAllow,
+					Add:    data.Clients[len(data.Clients)/2:],
+					Remove: data.Clients[:1],
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "faucet.governance-propose",
+			endpoint: "governancePropose",
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ProposeRequest{
+				Changes: mockGovernanceChanges("2"),
+				Stakes:  mockGovernanceStakes(data),
+			}).Encode(),
+		},
+		{
+			name:     "faucet.governance-vote",
+			endpoint: "governanceVote",
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.VoteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 0),
+				Approve:    true,
+				Stakes:     mockGovernanceStakes(data),
+			}).Encode(),
+		},
+		{
+			name:     "faucet.governance-execute",
+			endpoint: "governanceExecute",
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ExecuteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 1),
+			}).Encode(),
+		},
 	}
 	var testsI []bk.BenchTestI
 	for _, test := range tests {
@@ -5,6 +5,7 @@ import (
 
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/util"
 )
@@ -21,6 +22,8 @@ const (
 	IndividualReset
 	GlobalReset
 	OwnerId
+	ListMode
+	PowDifficulty
 	Cost
 )
 
@@ -33,6 +36,8 @@ var (
 		"individual_reset",
 		"global_rest",
 		"owner_id",
+		"list_mode",
+		"pow_difficulty",
 		"cost",
 	}
 
@@ -40,9 +45,20 @@ var (
 		"update-settings",
 		"pour",
 		"refill",
+		"update-client-list",
 	}
 )
 
+// client list modes
+const (
+	ListModeNone  = "none"  // lists are not used
+	ListModeAllow = "allow" // only clients of the allow list can pour
+	ListModeDeny  = "deny"  // clients of the deny list can't pour
+)
+
+// maxPowDifficulty limits the proof-of-work difficulty, in leading zero bits
+const maxPowDifficulty = 32
+
 type FaucetConfig struct {
 	PourAmount      currency.Coin  `json:"pour_amount"`
 	MaxPourAmount   currency.Coin  `json:"max_pour_amount"`
@@ -51,7 +67,19 @@ type FaucetConfig struct {
 	IndividualReset time.Duration  `json:"individual_reset"`
 	GlobalReset     time.Duration  `json:"global_rest"`
 	OwnerId         string         `json:"owner_id"`
+	ListMode        string         `json:"list_mode"`      // none, allow or deny; empty means none
+	PowDifficulty   int            `json:"pow_difficulty"` // leading zero bits of the pour proof-of-work, zero disables it
 	Cost            map[string]int `json:"cost"`
+	// Governance of the faucet settings changes
+	Governance governance.Config `json:"governance"`
+}
+
+func isValidListMode(mode string) bool {
+	switch mode {
+	case "", ListModeNone, ListModeAllow, ListModeDeny:
+		return true
+	}
+	return false
 }
 
 // configurations from sc.yaml
@@ -77,7 +105,10 @@ func getFaucetConfig() (conf *FaucetConfig, err error) {
 	conf.IndividualReset = config.SmartContractConfig.GetDuration("smart_contracts.faucetsc.individual_reset")
 	conf.GlobalReset = config.SmartContractConfig.GetDuration("smart_contracts.faucetsc.global_reset")
 	conf.OwnerId = config.SmartContractConfig.GetString("smart_contracts.faucetsc.owner_id")
+	conf.ListMode = config.SmartContractConfig.GetString("smart_contracts.faucetsc.list_mode")
+	conf.PowDifficulty = config.SmartContractConfig.GetInt("smart_contracts.faucetsc.pow_difficulty")
 	conf.Cost = config.SmartContractConfig.GetStringMapInt("smart_contracts.faucetsc.cost")
+	conf.Governance, err = governance.ReadConfig("smart_contracts.faucetsc.")
 	return
 }
 
@@ -9,9 +9,9 @@ import (
 // MarshalMsg implements msgp.Marshaler
 func (z *FaucetConfig) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 8
+	// map header, size 11
 	// string "PourAmount"
-	o = append(o, 0x88, 0xaa, 0x50, 0x6f, 0x75, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
+	o = append(o, 0x8b, 0xaa, 0x50, 0x6f, 0x75, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
 	o, err = z.PourAmount.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "PourAmount")
@@ -47,6 +47,12 @@ func (z *FaucetConfig) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "OwnerId"
 	o = append(o, 0xa7, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64)
 	o = msgp.AppendString(o, z.OwnerId)
+	// string "ListMode"
+	o = append(o, 0xa8, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65)
+	o = msgp.AppendString(o, z.ListMode)
+	// string "PowDifficulty"
+	o = append(o, 0xad, 0x50, 0x6f, 0x77, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79)
+	o = msgp.AppendInt(o, z.PowDifficulty)
 	// string "Cost"
 	o = append(o, 0xa4, 0x43, 0x6f, 0x73, 0x74)
 	o = msgp.AppendMapHeader(o, uint32(len(z.Cost)))
@@ -60,6 +66,13 @@ func (z *FaucetConfig) MarshalMsg(b []byte) (o []byte, err error) {
 		o = msgp.AppendString(o, k)
 		o = msgp.AppendInt(o, za0002)
 	}
+	// string "Governance"
+	o = append(o, 0xaa, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65)
+	o, err = z.Governance.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Governance")
+		return
+	}
 	return
 }
 
@@ -123,6 +136,18 @@ func (z *FaucetConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "OwnerId")
 				return
 			}
+		case "ListMode":
+			z.ListMode, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ListMode")
+				return
+			}
+		case "PowDifficulty":
+			z.PowDifficulty, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PowDifficulty")
+				return
+			}
 		case "Cost":
 			var zb0002 uint32
 			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
@@ -153,6 +178,12 @@ func (z *FaucetConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.Cost[za0001] = za0002
 			}
+		case "Governance":
+			bts, err = z.Governance.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Governance")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -167,13 +198,14 @@ func (z *FaucetConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *FaucetConfig) Msgsize() (s int) {
-	s = 1 + 11 + z.PourAmount.Msgsize() + 14 + z.MaxPourAmount.Msgsize() + 14 + z.PeriodicLimit.Msgsize() + 12 + z.GlobalLimit.Msgsize() + 16 + msgp.DurationSize + 12 + msgp.DurationSize + 8 + msgp.StringPrefixSize + len(z.OwnerId) + 5 + msgp.MapHeaderSize
+	s = 1 + 11 + z.PourAmount.Msgsize() + 14 + z.MaxPourAmount.Msgsize() + 14 + z.PeriodicLimit.Msgsize() + 12 + z.GlobalLimit.Msgsize() + 16 + msgp.DurationSize + 12 + msgp.DurationSize + 8 + msgp.StringPrefixSize + len(z.OwnerId) + 9 + msgp.StringPrefixSize + len(z.ListMode) + 14 + msgp.IntSize + 5 + msgp.MapHeaderSize
 	if z.Cost != nil {
 		for za0001, za0002 := range z.Cost {
 			_ = za0002
 			s += msgp.StringPrefixSize + len(za0001) + msgp.IntSize
 		}
 	}
+	s += 11 + z.Governance.Msgsize()
 	return
 }
 
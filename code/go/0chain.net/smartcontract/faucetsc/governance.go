@@ -0,0 +1,68 @@
+package faucetsc
+
+import (
+	c_state "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	sc "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+)
+
+// governanceSettings are the faucet settings changed by the governance, the
+// same way update-settings does
+type governanceSettings struct {
+	fc *FaucetSmartContract
+}
+
+func (s governanceSettings) Config(balances c_state.StateContextI) (*governance.Config, error) {
+	gn, err := s.fc.getGlobalNode(balances)
+	if err != nil {
+		return nil, err
+	}
+	return &gn.Governance, nil
+}
+
+func (s governanceSettings) update(changes sc.StringMap, balances c_state.StateContextI) (*GlobalNode, error) {
+	gn, err := s.fc.getGlobalNode(balances)
+	if err != nil {
+		return nil, err
+	}
+	if err := gn.updateConfig(changes.Fields); err != nil {
+		return nil, err
+	}
+	if err := gn.validate(); err != nil {
+		return nil, err
+	}
+	return gn, nil
+}
+
+func (s governanceSettings) Validate(changes sc.StringMap, balances c_state.StateContextI) error {
+	_, err := s.update(changes, balances)
+	return err
+}
+
+func (s governanceSettings) Apply(changes sc.StringMap, balances c_state.StateContextI) error {
+	gn, err := s.update(changes, balances)
+	if err != nil {
+		return err
+	}
+	_, err = balances.InsertTrieNode(gn.GetKey(), gn)
+	return err
+}
+
+// governancePropose submits the proposal of the faucet settings changes, the
+// proposer is a council member or a staker
+func (fc *FaucetSmartContract) governancePropose(t *transaction.Transaction, inputData []byte, balances c_state.StateContextI) (string, error) {
+	return governance.Propose(t, inputData, fc.ID, governanceSettings{fc}, balances)
+}
+
+// governanceVote on the open proposal with the stake of the voter, a vote
+// again replaces the former one
+func (fc *FaucetSmartContract) governanceVote(t *transaction.Transaction, inputData []byte, balances c_state.StateContextI) (string, error) {
+	return governance.CastVote(t, inputData, fc.ID, governanceSettings{fc}, balances)
+}
+
+// governanceExecute closes the proposal after the voting, the passed one is
+// applied to the settings after the timelock. Anyone can do it.
+func (fc *FaucetSmartContract) governanceExecute(t *transaction.Transaction, inputData []byte, balances c_state.StateContextI) (string, error) {
+	return governance.Execute(t, inputData, fc.ID, governanceSettings{fc}, balances)
+}
@@ -43,6 +43,8 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		rest.MakeEndpoint(faucet+"/globalPeriodicLimit", common.UserRateLimit(frh.getGlobalPeriodicLimit)),
 		rest.MakeEndpoint(faucet+"/pourAmount", common.UserRateLimit(frh.getPourAmount)),
 		rest.MakeEndpoint(faucet+"/faucet-config", common.UserRateLimit(frh.getConfig)),
+		rest.MakeEndpoint(faucet+"/client-list", common.UserRateLimit(frh.getClientList)),
+		rest.MakeEndpoint(faucet+"/pow-challenge", common.UserRateLimit(frh.getPowChallenge)),
 	}
 }
 
@@ -104,6 +106,12 @@ func (frh *FaucetscRestHandler) getConfig(w http.ResponseWriter, r *http.Request
 		Settings[IndividualReset]: fmt.Sprintf("%v", faucetConfig.IndividualReset),
 		Settings[GlobalReset]:     fmt.Sprintf("%v", faucetConfig.GlobalReset),
 		Settings[OwnerId]:         fmt.Sprintf("%v", faucetConfig.OwnerId),
+		Settings[ListMode]:        fmt.Sprintf("%v", faucetConfig.ListMode),
+		Settings[PowDifficulty]:   fmt.Sprintf("%v", faucetConfig.PowDifficulty),
+	}
+
+	for key, value := range faucetConfig.Governance.Fields() {
+		fields[key] = value
 	}
 
 	for _, key := range costFunctions {
@@ -195,6 +203,75 @@ func (frh *FaucetscRestHandler) getPersonalPeriodicLimit(w http.ResponseWriter,
 	common.Respond(w, r, resp, nil)
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d3/client-list client-list
+// returns the owner managed allow or deny list of clients, the list_mode setting chooses the list in use
+//
+// parameters:
+//
+//	+name: list
+//	 description: allow or deny
+//	 required: true
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: faucetClientList
+//	400:
+//	500:
+func (frh *FaucetscRestHandler) getClientList(w http.ResponseWriter, r *http.Request) {
+	list := r.URL.Query().Get("list")
+	if !isValidList(list) {
+		common.Respond(w, r, nil, common.NewErrBadRequest("list must be allow or deny"))
+		return
+	}
+
+	cl, err := getClientList(list, frh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get client list", err.Error()))
+		return
+	}
+	common.Respond(w, r, cl, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d3/pow-challenge pow-challenge
+// returns the proof-of-work challenge of the next pour of the client and the current difficulty, zero difficulty means no proof-of-work required
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client to pour tokens to
+//	 required: true
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: faucetPowChallenge
+//	400:
+//	404:
+func (frh *FaucetscRestHandler) getPowChallenge(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	sctx := frh.GetQueryStateContext()
+	gn, err := getGlobalNode(sctx)
+	if err != nil {
+		NoResourceOrErrInternal(w, r, err)
+		return
+	}
+
+	un := &UserNode{ID: clientID}
+	if err := sctx.GetTrieNode(un.GetKey(gn.ID), un); err != nil && err != util.ErrValueNotPresent {
+		common.Respond(w, r, nil, smartcontract.NewErrNoResourceOrErrInternal(err, true, noClient))
+		return
+	}
+	common.Respond(w, r, un.powChallengeFor(gn.PowDifficulty), nil)
+}
+
 func getGlobalNode(sctx state.QueryStateContextI) (GlobalNode, error) {
 	gn := GlobalNode{ID: ADDRESS}
 	err := sctx.GetTrieNode(gn.GetKey(), &gn)
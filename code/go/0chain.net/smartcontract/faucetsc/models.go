@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/bits"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -14,9 +16,11 @@ import (
 
 	"0chain.net/core/datastore"
 	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/util"
 )
 
+//msgp:ignore clientListRequest pourRequest powChallenge
 //go:generate msgp -io=false -tests=false -v
 
 // swagger:model periodicResponse
@@ -60,6 +64,12 @@ func (gn *GlobalNode) Decode(input []byte) error {
 
 func (gn *GlobalNode) updateConfig(fields map[string]string) error {
 	for key, value := range fields {
+		if strings.HasPrefix(key, governance.Prefix) {
+			if err := gn.Governance.Set(strings.TrimPrefix(key, governance.Prefix), value); err != nil {
+				return fmt.Errorf("key %s: %v", key, err)
+			}
+			continue
+		}
 		switch key {
 		case Settings[PourAmount]:
 			fAmount, err := strconv.ParseFloat(value, 64)
@@ -118,6 +128,18 @@ func (gn *GlobalNode) updateConfig(fields map[string]string) error {
 				return fmt.Errorf("key %s, %v should be valid hex string", key, value)
 			}
 			gn.OwnerId = value
+		case Settings[ListMode]:
+			if !isValidListMode(value) {
+				return fmt.Errorf("key %s, %v should be one of %s, %s or %s",
+					key, value, ListModeNone, ListModeAllow, ListModeDeny)
+			}
+			gn.ListMode = value
+		case Settings[PowDifficulty]:
+			difficulty, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("key %s, unable to convert %v to integer", key, value)
+			}
+			gn.PowDifficulty = difficulty
 
 		default:
 			return gn.setCostValue(key, value)
@@ -166,6 +188,13 @@ func (gn *GlobalNode) validate() error {
 		return common.NewError("failed to validate global node", fmt.Sprintf("individual reset(%v) is too short", gn.IndividualReset))
 	case gn.GlobalReset < gn.IndividualReset:
 		return common.NewError("failed to validate global node", fmt.Sprintf("global reset(%v) is less than individual reset(%v)", gn.GlobalReset, gn.IndividualReset))
+	case !isValidListMode(gn.ListMode):
+		return common.NewError("failed to validate global node", fmt.Sprintf("unknown list mode(%v)", gn.ListMode))
+	case gn.PowDifficulty < 0 || gn.PowDifficulty > maxPowDifficulty:
+		return common.NewError("failed to validate global node", fmt.Sprintf("pow difficulty(%v) is out of [0, %v] range", gn.PowDifficulty, maxPowDifficulty))
+	}
+	if err := gn.Governance.Validate(); err != nil {
+		return common.NewError("failed to validate global node", fmt.Sprintf("invalid governance: %v", err))
 	}
 
 	return nil
@@ -175,6 +204,9 @@ type UserNode struct {
 	ID        string        `json:"id"`
 	StartTime time.Time     `json:"start_time"`
 	Used      currency.Coin `json:"used"`
+	// Pours counts all pours of the client, it's a part of the
+	// proof-of-work challenge
+	Pours int64 `json:"pours"`
 }
 
 func (un *UserNode) GetKey(globalKey string) datastore.Key {
@@ -198,3 +230,101 @@ func (un *UserNode) Decode(input []byte) error {
 	err := json.Unmarshal(input, un)
 	return err
 }
+
+// swagger:model faucetPowChallenge
+type powChallenge struct {
+	Challenge  string `json:"challenge"`
+	Difficulty int    `json:"difficulty"`
+}
+
+// powChallengeFor returns the proof-of-work challenge of the user's next
+// pour. A solution is a nonce, the hash of "<challenge>:<nonce>" of which
+// starts with the difficulty zero bits.
+func (un *UserNode) powChallengeFor(difficulty int) powChallenge {
+	return powChallenge{
+		Challenge:  encryption.Hash(fmt.Sprintf("%s:%s:%d", ADDRESS, un.ID, un.Pours)),
+		Difficulty: difficulty,
+	}
+}
+
+func (pc powChallenge) verify(nonce string) bool {
+	if pc.Difficulty == 0 {
+		return true
+	}
+	return leadingZeroBits(encryption.RawHash(pc.Challenge+":"+nonce)) >= pc.Difficulty
+}
+
+func leadingZeroBits(b []byte) (n int) {
+	for _, x := range b {
+		if x != 0 {
+			return n + bits.LeadingZeros8(x)
+		}
+		n += 8
+	}
+	return
+}
+
+type pourRequest struct {
+	PowNonce string `json:"pow_nonce"`
+}
+
+func (pr *pourRequest) decode(input []byte) error {
+	return json.Unmarshal(input, pr)
+}
+
+// client lists
+
+func clientListKey(list string) datastore.Key {
+	return datastore.Key(ADDRESS + encryption.Hash("faucetsc_client_list:"+list))
+}
+
+func isValidList(list string) bool {
+	return list == ListModeAllow || list == ListModeDeny
+}
+
+// ClientList is an owner managed allow or deny list of client IDs
+// swagger:model faucetClientList
+type ClientList struct {
+	Clients []string `json:"clients"` // sorted
+}
+
+func (cl *ClientList) Encode() []byte {
+	buff, _ := json.Marshal(cl)
+	return buff
+}
+
+func (cl *ClientList) Decode(input []byte) error {
+	return json.Unmarshal(input, cl)
+}
+
+func (cl *ClientList) contains(clientID string) bool {
+	i := sort.SearchStrings(cl.Clients, clientID)
+	return i < len(cl.Clients) && cl.Clients[i] == clientID
+}
+
+func (cl *ClientList) add(clientID string) {
+	i := sort.SearchStrings(cl.Clients, clientID)
+	if i < len(cl.Clients) && cl.Clients[i] == clientID {
+		return
+	}
+	cl.Clients = append(cl.Clients, "")
+	copy(cl.Clients[i+1:], cl.Clients[i:])
+	cl.Clients[i] = clientID
+}
+
+func (cl *ClientList) remove(clientID string) {
+	i := sort.SearchStrings(cl.Clients, clientID)
+	if i < len(cl.Clients) && cl.Clients[i] == clientID {
+		cl.Clients = append(cl.Clients[:i], cl.Clients[i+1:]...)
+	}
+}
+
+type clientListRequest struct {
+	List   string   `json:"list"` // allow or deny
+	Add    []string `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+}
+
+func (clr *clientListRequest) decode(input []byte) error {
+	return json.Unmarshal(input, clr)
+}
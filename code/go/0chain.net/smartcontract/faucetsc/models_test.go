@@ -0,0 +1,114 @@
+package faucetsc
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/chain/state/mocks"
+	"0chain.net/core/common"
+	"github.com/0chain/common/core/util"
+)
+
+func TestClientList(t *testing.T) {
+	var cl ClientList
+	cl.add("c")
+	cl.add("a")
+	cl.add("b")
+	cl.add("a")
+	require.Equal(t, []string{"a", "b", "c"}, cl.Clients)
+	require.True(t, cl.contains("b"))
+
+	cl.remove("b")
+	cl.remove("d")
+	require.Equal(t, []string{"a", "c"}, cl.Clients)
+	require.False(t, cl.contains("b"))
+}
+
+func Test_leadingZeroBits(t *testing.T) {
+	require.Equal(t, 0, leadingZeroBits([]byte{0x80}))
+	require.Equal(t, 7, leadingZeroBits([]byte{0x01, 0xff}))
+	require.Equal(t, 12, leadingZeroBits([]byte{0x00, 0x08}))
+	require.Equal(t, 16, leadingZeroBits([]byte{0x00, 0x00}))
+}
+
+// solvePow finds the smallest nonce satisfying the challenge
+func solvePow(pc powChallenge) string {
+	for i := 0; ; i++ {
+		if nonce := strconv.Itoa(i); pc.verify(nonce) {
+			return nonce
+		}
+	}
+}
+
+func TestGlobalNode_updateConfig(t *testing.T) {
+	gn := &GlobalNode{FaucetConfig: &FaucetConfig{}}
+	require.NoError(t, gn.updateConfig(map[string]string{
+		Settings[ListMode]:      ListModeDeny,
+		Settings[PowDifficulty]: "8",
+	}))
+	require.Equal(t, ListModeDeny, gn.ListMode)
+	require.Equal(t, 8, gn.PowDifficulty)
+
+	require.EqualError(t, gn.updateConfig(map[string]string{
+		Settings[ListMode]: "everyone",
+	}), "key list_mode, everyone should be one of none, allow or deny")
+
+	require.NoError(t, gn.updateConfig(map[string]string{
+		"governance.quorum":     "10",
+		"governance.max_voters": "20",
+	}))
+	require.EqualValues(t, 10e10, gn.Governance.Quorum)
+	require.Equal(t, 20, gn.Governance.MaxVoters)
+}
+
+func TestUserNode_validPourClient(t *testing.T) {
+	var (
+		un    = &UserNode{ID: "client", Pours: 3}
+		lists = map[string]*ClientList{
+			clientListKey(ListModeAllow): {Clients: []string{"client"}},
+			clientListKey(ListModeDeny):  {Clients: []string{"another"}},
+		}
+		balances = &mocks.StateContextI{}
+	)
+	balances.On("GetTrieNode", mock.Anything, mock.AnythingOfType("*faucetsc.ClientList")).Return(
+		func(key string, v util.MPTSerializable) error {
+			cl, ok := lists[key]
+			if !ok {
+				return util.ErrValueNotPresent
+			}
+			*v.(*ClientList) = *cl
+			return nil
+		})
+
+	gn := &GlobalNode{FaucetConfig: &FaucetConfig{ListMode: ListModeNone}}
+	require.NoError(t, un.validPourClient([]byte("{Pay day}"), balances, gn))
+
+	gn.ListMode = ListModeAllow
+	require.NoError(t, un.validPourClient(nil, balances, gn))
+	lists[clientListKey(ListModeAllow)] = &ClientList{}
+	require.EqualError(t, un.validPourClient(nil, balances, gn),
+		"invalid_request: client is not in the allow list")
+
+	gn.ListMode = ListModeDeny
+	require.NoError(t, un.validPourClient(nil, balances, gn))
+	lists[clientListKey(ListModeDeny)].add("client")
+	require.EqualError(t, un.validPourClient(nil, balances, gn),
+		"invalid_request: client is in the deny list")
+
+	gn.ListMode = ListModeNone
+	gn.PowDifficulty = 8
+	require.EqualError(t, un.validPourClient([]byte("{Pay day}"), balances, gn),
+		"invalid_request: malformed pour request: invalid character 'P' looking for beginning of object key string")
+
+	nonce := solvePow(un.powChallengeFor(gn.PowDifficulty))
+	input := []byte(`{"pow_nonce":"` + nonce + `"}`)
+	require.NoError(t, un.validPourClient(input, balances, gn))
+
+	// the solution can't be used for the next pour
+	un.Pours++
+	err := un.validPourClient(input, balances, gn)
+	require.Equal(t, common.NewError("invalid_request", "invalid proof-of-work"), err)
+}
@@ -16,6 +16,7 @@ import (
 	"0chain.net/chaincore/state"
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/logging"
 	"github.com/0chain/common/core/util"
 	metrics "github.com/rcrowley/go-metrics"
@@ -71,6 +72,10 @@ func (fc *FaucetSmartContract) setSC(sc *smartcontractinterface.SmartContract, _
 	fc.SmartContractExecutionStats["update-settings"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "update-settings"), nil)
 	fc.SmartContractExecutionStats["pour"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "pour"), nil)
 	fc.SmartContractExecutionStats["refill"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "refill"), nil)
+	fc.SmartContractExecutionStats["update-client-list"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "update-client-list"), nil)
+	fc.SmartContractExecutionStats["governance-propose"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "governance-propose"), nil)
+	fc.SmartContractExecutionStats["governance-vote"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "governance-vote"), nil)
+	fc.SmartContractExecutionStats["governance-execute"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "governance-execute"), nil)
 	fc.SmartContractExecutionStats["tokens Poured"] = metrics.GetOrRegisterHistogram(fmt.Sprintf("sc:%v:func:%v", fc.ID, "tokens Poured"), nil, metrics.NewUniformSample(1024))
 	fc.SmartContractExecutionStats["token refills"] = metrics.GetOrRegisterHistogram(fmt.Sprintf("sc:%v:func:%v", fc.ID, "token refills"), nil, metrics.NewUniformSample(1024))
 }
@@ -118,6 +123,35 @@ func (un *UserNode) validPourRequest(t *transaction.Transaction, balances c_stat
 	return true, nil
 }
 
+// validPourClient checks the client lists and the proof-of-work of the pour
+func (un *UserNode) validPourClient(input []byte, balances c_state.StateContextI, gn *GlobalNode) error {
+	switch gn.ListMode {
+	case ListModeAllow, ListModeDeny:
+		cl, err := getClientList(gn.ListMode, balances)
+		if err != nil {
+			return common.NewError("invalid_request", "can't get client list: "+err.Error())
+		}
+		if gn.ListMode == ListModeAllow && !cl.contains(un.ID) {
+			return common.NewError("invalid_request", "client is not in the allow list")
+		}
+		if gn.ListMode == ListModeDeny && cl.contains(un.ID) {
+			return common.NewError("invalid_request", "client is in the deny list")
+		}
+	}
+
+	if gn.PowDifficulty == 0 {
+		return nil
+	}
+	var pr pourRequest
+	if err := pr.decode(input); err != nil {
+		return common.NewError("invalid_request", "malformed pour request: "+err.Error())
+	}
+	if !un.powChallengeFor(gn.PowDifficulty).verify(pr.PowNonce) {
+		return common.NewError("invalid_request", "invalid proof-of-work")
+	}
+	return nil
+}
+
 func (fc *FaucetSmartContract) updateSettings(
 	t *transaction.Transaction,
 	inputData []byte,
@@ -136,6 +170,10 @@ func (fc *FaucetSmartContract) updateSettings(
 		return "", common.NewError("update_settings", "limit request not formatted correctly")
 	}
 
+	if err := governance.CheckOwnerChanges(input); err != nil {
+		return "", common.NewError("update_settings", err.Error())
+	}
+
 	if err := gn.updateConfig(input.Fields); err != nil {
 		return "", common.NewError("update_settings", err.Error())
 	}
@@ -154,12 +192,16 @@ func toSeconds(dur time.Duration) common.Timestamp {
 	return common.Timestamp(dur / time.Second)
 }
 
-func (fc *FaucetSmartContract) pour(t *transaction.Transaction, _ []byte, balances c_state.StateContextI, gn *GlobalNode) (string, error) {
+func (fc *FaucetSmartContract) pour(t *transaction.Transaction, inputData []byte, balances c_state.StateContextI, gn *GlobalNode) (string, error) {
 	user, err := fc.getUserVariables(t, gn, balances)
 	if err != nil {
 		return "", err
 	}
 
+	if err := user.validPourClient(inputData, balances, gn); err != nil {
+		return "", err
+	}
+
 	ok, err := user.validPourRequest(t, balances, gn)
 	if ok {
 		var pourAmount = gn.PourAmount
@@ -186,6 +228,7 @@ func (fc *FaucetSmartContract) pour(t *transaction.Transaction, _ []byte, balanc
 			return "", common.NewError("pour", fmt.Sprintf("adding tokens to global used amount resulted in an error: %v", err.Error()))
 		}
 		gn.Used = gnUsed
+		user.Pours++
 		_, err = balances.InsertTrieNode(user.GetKey(gn.ID), user)
 		if err != nil {
 			logging.Logger.Error("pour_failed: error inserting user",
@@ -227,6 +270,49 @@ func (fc *FaucetSmartContract) refill(t *transaction.Transaction, balances c_sta
 	return "", common.NewError("broke", "it seems you're broke and can't transfer money")
 }
 
+// updateClientList adds and removes clients of the allow or deny list by owner
+func (fc *FaucetSmartContract) updateClientList(t *transaction.Transaction, inputData []byte, balances c_state.StateContextI, gn *GlobalNode) (string, error) {
+	if err := smartcontractinterface.AuthorizeWithOwner("update_client_list", func() bool {
+		return gn.FaucetConfig.OwnerId == t.ClientID
+	}); err != nil {
+		return "", err
+	}
+
+	var req clientListRequest
+	if err := req.decode(inputData); err != nil {
+		return "", common.NewError("update_client_list", "malformed request: "+err.Error())
+	}
+	if !isValidList(req.List) {
+		return "", common.NewErrorf("update_client_list", "unknown list %q", req.List)
+	}
+
+	cl, err := getClientList(req.List, balances)
+	if err != nil {
+		return "", common.NewError("update_client_list", "can't get client list: "+err.Error())
+	}
+	for _, clientID := range req.Add {
+		cl.add(clientID)
+	}
+	for _, clientID := range req.Remove {
+		cl.remove(clientID)
+	}
+
+	if _, err = balances.InsertTrieNode(clientListKey(req.List), cl); err != nil {
+		return "", common.NewError("update_client_list", "saving client list: "+err.Error())
+	}
+	return string(cl.Encode()), nil
+}
+
+// getClientList returns the allow or deny list, empty if not created yet
+func getClientList(list string, balances c_state.CommonStateContextI) (*ClientList, error) {
+	cl := new(ClientList)
+	err := balances.GetTrieNode(clientListKey(list), cl)
+	if err != nil && err != util.ErrValueNotPresent {
+		return nil, err
+	}
+	return cl, nil
+}
+
 func (fc *FaucetSmartContract) getUserNode(id string, globalKey string, balances c_state.StateContextI) (*UserNode, error) {
 	un := &UserNode{ID: id}
 	err := balances.GetTrieNode(un.GetKey(globalKey), un)
@@ -288,6 +374,14 @@ func (fc *FaucetSmartContract) Execute(t *transaction.Transaction, funcName stri
 		return fc.pour(t, inputData, balances, gn)
 	case "refill":
 		return fc.refill(t, balances, gn)
+	case "update-client-list":
+		return fc.updateClientList(t, inputData, balances, gn)
+	case "governance-propose":
+		return fc.governancePropose(t, inputData, balances)
+	case "governance-vote":
+		return fc.governanceVote(t, inputData, balances)
+	case "governance-execute":
+		return fc.governanceExecute(t, inputData, balances)
 	default:
 		return "", common.NewErrorf("failed execution", "no faucet smart contract method with name %s", funcName)
 	}
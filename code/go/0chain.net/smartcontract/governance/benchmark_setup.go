@@ -0,0 +1,88 @@
+package governance
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/viper"
+	"0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/dbs/event"
+	"github.com/0chain/common/core/currency"
+)
+
+// AddMockProposals of the smart contract, the open proposal to vote on and
+// the one voted by the voter with the weight of the stakes to execute
+func AddMockProposals(
+	scAddress, proposerID, voterID string,
+	weight currency.Coin,
+	stakes []StakeRef,
+	changes config.StringMap,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	var (
+		now = balances.GetTransaction().CreationDate
+		c   = &Config{VotingPeriod: time.Hour, Timelock: time.Hour, MaxVoters: 100}
+	)
+	changesJson, err := json.Marshal(changes.Fields)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		created := now
+		if i > 0 {
+			created = now - common.Timestamp(3*time.Hour/time.Second)
+		}
+		p := NewProposal(GetMockProposalId(scAddress, i), proposerID, changes, created, c)
+		if i > 0 {
+			err := p.AddVote(&Vote{
+				VoterID:   voterID,
+				Approve:   true,
+				Weight:    weight,
+				Stakes:    stakes,
+				Timestamp: created,
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+		if err := p.Save(scAddress, balances); err != nil {
+			log.Fatal(err)
+		}
+		if viper.GetBool(benchmark.EventDbEnabled) {
+			pDb := event.GovernanceProposal{
+				ProposalID:    p.ID,
+				SmartContract: scAddress,
+				ProposerID:    p.ProposerID,
+				Changes:       string(changesJson),
+				Created:       int64(p.Created),
+				VotingEnd:     int64(p.VotingEnd),
+				ExecutableAt:  int64(p.ExecutableAt),
+				Status:        p.Status,
+				YesWeight:     p.Tally.Yes,
+			}
+			for _, v := range p.Votes {
+				pDb.Votes = append(pDb.Votes, event.GovernanceVote{
+					ProposalID: p.ID,
+					VoterID:    v.VoterID,
+					Approve:    v.Approve,
+					Weight:     v.Weight,
+					Timestamp:  int64(v.Timestamp),
+				})
+			}
+			if err := eventDb.Store.Get().Create(&pDb).Error; err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+}
+
+func GetMockProposalId(scAddress string, i int) string {
+	return encryption.Hash(scAddress + " mock governance proposal " + strconv.Itoa(i))
+}
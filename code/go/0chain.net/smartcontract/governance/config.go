@@ -0,0 +1,130 @@
+package governance
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"0chain.net/core/config"
+	"github.com/0chain/common/core/currency"
+)
+
+// Prefix of the governance settings of the smart contracts
+const Prefix = "governance."
+
+// governance settings
+const (
+	Council      = "council"
+	Quorum       = "quorum"
+	Threshold    = "threshold"
+	VotingPeriod = "voting_period"
+	Timelock     = "timelock"
+	MinStake     = "min_stake"
+	MaxVoters    = "max_voters"
+)
+
+// ErrOwnerChanges is returned when the owner of the smart contract changes
+// the governance settings, they are changed by the passed proposals only
+var ErrOwnerChanges = errors.New("governance settings can only be changed by a proposal")
+
+// CheckOwnerChanges of the smart contract settings made by the owner, they
+// can't change the governance settings
+func CheckOwnerChanges(changes config.StringMap) error {
+	for key := range changes.Fields {
+		if strings.HasPrefix(key, Prefix) {
+			return ErrOwnerChanges
+		}
+	}
+	return nil
+}
+
+// ReadConfig of the smart contract from sc.yaml, the prefix is the one of
+// the smart contract settings
+func ReadConfig(prefix string) (c Config, err error) {
+	scc := config.SmartContractConfig
+	pfx := prefix + Prefix
+	c.Council = scc.GetStringSlice(pfx + Council)
+	if c.Quorum, err = currency.ParseZCN(scc.GetFloat64(pfx + Quorum)); err != nil {
+		return c, err
+	}
+	c.Threshold = scc.GetFloat64(pfx + Threshold)
+	c.VotingPeriod = scc.GetDuration(pfx + VotingPeriod)
+	c.Timelock = scc.GetDuration(pfx + Timelock)
+	if c.MinStake, err = currency.ParseZCN(scc.GetFloat64(pfx + MinStake)); err != nil {
+		return c, err
+	}
+	c.MaxVoters = scc.GetInt(pfx + MaxVoters)
+	return c, nil
+}
+
+// ParseCouncil from the comma separated client ids
+func ParseCouncil(value string) ([]string, error) {
+	var council []string
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id == "" {
+			continue
+		}
+		if _, err := hex.DecodeString(id); err != nil {
+			return nil, fmt.Errorf("invalid council member %s: %v", id, err)
+		}
+		council = append(council, id)
+	}
+	return council, nil
+}
+
+// Set the governance setting, the key is without the prefix
+func (c *Config) Set(key, value string) (err error) {
+	switch key {
+	case Council:
+		c.Council, err = ParseCouncil(value)
+	case Quorum, MinStake:
+		var amount float64
+		if amount, err = strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to currency.Coin", key, value)
+		}
+		var coin currency.Coin
+		if coin, err = currency.ParseZCN(amount); err != nil {
+			return err
+		}
+		if key == Quorum {
+			c.Quorum = coin
+		} else {
+			c.MinStake = coin
+		}
+	case Threshold:
+		if c.Threshold, err = strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to float64", key, value)
+		}
+	case VotingPeriod:
+		if c.VotingPeriod, err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to time.Duration", key, value)
+		}
+	case Timelock:
+		if c.Timelock, err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to time.Duration", key, value)
+		}
+	case MaxVoters:
+		if c.MaxVoters, err = strconv.Atoi(value); err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to int", key, value)
+		}
+	default:
+		return fmt.Errorf("unknown governance setting %s", key)
+	}
+	return err
+}
+
+// Fields of the governance settings with the prefix
+func (c *Config) Fields() map[string]string {
+	return map[string]string{
+		Prefix + Council:      strings.Join(c.Council, ","),
+		Prefix + Quorum:       fmt.Sprintf("%v", float64(c.Quorum)/1e10),
+		Prefix + Threshold:    fmt.Sprintf("%v", c.Threshold),
+		Prefix + VotingPeriod: fmt.Sprintf("%v", c.VotingPeriod),
+		Prefix + Timelock:     fmt.Sprintf("%v", c.Timelock),
+		Prefix + MinStake:     fmt.Sprintf("%v", float64(c.MinStake)/1e10),
+		Prefix + MaxVoters:    fmt.Sprintf("%v", c.MaxVoters),
+	}
+}
@@ -0,0 +1,42 @@
+package governance
+
+import (
+	"encoding/json"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+// Emit the proposal of the smart contract with its tally
+func (p *Proposal) Emit(scAddress string, balances cstate.StateContextI) error {
+	changes, err := json.Marshal(p.Changes.Fields)
+	if err != nil {
+		return err
+	}
+	balances.EmitEvent(event.TypeStats, event.TagAddOrOverwriteGovernanceProposal, p.ID,
+		[]event.GovernanceProposal{{
+			ProposalID:    p.ID,
+			SmartContract: scAddress,
+			ProposerID:    p.ProposerID,
+			Changes:       string(changes),
+			Created:       int64(p.Created),
+			VotingEnd:     int64(p.VotingEnd),
+			ExecutableAt:  int64(p.ExecutableAt),
+			Status:        p.Status,
+			YesWeight:     p.Tally.Yes,
+			NoWeight:      p.Tally.No,
+		}})
+	return nil
+}
+
+// EmitVote on the proposal
+func (p *Proposal) EmitVote(v *Vote, balances cstate.StateContextI) {
+	balances.EmitEvent(event.TypeStats, event.TagAddOrOverwriteGovernanceVote, p.ID,
+		[]event.GovernanceVote{{
+			ProposalID: p.ID,
+			VoterID:    v.VoterID,
+			Approve:    v.Approve,
+			Weight:     v.Weight,
+			Timestamp:  int64(v.Timestamp),
+		}})
+}
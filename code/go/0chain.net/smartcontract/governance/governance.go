@@ -0,0 +1,222 @@
+package governance
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+const (
+	ProposalOpen = iota
+	ProposalExecuted
+	ProposalRejected
+)
+
+var proposalStatuses = map[string]int{
+	"open":     ProposalOpen,
+	"executed": ProposalExecuted,
+	"rejected": ProposalRejected,
+}
+
+// ProposalStatusFromString parses the status name used by the queries
+func ProposalStatusFromString(s string) (int, bool) {
+	status, ok := proposalStatuses[s]
+	return status, ok
+}
+
+// Config of the governance of the smart contract settings. The council and
+// the stakers with the min stake can propose settings changes, the stakers
+// with the min stake vote with the stake of their delegate pools. A proposal
+// passes when the votes reach the quorum and the approving share of them
+// reaches the threshold, it's applied after the timelock.
+type Config struct {
+	Council      []string      `json:"council"`
+	Quorum       currency.Coin `json:"quorum"`
+	Threshold    float64       `json:"threshold"`
+	VotingPeriod time.Duration `json:"voting_period"`
+	Timelock     time.Duration `json:"timelock"`
+	// MinStake of the proposers not in the council and of the voters.
+	MinStake currency.Coin `json:"min_stake"`
+	// MaxVoters of a proposal.
+	MaxVoters int `json:"max_voters"`
+}
+
+func (c *Config) Validate() error {
+	for _, id := range c.Council {
+		if _, err := hex.DecodeString(id); err != nil {
+			return fmt.Errorf("invalid council member %s: %v", id, err)
+		}
+	}
+	switch {
+	case c.Quorum == 0:
+		return errors.New("quorum must be positive")
+	case c.Threshold <= 0 || c.Threshold > 1:
+		return fmt.Errorf("threshold must be in (0, 1]: %v", c.Threshold)
+	case c.VotingPeriod <= 0:
+		return fmt.Errorf("voting_period must be positive: %v", c.VotingPeriod)
+	case c.Timelock < 0:
+		return fmt.Errorf("negative timelock: %v", c.Timelock)
+	case c.MaxVoters <= 0:
+		return fmt.Errorf("max_voters must be positive: %v", c.MaxVoters)
+	}
+	return nil
+}
+
+func (c *Config) IsCouncil(id string) bool {
+	for _, member := range c.Council {
+		if member == id {
+			return true
+		}
+	}
+	return false
+}
+
+// StakeRef is a stake pool of a provider the voter has a delegate pool in
+type StakeRef struct {
+	ProviderType spenum.Provider `json:"provider_type"`
+	ProviderID   string          `json:"provider_id"`
+}
+
+// Vote of a staker weighted with the stake of the voter at the vote time,
+// the stakes are recounted on the execution
+type Vote struct {
+	VoterID   string           `json:"voter_id"`
+	Approve   bool             `json:"approve"`
+	Weight    currency.Coin    `json:"weight"`
+	Stakes    []StakeRef       `json:"stakes"`
+	Timestamp common.Timestamp `json:"timestamp"`
+}
+
+// Tally of the votes weights
+type Tally struct {
+	Yes currency.Coin `json:"yes"`
+	No  currency.Coin `json:"no"`
+}
+
+func (t *Tally) add(v *Vote) (err error) {
+	if v.Approve {
+		t.Yes, err = currency.AddCoin(t.Yes, v.Weight)
+	} else {
+		t.No, err = currency.AddCoin(t.No, v.Weight)
+	}
+	return
+}
+
+func (t *Tally) sub(v *Vote) (err error) {
+	if v.Approve {
+		t.Yes, err = currency.MinusCoin(t.Yes, v.Weight)
+	} else {
+		t.No, err = currency.MinusCoin(t.No, v.Weight)
+	}
+	return
+}
+
+// Passed if the votes reach the quorum and the approving share of them
+// reaches the threshold
+func (t Tally) Passed(c *Config) bool {
+	total := t.Yes + t.No
+	if total == 0 || total < c.Quorum {
+		return false
+	}
+	return float64(t.Yes) >= c.Threshold*float64(total)
+}
+
+// Proposal of the smart contract settings changes
+type Proposal struct {
+	ID         string           `json:"id"`
+	ProposerID string           `json:"proposer_id"`
+	Changes    config.StringMap `json:"changes"`
+	Created    common.Timestamp `json:"created"`
+	VotingEnd  common.Timestamp `json:"voting_end"`
+	// ExecutableAt is the end of the timelock of the passed proposal.
+	ExecutableAt common.Timestamp `json:"executable_at"`
+	Status       int              `json:"status"`
+	// MaxVoters of the proposal, set on the creation.
+	MaxVoters int `json:"max_voters"`
+	// Tally of the votes weights at the vote time, recounted on the execution.
+	Tally Tally   `json:"tally"`
+	Votes []*Vote `json:"votes"`
+}
+
+func NewProposal(id, proposerID string, changes config.StringMap, now common.Timestamp, c *Config) *Proposal {
+	votingEnd := now + common.Timestamp(c.VotingPeriod.Seconds())
+	return &Proposal{
+		ID:           id,
+		ProposerID:   proposerID,
+		Changes:      changes,
+		Created:      now,
+		VotingEnd:    votingEnd,
+		ExecutableAt: votingEnd + common.Timestamp(c.Timelock.Seconds()),
+		Status:       ProposalOpen,
+		MaxVoters:    c.MaxVoters,
+	}
+}
+
+func ProposalKey(scAddress, id string) datastore.Key {
+	return datastore.Key(scAddress + ":governance:proposal:" + id)
+}
+
+func GetProposal(scAddress, id string, balances cstate.CommonStateContextI) (*Proposal, error) {
+	p := new(Proposal)
+	if err := balances.GetTrieNode(ProposalKey(scAddress, id), p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Proposal) Save(scAddress string, balances cstate.StateContextI) error {
+	_, err := balances.InsertTrieNode(ProposalKey(scAddress, p.ID), p)
+	return err
+}
+
+// AddVote to the open proposal, the former vote of the voter is replaced
+// and the new voters can't exceed the max voters of the proposal
+func (p *Proposal) AddVote(v *Vote) error {
+	if p.Status != ProposalOpen || v.Timestamp > p.VotingEnd {
+		return errors.New("voting is closed")
+	}
+	for i, former := range p.Votes {
+		if former.VoterID != v.VoterID {
+			continue
+		}
+		if err := p.Tally.sub(former); err != nil {
+			return err
+		}
+		p.Votes[i] = v
+		return p.Tally.add(v)
+	}
+	if len(p.Votes) >= p.MaxVoters {
+		return fmt.Errorf("max voters reached: %d", p.MaxVoters)
+	}
+	p.Votes = append(p.Votes, v)
+	return p.Tally.add(v)
+}
+
+// Recount the tally with the votes weighted with the lesser of the stake at
+// the vote time and the current stake, so the stake unlocked after the vote
+// doesn't count and can't vote again from another wallet
+func (p *Proposal) Recount(balances cstate.CommonStateContextI) (tally Tally, err error) {
+	for _, v := range p.Votes {
+		weight, err := Weight(v.VoterID, v.Stakes, balances)
+		if err != nil {
+			return Tally{}, err
+		}
+		if weight > v.Weight {
+			weight = v.Weight
+		}
+		if err := tally.add(&Vote{Approve: v.Approve, Weight: weight}); err != nil {
+			return Tally{}, err
+		}
+	}
+	return tally, nil
+}
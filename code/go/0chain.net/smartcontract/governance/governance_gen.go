@@ -0,0 +1,664 @@
+package governance
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Config) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 7
+	// string "Council"
+	o = append(o, 0x87, 0xa7, 0x43, 0x6f, 0x75, 0x6e, 0x63, 0x69, 0x6c)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Council)))
+	for za0001 := range z.Council {
+		o = msgp.AppendString(o, z.Council[za0001])
+	}
+	// string "Quorum"
+	o = append(o, 0xa6, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d)
+	o, err = z.Quorum.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Quorum")
+		return
+	}
+	// string "Threshold"
+	o = append(o, 0xa9, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64)
+	o = msgp.AppendFloat64(o, z.Threshold)
+	// string "VotingPeriod"
+	o = append(o, 0xac, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64)
+	o = msgp.AppendDuration(o, z.VotingPeriod)
+	// string "Timelock"
+	o = append(o, 0xa8, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b)
+	o = msgp.AppendDuration(o, z.Timelock)
+	// string "MinStake"
+	o = append(o, 0xa8, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65)
+	o, err = z.MinStake.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "MinStake")
+		return
+	}
+	// string "MaxVoters"
+	o = append(o, 0xa9, 0x4d, 0x61, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73)
+	o = msgp.AppendInt(o, z.MaxVoters)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Config) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Council":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Council")
+				return
+			}
+			if cap(z.Council) >= int(zb0002) {
+				z.Council = (z.Council)[:zb0002]
+			} else {
+				z.Council = make([]string, zb0002)
+			}
+			for za0001 := range z.Council {
+				z.Council[za0001], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Council", za0001)
+					return
+				}
+			}
+		case "Quorum":
+			bts, err = z.Quorum.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Quorum")
+				return
+			}
+		case "Threshold":
+			z.Threshold, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Threshold")
+				return
+			}
+		case "VotingPeriod":
+			z.VotingPeriod, bts, err = msgp.ReadDurationBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VotingPeriod")
+				return
+			}
+		case "Timelock":
+			z.Timelock, bts, err = msgp.ReadDurationBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Timelock")
+				return
+			}
+		case "MinStake":
+			bts, err = z.MinStake.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinStake")
+				return
+			}
+		case "MaxVoters":
+			z.MaxVoters, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxVoters")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Config) Msgsize() (s int) {
+	s = 1 + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Council {
+		s += msgp.StringPrefixSize + len(z.Council[za0001])
+	}
+	s += 7 + z.Quorum.Msgsize() + 10 + msgp.Float64Size + 13 + msgp.DurationSize + 9 + msgp.DurationSize + 9 + z.MinStake.Msgsize() + 10 + msgp.IntSize
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Proposal) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 10
+	// string "ID"
+	o = append(o, 0x8a, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "ProposerID"
+	o = append(o, 0xaa, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ProposerID)
+	// string "Changes"
+	o = append(o, 0xa7, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73)
+	o, err = z.Changes.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Changes")
+		return
+	}
+	// string "Created"
+	o = append(o, 0xa7, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64)
+	o, err = z.Created.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Created")
+		return
+	}
+	// string "VotingEnd"
+	o = append(o, 0xa9, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64)
+	o, err = z.VotingEnd.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "VotingEnd")
+		return
+	}
+	// string "ExecutableAt"
+	o = append(o, 0xac, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74)
+	o, err = z.ExecutableAt.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ExecutableAt")
+		return
+	}
+	// string "Status"
+	o = append(o, 0xa6, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73)
+	o = msgp.AppendInt(o, z.Status)
+	// string "MaxVoters"
+	o = append(o, 0xa9, 0x4d, 0x61, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73)
+	o = msgp.AppendInt(o, z.MaxVoters)
+	// string "Tally"
+	o = append(o, 0xa5, 0x54, 0x61, 0x6c, 0x6c, 0x79)
+	// map header, size 2
+	// string "Yes"
+	o = append(o, 0x82, 0xa3, 0x59, 0x65, 0x73)
+	o, err = z.Tally.Yes.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Tally", "Yes")
+		return
+	}
+	// string "No"
+	o = append(o, 0xa2, 0x4e, 0x6f)
+	o, err = z.Tally.No.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Tally", "No")
+		return
+	}
+	// string "Votes"
+	o = append(o, 0xa5, 0x56, 0x6f, 0x74, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Votes)))
+	for za0001 := range z.Votes {
+		if z.Votes[za0001] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Votes[za0001].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Votes", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Proposal) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "ProposerID":
+			z.ProposerID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ProposerID")
+				return
+			}
+		case "Changes":
+			bts, err = z.Changes.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Changes")
+				return
+			}
+		case "Created":
+			bts, err = z.Created.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Created")
+				return
+			}
+		case "VotingEnd":
+			bts, err = z.VotingEnd.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VotingEnd")
+				return
+			}
+		case "ExecutableAt":
+			bts, err = z.ExecutableAt.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ExecutableAt")
+				return
+			}
+		case "Status":
+			z.Status, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		case "MaxVoters":
+			z.MaxVoters, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxVoters")
+				return
+			}
+		case "Tally":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Tally")
+				return
+			}
+			for zb0002 > 0 {
+				zb0002--
+				field, bts, err = msgp.ReadMapKeyZC(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Tally")
+					return
+				}
+				switch msgp.UnsafeString(field) {
+				case "Yes":
+					bts, err = z.Tally.Yes.UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Tally", "Yes")
+						return
+					}
+				case "No":
+					bts, err = z.Tally.No.UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Tally", "No")
+						return
+					}
+				default:
+					bts, err = msgp.Skip(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Tally")
+						return
+					}
+				}
+			}
+		case "Votes":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Votes")
+				return
+			}
+			if cap(z.Votes) >= int(zb0003) {
+				z.Votes = (z.Votes)[:zb0003]
+			} else {
+				z.Votes = make([]*Vote, zb0003)
+			}
+			for za0001 := range z.Votes {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Votes[za0001] = nil
+				} else {
+					if z.Votes[za0001] == nil {
+						z.Votes[za0001] = new(Vote)
+					}
+					bts, err = z.Votes[za0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Votes", za0001)
+						return
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Proposal) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 11 + msgp.StringPrefixSize + len(z.ProposerID) + 8 + z.Changes.Msgsize() + 8 + z.Created.Msgsize() + 10 + z.VotingEnd.Msgsize() + 13 + z.ExecutableAt.Msgsize() + 7 + msgp.IntSize + 10 + msgp.IntSize + 6 + 1 + 4 + z.Tally.Yes.Msgsize() + 3 + z.Tally.No.Msgsize() + 6 + msgp.ArrayHeaderSize
+	for za0001 := range z.Votes {
+		if z.Votes[za0001] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Votes[za0001].Msgsize()
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *StakeRef) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "ProviderType"
+	o = append(o, 0x82, 0xac, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65)
+	o, err = z.ProviderType.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ProviderType")
+		return
+	}
+	// string "ProviderID"
+	o = append(o, 0xaa, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ProviderID)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *StakeRef) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ProviderType":
+			bts, err = z.ProviderType.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ProviderType")
+				return
+			}
+		case "ProviderID":
+			z.ProviderID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ProviderID")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *StakeRef) Msgsize() (s int) {
+	s = 1 + 13 + z.ProviderType.Msgsize() + 11 + msgp.StringPrefixSize + len(z.ProviderID)
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Tally) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Yes"
+	o = append(o, 0x82, 0xa3, 0x59, 0x65, 0x73)
+	o, err = z.Yes.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Yes")
+		return
+	}
+	// string "No"
+	o = append(o, 0xa2, 0x4e, 0x6f)
+	o, err = z.No.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "No")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Tally) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Yes":
+			bts, err = z.Yes.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Yes")
+				return
+			}
+		case "No":
+			bts, err = z.No.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "No")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Tally) Msgsize() (s int) {
+	s = 1 + 4 + z.Yes.Msgsize() + 3 + z.No.Msgsize()
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Vote) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "VoterID"
+	o = append(o, 0x85, 0xa7, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.VoterID)
+	// string "Approve"
+	o = append(o, 0xa7, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65)
+	o = msgp.AppendBool(o, z.Approve)
+	// string "Weight"
+	o = append(o, 0xa6, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74)
+	o, err = z.Weight.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Weight")
+		return
+	}
+	// string "Stakes"
+	o = append(o, 0xa6, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Stakes)))
+	for za0001 := range z.Stakes {
+		// map header, size 2
+		// string "ProviderType"
+		o = append(o, 0x82, 0xac, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65)
+		o, err = z.Stakes[za0001].ProviderType.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Stakes", za0001, "ProviderType")
+			return
+		}
+		// string "ProviderID"
+		o = append(o, 0xaa, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44)
+		o = msgp.AppendString(o, z.Stakes[za0001].ProviderID)
+	}
+	// string "Timestamp"
+	o = append(o, 0xa9, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+	o, err = z.Timestamp.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Timestamp")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Vote) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "VoterID":
+			z.VoterID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VoterID")
+				return
+			}
+		case "Approve":
+			z.Approve, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Approve")
+				return
+			}
+		case "Weight":
+			bts, err = z.Weight.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Weight")
+				return
+			}
+		case "Stakes":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Stakes")
+				return
+			}
+			if cap(z.Stakes) >= int(zb0002) {
+				z.Stakes = (z.Stakes)[:zb0002]
+			} else {
+				z.Stakes = make([]StakeRef, zb0002)
+			}
+			for za0001 := range z.Stakes {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Stakes", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Stakes", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ProviderType":
+						bts, err = z.Stakes[za0001].ProviderType.UnmarshalMsg(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Stakes", za0001, "ProviderType")
+							return
+						}
+					case "ProviderID":
+						z.Stakes[za0001].ProviderID, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Stakes", za0001, "ProviderID")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Stakes", za0001)
+							return
+						}
+					}
+				}
+			}
+		case "Timestamp":
+			bts, err = z.Timestamp.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Vote) Msgsize() (s int) {
+	s = 1 + 8 + msgp.StringPrefixSize + len(z.VoterID) + 8 + msgp.BoolSize + 7 + z.Weight.Msgsize() + 7 + msgp.ArrayHeaderSize
+	for za0001 := range z.Stakes {
+		s += 1 + 13 + z.Stakes[za0001].ProviderType.Msgsize() + 11 + msgp.StringPrefixSize + len(z.Stakes[za0001].ProviderID)
+	}
+	s += 10 + z.Timestamp.Msgsize()
+	return
+}
@@ -0,0 +1,102 @@
+package governance
+
+import (
+	"testing"
+	"time"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/core/config"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalVoting(t *testing.T) {
+	c := &Config{Quorum: 10, Threshold: 0.66, VotingPeriod: time.Minute, MaxVoters: 2}
+	require.NoError(t, c.Validate())
+	p := NewProposal("id", "proposer", config.StringMap{}, 100, c)
+	require.EqualValues(t, 160, p.VotingEnd)
+	require.EqualValues(t, 2, p.MaxVoters)
+
+	require.NoError(t, p.AddVote(&Vote{VoterID: "a", Approve: true, Weight: 6, Timestamp: 110}))
+	require.False(t, p.Tally.Passed(c), "below the quorum")
+	require.NoError(t, p.AddVote(&Vote{VoterID: "b", Approve: false, Weight: 4, Timestamp: 120}))
+	require.False(t, p.Tally.Passed(c), "below the threshold")
+	// the vote again replaces the former one
+	require.NoError(t, p.AddVote(&Vote{VoterID: "b", Approve: true, Weight: 4, Timestamp: 130}))
+	require.Len(t, p.Votes, 2)
+	require.Equal(t, Tally{Yes: 10}, p.Tally)
+	require.True(t, p.Tally.Passed(c))
+	require.EqualError(t, p.AddVote(&Vote{VoterID: "c", Weight: 1, Timestamp: 140}), "max voters reached: 2")
+	require.EqualError(t, p.AddVote(&Vote{VoterID: "a", Weight: 1, Timestamp: 161}), "voting is closed")
+}
+
+func TestConfig(t *testing.T) {
+	c := &Config{Threshold: 0.5, VotingPeriod: time.Minute, MaxVoters: 1}
+	require.EqualError(t, c.Validate(), "quorum must be positive")
+
+	for key, value := range map[string]string{
+		Council:      "f769ccdf8587b8cab6a0f6a8a5a0a91d3405392768f283c80a45d6023a1bfa1f",
+		Quorum:       "10",
+		Threshold:    "0.66",
+		VotingPeriod: "72h",
+		Timelock:     "24h",
+		MinStake:     "1",
+		MaxVoters:    "100",
+	} {
+		require.NoError(t, c.Set(key, value))
+	}
+	require.NoError(t, c.Validate())
+	require.Equal(t, Config{
+		Council:      []string{"f769ccdf8587b8cab6a0f6a8a5a0a91d3405392768f283c80a45d6023a1bfa1f"},
+		Quorum:       10e10,
+		Threshold:    0.66,
+		VotingPeriod: 72 * time.Hour,
+		Timelock:     24 * time.Hour,
+		MinStake:     1e10,
+		MaxVoters:    100,
+	}, *c)
+	require.Equal(t, "10", c.Fields()[Prefix+Quorum])
+
+	require.Error(t, c.Set(Council, "not hex"))
+	require.EqualError(t, c.Set("unknown", "1"), "unknown governance setting unknown")
+}
+
+func TestCheckOwnerChanges(t *testing.T) {
+	require.NoError(t, CheckOwnerChanges(config.StringMap{Fields: map[string]string{"max_n": "10"}}))
+	require.Equal(t, ErrOwnerChanges, CheckOwnerChanges(config.StringMap{Fields: map[string]string{
+		"max_n":          "10",
+		Prefix + Council: "f769ccdf8587b8cab6a0f6a8a5a0a91d3405392768f283c80a45d6023a1bfa1f",
+	}}))
+}
+
+func TestWeight(t *testing.T) {
+	RegisterStakePools(spenum.Blobber, func(providerID string, _ cstate.CommonStateContextI) (stakepool.AbstractStakePool, error) {
+		if providerID == "gone" {
+			return nil, util.ErrValueNotPresent
+		}
+		sp := stakepool.NewStakePool()
+		sp.Pools["voter"] = &stakepool.DelegatePool{Balance: 5, Status: spenum.Active}
+		sp.Pools["unbonding"] = &stakepool.DelegatePool{Balance: 5, Status: spenum.Unbonding}
+		return sp, nil
+	})
+	defer delete(stakePools, spenum.Blobber)
+
+	stakes := []StakeRef{
+		{ProviderType: spenum.Blobber, ProviderID: "b1"},
+		{ProviderType: spenum.Blobber, ProviderID: "b1"},
+		{ProviderType: spenum.Blobber, ProviderID: "b2"},
+		{ProviderType: spenum.Blobber, ProviderID: "gone"},
+	}
+	weight, err := Weight("voter", stakes, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, weight)
+	weight, err = Weight("unbonding", stakes, nil)
+	require.NoError(t, err)
+	require.Zero(t, weight)
+
+	require.NoError(t, validateStakes(stakes))
+	require.EqualError(t, validateStakes([]StakeRef{{ProviderType: spenum.Miner, ProviderID: "m"}}),
+		"m is not a staked provider")
+}
@@ -0,0 +1,262 @@
+package governance
+
+import (
+	"encoding/json"
+	"errors"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+)
+
+// Settings of the smart contract changed by the passed proposals
+type Settings interface {
+	// Config of the governance of the smart contract
+	Config(balances cstate.StateContextI) (*Config, error)
+	// Validate the changes on the current settings
+	Validate(changes config.StringMap, balances cstate.StateContextI) error
+	// Apply the changes of the passed proposal to the settings
+	Apply(changes config.StringMap, balances cstate.StateContextI) error
+}
+
+type ProposeRequest struct {
+	Changes config.StringMap `json:"changes"`
+	Stakes  []StakeRef       `json:"stakes"`
+}
+
+func (r *ProposeRequest) Encode() []byte {
+	buff, _ := json.Marshal(r)
+	return buff
+}
+
+func (r *ProposeRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if len(r.Changes.Fields) == 0 {
+		return errors.New("missing changes")
+	}
+	return validateStakes(r.Stakes)
+}
+
+type VoteRequest struct {
+	ProposalID string     `json:"proposal_id"`
+	Approve    bool       `json:"approve"`
+	Stakes     []StakeRef `json:"stakes"`
+}
+
+func (r *VoteRequest) Encode() []byte {
+	buff, _ := json.Marshal(r)
+	return buff
+}
+
+func (r *VoteRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.ProposalID == "" {
+		return errors.New("missing proposal_id")
+	}
+	return validateStakes(r.Stakes)
+}
+
+type ExecuteRequest struct {
+	ProposalID string `json:"proposal_id"`
+}
+
+func (r *ExecuteRequest) Encode() []byte {
+	buff, _ := json.Marshal(r)
+	return buff
+}
+
+func (r *ExecuteRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.ProposalID == "" {
+		return errors.New("missing proposal_id")
+	}
+	return nil
+}
+
+func toJson(val interface{}) string {
+	buff, _ := json.Marshal(val)
+	return string(buff)
+}
+
+// Propose the settings changes of the smart contract, the proposer is a
+// council member or a staker with the min stake
+func Propose(
+	t *transaction.Transaction,
+	input []byte,
+	scAddress string,
+	s Settings,
+	balances cstate.StateContextI,
+) (string, error) {
+	gc, err := s.Config(balances)
+	if err != nil {
+		return "", common.NewErrorf("governance_propose_failed",
+			"can't get config: %v", err)
+	}
+
+	var req ProposeRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("governance_propose_failed",
+			"invalid request: "+err.Error())
+	}
+
+	if !gc.IsCouncil(t.ClientID) {
+		weight, err := Weight(t.ClientID, req.Stakes, balances)
+		if err != nil {
+			return "", common.NewError("governance_propose_failed", err.Error())
+		}
+		if weight == 0 {
+			return "", common.NewError("governance_propose_failed",
+				"proposer is neither a council member nor a staker")
+		}
+		if weight < gc.MinStake {
+			return "", common.NewErrorf("governance_propose_failed",
+				"stake %v is less than min stake %v", weight, gc.MinStake)
+		}
+	}
+
+	if err := s.Validate(req.Changes, balances); err != nil {
+		return "", common.NewError("governance_propose_failed",
+			"invalid changes: "+err.Error())
+	}
+
+	p := NewProposal(t.Hash, t.ClientID, req.Changes, t.CreationDate, gc)
+	if err := p.Save(scAddress, balances); err != nil {
+		return "", common.NewError("governance_propose_failed",
+			"saving proposal: "+err.Error())
+	}
+	if err := p.Emit(scAddress, balances); err != nil {
+		return "", common.NewError("governance_propose_failed", err.Error())
+	}
+	return toJson(p), nil
+}
+
+// CastVote on the open proposal of the smart contract with the stake of the
+// voter at the vote time, a vote again replaces the former one. The stake is
+// recounted on the execution.
+func CastVote(
+	t *transaction.Transaction,
+	input []byte,
+	scAddress string,
+	s Settings,
+	balances cstate.StateContextI,
+) (string, error) {
+	gc, err := s.Config(balances)
+	if err != nil {
+		return "", common.NewErrorf("governance_vote_failed",
+			"can't get config: %v", err)
+	}
+
+	var req VoteRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("governance_vote_failed",
+			"invalid request: "+err.Error())
+	}
+
+	p, err := GetProposal(scAddress, req.ProposalID, balances)
+	if err != nil {
+		return "", common.NewError("governance_vote_failed",
+			"can't get proposal: "+err.Error())
+	}
+
+	weight, err := Weight(t.ClientID, req.Stakes, balances)
+	if err != nil {
+		return "", common.NewError("governance_vote_failed", err.Error())
+	}
+	if weight == 0 {
+		return "", common.NewError("governance_vote_failed", "no stake to vote with")
+	}
+	if weight < gc.MinStake {
+		return "", common.NewErrorf("governance_vote_failed",
+			"stake %v is less than min stake %v", weight, gc.MinStake)
+	}
+
+	v := &Vote{
+		VoterID:   t.ClientID,
+		Approve:   req.Approve,
+		Weight:    weight,
+		Stakes:    req.Stakes,
+		Timestamp: t.CreationDate,
+	}
+	if err := p.AddVote(v); err != nil {
+		return "", common.NewError("governance_vote_failed", err.Error())
+	}
+	if err := p.Save(scAddress, balances); err != nil {
+		return "", common.NewError("governance_vote_failed",
+			"saving proposal: "+err.Error())
+	}
+	if err := p.Emit(scAddress, balances); err != nil {
+		return "", common.NewError("governance_vote_failed", err.Error())
+	}
+	p.EmitVote(v, balances)
+	return toJson(v), nil
+}
+
+// Execute the proposal of the smart contract after the voting, the votes are
+// recounted with the current stake, the rejected proposal is closed and the
+// passed one is applied to the settings after the timelock. Anyone can do it.
+func Execute(
+	t *transaction.Transaction,
+	input []byte,
+	scAddress string,
+	s Settings,
+	balances cstate.StateContextI,
+) (string, error) {
+	gc, err := s.Config(balances)
+	if err != nil {
+		return "", common.NewErrorf("governance_execute_failed",
+			"can't get config: %v", err)
+	}
+
+	var req ExecuteRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("governance_execute_failed",
+			"invalid request: "+err.Error())
+	}
+
+	p, err := GetProposal(scAddress, req.ProposalID, balances)
+	if err != nil {
+		return "", common.NewError("governance_execute_failed",
+			"can't get proposal: "+err.Error())
+	}
+	switch {
+	case p.Status != ProposalOpen:
+		return "", common.NewError("governance_execute_failed",
+			"proposal is closed")
+	case t.CreationDate <= p.VotingEnd:
+		return "", common.NewErrorf("governance_execute_failed",
+			"voting is open until %v", p.VotingEnd)
+	}
+
+	if p.Tally, err = p.Recount(balances); err != nil {
+		return "", common.NewError("governance_execute_failed",
+			"recounting votes: "+err.Error())
+	}
+	if !p.Tally.Passed(gc) {
+		p.Status = ProposalRejected
+	} else {
+		if t.CreationDate < p.ExecutableAt {
+			return "", common.NewErrorf("governance_execute_failed",
+				"proposal is timelocked until %v", p.ExecutableAt)
+		}
+		if err := s.Apply(p.Changes, balances); err != nil {
+			return "", common.NewError("governance_execute_failed", err.Error())
+		}
+		p.Status = ProposalExecuted
+	}
+
+	if err := p.Save(scAddress, balances); err != nil {
+		return "", common.NewError("governance_execute_failed",
+			"saving proposal: "+err.Error())
+	}
+	if err := p.Emit(scAddress, balances); err != nil {
+		return "", common.NewError("governance_execute_failed", err.Error())
+	}
+	return toJson(p), nil
+}
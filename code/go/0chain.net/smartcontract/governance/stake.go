@@ -0,0 +1,70 @@
+package governance
+
+import (
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+)
+
+// MaxStakes is the most stake pools a vote is weighted with
+const MaxStakes = 20
+
+// stakePools of the providers, registered by the smart contracts of the
+// providers
+var stakePools = make(map[spenum.Provider]func(providerID string, balances cstate.CommonStateContextI) (stakepool.AbstractStakePool, error))
+
+// RegisterStakePools registers the stake pools of the provider type the
+// proposers and the voters stake with
+func RegisterStakePools(providerType spenum.Provider,
+	get func(providerID string, balances cstate.CommonStateContextI) (stakepool.AbstractStakePool, error)) {
+	stakePools[providerType] = get
+}
+
+func validateStakes(stakes []StakeRef) error {
+	if len(stakes) > MaxStakes {
+		return fmt.Errorf("more than %d stakes", MaxStakes)
+	}
+	for _, s := range stakes {
+		if _, ok := stakePools[s.ProviderType]; !ok {
+			return fmt.Errorf("%s is not a staked provider", s.ProviderID)
+		}
+	}
+	return nil
+}
+
+// Weight is the stake of the voter in the active delegate pools of the
+// stake pools, the stake pools no longer existing don't count
+func Weight(voterID string, stakes []StakeRef, balances cstate.CommonStateContextI) (weight currency.Coin, err error) {
+	seen := make(map[StakeRef]bool, len(stakes))
+	for _, s := range stakes {
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+
+		get, ok := stakePools[s.ProviderType]
+		if !ok {
+			return 0, fmt.Errorf("%s is not a staked provider", s.ProviderID)
+		}
+		sp, err := get(s.ProviderID, balances)
+		switch err {
+		case nil:
+		case util.ErrValueNotPresent:
+			continue
+		default:
+			return 0, fmt.Errorf("can't get stake pool of %s: %v", s.ProviderID, err)
+		}
+		dp, ok := sp.GetPools()[voterID]
+		if !ok || dp.Status != spenum.Active {
+			continue
+		}
+		if weight, err = currency.AddCoin(weight, dp.Balance); err != nil {
+			return 0, err
+		}
+	}
+	return weight, nil
+}
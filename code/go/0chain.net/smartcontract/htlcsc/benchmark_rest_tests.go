@@ -0,0 +1,38 @@
+package htlcsc
+
+import (
+	benchmark "0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/rest"
+)
+
+func BenchmarkRestTests(
+	data benchmark.BenchData, _ benchmark.SignatureScheme,
+) benchmark.TestSuite {
+	rh := rest.NewRestHandler(&rest.TestQueryChainer{})
+	hrh := NewHTLCRestHandler(rh)
+	return benchmark.GetRestTests(
+		[]benchmark.TestParameters{
+			{
+				FuncName: "htlc-config",
+				Endpoint: hrh.getConfig,
+			},
+			{
+				FuncName: "htlc",
+				Params: map[string]string{
+					"hash_lock": getMockHashLock(0),
+				},
+				Endpoint: hrh.getHTLC,
+			},
+			{
+				FuncName: "participant-htlcs",
+				Params: map[string]string{
+					"client_id": data.Clients[0],
+				},
+				Endpoint: hrh.getParticipantHTLCs,
+			},
+		},
+		ADDRESS,
+		hrh,
+		benchmark.HTLCRest,
+	)
+}
@@ -0,0 +1,117 @@
+package htlcsc
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+
+	cstate "0chain.net/chaincore/chain/state"
+	config2 "0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/viper"
+	"0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+const (
+	mockHTLCAmount       = 1e10
+	mockHTLCTimeLock     = time.Hour
+	mockGovernanceWeight = 100e10
+)
+
+func AddMockConfig(balances cstate.StateContextI) {
+	var (
+		conf config
+		err  error
+	)
+	conf.OwnerId = viper.GetString(benchmark.HTLCOwner)
+	conf.MinLock, err = currency.MultFloat64(1e10, viper.GetFloat64(benchmark.HTLCMinLock))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	conf.MinTimeLock = viper.GetDuration(benchmark.HTLCMinTimeLock)
+	conf.MaxTimeLock = viper.GetDuration(benchmark.HTLCMaxTimeLock)
+	conf.Governance, err = governance.ReadConfig(benchmark.SmartContract + benchmark.HTLCSc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), &conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// AddMockGovernanceProposals adds the open proposal to vote on and the one
+// voted by the first client to execute, the client votes with the stake in
+// the first miner
+func AddMockGovernanceProposals(
+	clients, miners []string,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	governance.AddMockProposals(ADDRESS, clients[0], clients[0], mockGovernanceWeight,
+		[]governance.StakeRef{{ProviderType: spenum.Miner, ProviderID: miners[0]}},
+		config2.StringMap{Fields: map[string]string{Settings[MaxTimeLock]: "2h"}},
+		eventDb, balances)
+}
+
+func mockGovernanceStakes(data benchmark.BenchData) []governance.StakeRef {
+	return []governance.StakeRef{{
+		ProviderType: spenum.Miner,
+		ProviderID:   data.Miners[0],
+	}}
+}
+
+// AddMockHTLCs adds a contract sent by each client to the next client,
+// the contracts of odd clients are expired.
+func AddMockHTLCs(
+	clients []string,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	now := balances.GetTransaction().CreationDate
+	htlcs := make([]event.HTLC, 0, len(clients))
+	for i := 0; i < len(clients); i++ {
+		hashLock, err := hashLockOf(getMockPreimage(i))
+		if err != nil {
+			log.Fatal(err)
+		}
+		h := htlc{
+			ID:        getMockHTLCID(i),
+			HashLock:  hashLock,
+			Sender:    clients[i],
+			Recipient: clients[(i+1)%len(clients)],
+			Amount:    mockHTLCAmount,
+			ExpireAt:  now + toSeconds(mockHTLCTimeLock),
+		}
+		if i%2 == 1 {
+			h.ExpireAt = now - 1
+		}
+		if _, err := balances.InsertTrieNode(htlcKey(ADDRESS, h.ID), &h); err != nil {
+			log.Fatal(err)
+		}
+		htlcs = append(htlcs, h.toEvent(Open, ""))
+	}
+	if err := eventDb.Store.Get().Create(&htlcs).Error; err != nil {
+		log.Fatal(err)
+	}
+}
+
+func getMockHTLCID(client int) string {
+	return encryption.Hash("mock htlc" + strconv.Itoa(client))
+}
+
+func getMockPreimage(client int) string {
+	return encryption.Hash("mock preimage" + strconv.Itoa(client))
+}
+
+func getMockHashLock(client int) string {
+	hashLock, _ := hashLockOf(getMockPreimage(client))
+	return hashLock
+}
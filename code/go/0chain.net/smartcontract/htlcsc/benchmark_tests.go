@@ -0,0 +1,185 @@
+package htlcsc
+
+import (
+	"encoding/json"
+	"testing"
+
+	sc "0chain.net/core/config"
+
+	"github.com/spf13/viper"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	sci "0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	bk "0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/governance"
+)
+
+type BenchTest struct {
+	name     string
+	endpoint func(
+		*transaction.Transaction,
+		[]byte,
+		cstate.StateContextI,
+	) (string, error)
+	txn   *transaction.Transaction
+	input []byte
+}
+
+func (bt BenchTest) Name() string {
+	return bt.name
+}
+
+func (bt BenchTest) Transaction() *transaction.Transaction {
+	return &transaction.Transaction{
+		HashIDField: datastore.HashIDField{
+			Hash: bt.txn.Hash,
+		},
+		ClientID:     bt.txn.ClientID,
+		ToClientID:   bt.txn.ToClientID,
+		Value:        bt.txn.Value,
+		CreationDate: bt.txn.CreationDate,
+	}
+}
+
+func (bt BenchTest) Run(balances cstate.TimedQueryStateContext, _ *testing.B) error {
+	_, err := bt.endpoint(bt.Transaction(), bt.input, balances)
+	return err
+}
+
+func BenchmarkTests(
+	data bk.BenchData, _ bk.SignatureScheme,
+) bk.TestSuite {
+	creationTimeRaw := viper.GetInt64("MptCreationTime")
+	creationTime := common.Now()
+	if creationTimeRaw != 0 {
+		creationTime = common.Timestamp(creationTimeRaw)
+	}
+
+	var hsc = HTLCSmartContract{
+		SmartContract: sci.NewSC(ADDRESS),
+	}
+	hsc.setSC(hsc.SmartContract, &smartcontract.BCContext{})
+	var tests = []BenchTest{
+		{
+			name:     "htlc.lock",
+			endpoint: hsc.lock,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				Value:        mockHTLCAmount,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&lockRequest{
+					Recipient: data.Clients[1],
+					HashLock:  encryption.Hash("new mock hash lock"),
+					TimeLock:  mockHTLCTimeLock,
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "htlc.claim",
+			endpoint: hsc.claim,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&claimRequest{
+					ID:       getMockHTLCID(0),
+					Preimage: getMockPreimage(0),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "htlc.refund",
+			endpoint: hsc.refund,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&refundRequest{
+					ID: getMockHTLCID(1),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "htlc.updateConfig",
+			endpoint: hsc.updateConfig,
+			txn: &transaction.Transaction{
+				ClientID:     viper.GetString(bk.HTLCOwner),
+				CreationDate: creationTime,
+			},
+			input: (&sc.StringMap{
+				Fields: map[string]string{
+					Settings[MinLock]:     "1",
+					Settings[MinTimeLock]: "2s",
+					Settings[MaxTimeLock]: "3h",
+				},
+			}).Encode(),
+		},
+		{
+			name:     "htlc.governance-propose",
+			endpoint: hsc.governancePropose,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ProposeRequest{
+				Changes: sc.StringMap{
+					Fields: map[string]string{Settings[MaxTimeLock]: "3h"},
+				},
+				Stakes: mockGovernanceStakes(data),
+			}).Encode(),
+		},
+		{
+			name:     "htlc.governance-vote",
+			endpoint: hsc.governanceVote,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.VoteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 0),
+				Approve:    true,
+				Stakes:     mockGovernanceStakes(data),
+			}).Encode(),
+		},
+		{
+			name:     "htlc.governance-execute",
+			endpoint: hsc.governanceExecute,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ExecuteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 1),
+			}).Encode(),
+		},
+	}
+	var testsI []bk.BenchTestI
+	for _, test := range tests {
+		testsI = append(testsI, test)
+	}
+	return bk.TestSuite{
+		Source:     bk.HTLC,
+		Benchmarks: testsI,
+	}
+}
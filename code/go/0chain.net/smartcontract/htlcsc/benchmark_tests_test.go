@@ -0,0 +1,24 @@
+package htlcsc
+
+import (
+	"0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/benchmark/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHTLCBenchmarkTests(t *testing.T) {
+	mockSigScheme := &mocks.SignatureScheme{}
+	mockSigScheme.On("SetPublicKey", mock.Anything).Return(nil)
+	mockSigScheme.On("SetPrivateKey", mock.Anything).Return()
+	mockSigScheme.On("Sign", mock.Anything).Return("", nil)
+
+	hsc := NewHTLCSmartContract()
+
+	require.EqualValues(
+		t,
+		len(hsc.GetExecutionStats()),
+		len(BenchmarkTests(benchmark.MockBenchData, mockSigScheme).Benchmarks),
+	)
+}
@@ -0,0 +1,313 @@
+package htlcsc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	config2 "0chain.net/core/config"
+	"github.com/0chain/common/core/currency"
+
+	"0chain.net/chaincore/smartcontractinterface"
+
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"github.com/0chain/common/core/util"
+
+	"0chain.net/smartcontract/governance"
+
+	chainstate "0chain.net/chaincore/chain/state"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+type Setting int
+
+const (
+	MinLock Setting = iota
+	MinTimeLock
+	MaxTimeLock
+	OwnerId
+	Cost
+)
+
+var (
+	Settings = []string{
+		"min_lock",
+		"min_time_lock",
+		"max_time_lock",
+		"owner_id",
+		"cost",
+	}
+
+	costFunctions = []string{
+		"lock",
+		"claim",
+		"refund",
+		"htlcsc-update-settings",
+	}
+)
+
+func scConfigKey(scKey string) datastore.Key {
+	return scKey + encryption.Hash("htlcsc_config")
+}
+
+// config represents SC configurations ('htlcsc:' from sc.yaml)
+type config struct {
+	MinLock     currency.Coin  `json:"min_lock"`
+	MinTimeLock time.Duration  `json:"min_time_lock"`
+	MaxTimeLock time.Duration  `json:"max_time_lock"`
+	OwnerId     string         `json:"owner_id"`
+	Cost        map[string]int `json:"cost"`
+	// Governance of the htlc settings changes
+	Governance governance.Config `json:"governance"`
+}
+
+func (c *config) validate() (err error) {
+	switch {
+	case toSeconds(c.MinTimeLock) < 1:
+		return errors.New("invalid min_time_lock (< 1s)")
+	case toSeconds(c.MaxTimeLock) <= toSeconds(c.MinTimeLock):
+		return errors.New("invalid max_time_lock: less or equal to min_time_lock")
+	case c.OwnerId == "":
+		return errors.New("owner_id is not set or empty")
+	}
+	if err = c.Governance.Validate(); err != nil {
+		return fmt.Errorf("invalid governance: %v", err)
+	}
+	return
+}
+
+func (c *config) Encode() (b []byte) {
+	var err error
+	if b, err = json.Marshal(c); err != nil {
+		panic(err) // must not happens
+	}
+	return
+}
+
+func (c *config) Decode(b []byte) error {
+	return json.Unmarshal(b, c)
+}
+
+func (c *config) update(changes *config2.StringMap) error {
+	for key, value := range changes.Fields {
+		if strings.HasPrefix(key, governance.Prefix) {
+			if err := c.Governance.Set(strings.TrimPrefix(key, governance.Prefix), value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch key {
+		case Settings[MinLock]:
+			if sbValue, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("value %v cannot be converted to currency.Coin, "+
+					"failing to set config key %s", value, key)
+			} else {
+				cMinLock, err := currency.MultFloat64(1e10, sbValue)
+				if err != nil {
+					return err
+				}
+				c.MinLock = cMinLock
+			}
+		case Settings[MinTimeLock]:
+			if dValue, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to time.Duration, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MinTimeLock = dValue
+			}
+		case Settings[MaxTimeLock]:
+			if dValue, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to time.Duration, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxTimeLock = dValue
+			}
+		case Settings[OwnerId]:
+			if _, err := hex.DecodeString(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int with 16 base, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.OwnerId = value
+			}
+
+		default:
+			return c.setCostValue(key, value)
+		}
+	}
+	return nil
+}
+
+func (c *config) setCostValue(key, value string) error {
+	if !strings.HasPrefix(key, Settings[Cost]) {
+		return fmt.Errorf("config setting %s not found", key)
+	}
+
+	costKey := strings.ToLower(strings.TrimPrefix(key, Settings[Cost]+"."))
+	for _, costFunction := range costFunctions {
+		if costKey != strings.ToLower(costFunction) {
+			continue
+		}
+		costValue, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to integer", key, value)
+		}
+
+		if costValue < 0 {
+			return fmt.Errorf("cost.%s contains invalid value %s", key, value)
+		}
+
+		if c.Cost == nil {
+			c.Cost = make(map[string]int)
+		}
+		c.Cost[costKey] = costValue
+
+		return nil
+	}
+
+	return fmt.Errorf("cost config setting %s not found", costKey)
+}
+
+func (c *config) getConfigMap() config2.StringMap {
+	fields := map[string]string{
+		Settings[MinLock]:     fmt.Sprintf("%v", float64(c.MinLock)/1e10),
+		Settings[MinTimeLock]: fmt.Sprintf("%v", c.MinTimeLock),
+		Settings[MaxTimeLock]: fmt.Sprintf("%v", c.MaxTimeLock),
+		Settings[OwnerId]:     fmt.Sprintf("%v", c.OwnerId),
+	}
+
+	for _, key := range costFunctions {
+		fields[fmt.Sprintf("cost.%s", key)] = fmt.Sprintf("%0v", c.Cost[strings.ToLower(key)])
+	}
+	for key, value := range c.Governance.Fields() {
+		fields[key] = value
+	}
+
+	return config2.StringMap{
+		Fields: fields,
+	}
+}
+
+func (hsc *HTLCSmartContract) updateConfig(
+	txn *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (resp string, err error) {
+	var conf *config
+	if conf, err = hsc.getConfig(balances); err != nil {
+		return "", common.NewError("update_config",
+			"can't get config: "+err.Error())
+	}
+
+	if err := smartcontractinterface.AuthorizeWithOwner("update_config", func() bool {
+		return conf.OwnerId == txn.ClientID
+	}); err != nil {
+		return "", err
+	}
+
+	update := &config2.StringMap{}
+	if err = update.Decode(input); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := governance.CheckOwnerChanges(*update); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := conf.update(update); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := conf.validate(); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	if err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	return "", nil
+}
+
+//
+// helpers
+//
+
+// configurations from sc.yaml
+func getConfiguredConfig() (conf *config, err error) {
+	const prefix = "smart_contracts.htlcsc."
+
+	conf = new(config)
+
+	// short hand
+	var scconf = config2.SmartContractConfig
+	conf.MinLock, err = currency.ParseZCN(scconf.GetFloat64(prefix + "min_lock"))
+	if err != nil {
+		return nil, err
+	}
+	conf.MinTimeLock = scconf.GetDuration(prefix + "min_time_lock")
+	conf.MaxTimeLock = scconf.GetDuration(prefix + "max_time_lock")
+	conf.OwnerId = scconf.GetString(prefix + "owner_id")
+	conf.Cost = scconf.GetStringMapInt(prefix + "cost")
+	conf.Governance, err = governance.ReadConfig(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	err = conf.validate()
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func getConfigReadOnly(
+	balances chainstate.CommonStateContextI,
+) (conf *config, err error) {
+	conf = new(config)
+	err = balances.GetTrieNode(scConfigKey(ADDRESS), conf)
+	switch err {
+	case nil:
+		return conf, nil
+	case util.ErrValueNotPresent:
+		if conf, err = getConfiguredConfig(); err != nil {
+			return nil, err
+		}
+		return conf, nil
+	default:
+		return nil, err
+	}
+}
+
+func (hsc *HTLCSmartContract) getConfig(
+	balances chainstate.StateContextI,
+) (conf *config, err error) {
+	conf = new(config)
+	err = balances.GetTrieNode(scConfigKey(ADDRESS), conf)
+	if err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func InitConfig(balances chainstate.StateContextI) error {
+	err := balances.GetTrieNode(scConfigKey(ADDRESS), &config{})
+	if err == util.ErrValueNotPresent {
+		conf, err := getConfiguredConfig()
+		if err != nil {
+			return err
+		}
+		_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+		return err
+	}
+	return err
+}
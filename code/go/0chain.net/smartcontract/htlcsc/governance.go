@@ -0,0 +1,80 @@
+package htlcsc
+
+import (
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	config2 "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+)
+
+// governanceSettings are the htlc settings changed by the governance,
+// the same way htlcsc-update-settings does
+type governanceSettings struct {
+	hsc *HTLCSmartContract
+}
+
+func (s governanceSettings) Config(balances chainstate.StateContextI) (*governance.Config, error) {
+	conf, err := s.hsc.getConfig(balances)
+	if err != nil {
+		return nil, err
+	}
+	return &conf.Governance, nil
+}
+
+func (s governanceSettings) update(changes config2.StringMap, balances chainstate.StateContextI) (*config, error) {
+	conf, err := s.hsc.getConfig(balances)
+	if err != nil {
+		return nil, err
+	}
+	if err := conf.update(&changes); err != nil {
+		return nil, err
+	}
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (s governanceSettings) Validate(changes config2.StringMap, balances chainstate.StateContextI) error {
+	_, err := s.update(changes, balances)
+	return err
+}
+
+func (s governanceSettings) Apply(changes config2.StringMap, balances chainstate.StateContextI) error {
+	conf, err := s.update(changes, balances)
+	if err != nil {
+		return err
+	}
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	return err
+}
+
+// governancePropose submits the proposal of the htlc settings changes,
+// the proposer is a council member or a staker
+func (hsc *HTLCSmartContract) governancePropose(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.Propose(t, input, hsc.ID, governanceSettings{hsc}, balances)
+}
+
+// governanceVote on the open proposal with the stake of the voter, a vote
+// again replaces the former one
+func (hsc *HTLCSmartContract) governanceVote(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.CastVote(t, input, hsc.ID, governanceSettings{hsc}, balances)
+}
+
+// governanceExecute closes the proposal after the voting, the passed one
+// is applied to the settings after the timelock. Anyone can do it.
+func (hsc *HTLCSmartContract) governanceExecute(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.Execute(t, input, hsc.ID, governanceSettings{hsc}, balances)
+}
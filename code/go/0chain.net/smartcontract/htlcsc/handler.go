@@ -0,0 +1,137 @@
+package htlcsc
+
+import (
+	"net/http"
+
+	"0chain.net/core/common"
+	common2 "0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/rest"
+)
+
+type HTLCRestHandler struct {
+	rest.RestHandlerI
+}
+
+func NewHTLCRestHandler(rh rest.RestHandlerI) *HTLCRestHandler {
+	return &HTLCRestHandler{rh}
+}
+
+func SetupRestHandler(rh rest.RestHandlerI) {
+	rh.Register(GetEndpoints(rh))
+}
+
+func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
+	hrh := NewHTLCRestHandler(rh)
+	htlc := "/v1/screst/" + ADDRESS
+	return []rest.Endpoint{
+		rest.MakeEndpoint(htlc+"/htlc", common.UserRateLimit(hrh.getHTLC)),
+		rest.MakeEndpoint(htlc+"/participant-htlcs", common.UserRateLimit(hrh.getParticipantHTLCs)),
+		rest.MakeEndpoint(htlc+"/htlc-config", common.UserRateLimit(hrh.getConfig)),
+	}
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e2/htlc htlc
+// get contracts locked by the hash, including claimed and refunded ones
+//
+// parameters:
+//
+//	+name: hash_lock
+//	 description: hex encoded SHA-256 hash of the preimage
+//	 required: true
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []HTLC
+//	400:
+//	500:
+func (hrh *HTLCRestHandler) getHTLC(w http.ResponseWriter, r *http.Request) {
+	hashLock := r.URL.Query().Get("hash_lock")
+	if !validHashLock(hashLock) {
+		common.Respond(w, r, nil, common.NewErrBadRequest("invalid hash_lock"))
+		return
+	}
+
+	edb := hrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	htlcs, err := edb.GetHTLCsByHashLock(hashLock)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get htlc", err.Error()))
+		return
+	}
+	common.Respond(w, r, htlcs, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e2/participant-htlcs participant-htlcs
+// get contracts the client is sender or recipient of
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: sender or recipient
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []HTLC
+//	400:
+//	500:
+func (hrh *HTLCRestHandler) getParticipantHTLCs(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := hrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	htlcs, err := edb.GetParticipantHTLCs(clientID, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get participant htlcs", err.Error()))
+		return
+	}
+	common.Respond(w, r, htlcs, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e2/htlc-config htlc-config
+// get htlc configuration settings
+//
+// responses:
+//
+//	200: StringMap
+//	500:
+func (hrh *HTLCRestHandler) getConfig(w http.ResponseWriter, r *http.Request) {
+	conf, err := getConfigReadOnly(hrh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get config", err.Error()))
+		return
+	}
+	common.Respond(w, r, conf.getConfigMap(), nil)
+}
@@ -0,0 +1,350 @@
+package htlcsc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+//msgp:ignore lockRequest claimRequest refundRequest
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+// Status of a contract stored in event DB. The MPT keeps only open ones.
+type Status int
+
+const (
+	Open Status = iota
+	Claimed
+	Refunded
+)
+
+// maxPreimageSize limits the preimage, 32 bytes secrets are used by the
+// HTLCs of other chains
+const maxPreimageSize = 64
+
+func toSeconds(dur time.Duration) common.Timestamp {
+	return common.Timestamp(dur / time.Second)
+}
+
+// hashLockOf returns hex encoded SHA-256 of the hex encoded preimage, the
+// same hash function is used by HTLCs of Bitcoin and Ethereum.
+func hashLockOf(preimage string) (string, error) {
+	b, err := hex.DecodeString(preimage)
+	if err != nil {
+		return "", fmt.Errorf("invalid preimage: %v", err)
+	}
+	if len(b) == 0 || len(b) > maxPreimageSize {
+		return "", fmt.Errorf("invalid preimage size %d", len(b))
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func validHashLock(hashLock string) bool {
+	b, err := hex.DecodeString(hashLock)
+	return err == nil && len(b) == sha256.Size
+}
+
+//
+// requests
+//
+
+type lockRequest struct {
+	Recipient string        `json:"recipient"`
+	HashLock  string        `json:"hash_lock"`
+	TimeLock  time.Duration `json:"time_lock"`
+}
+
+func (lr *lockRequest) decode(b []byte) error {
+	return json.Unmarshal(b, lr)
+}
+
+func (lr *lockRequest) validate(t *transaction.Transaction, conf *config) (err error) {
+	switch {
+	case lr.Recipient == "":
+		return errors.New("missing recipient")
+	case lr.Recipient == t.ClientID:
+		return errors.New("sender can't be the recipient")
+	case !validHashLock(lr.HashLock):
+		return errors.New("hash_lock must be hex encoded SHA-256 hash")
+	case lr.TimeLock < conf.MinTimeLock:
+		return errors.New("time_lock is too short")
+	case lr.TimeLock > conf.MaxTimeLock:
+		return errors.New("time_lock is too long")
+	}
+	return
+}
+
+type claimRequest struct {
+	ID       string `json:"id"`
+	Preimage string `json:"preimage"`
+}
+
+func (cr *claimRequest) decode(b []byte) error {
+	return json.Unmarshal(b, cr)
+}
+
+type refundRequest struct {
+	ID string `json:"id"`
+}
+
+func (rr *refundRequest) decode(b []byte) error {
+	return json.Unmarshal(b, rr)
+}
+
+//
+// hash time locked contract
+//
+
+// htlcKey of the contract is its lock transaction hash, the hash lock is
+// chosen by the sender and can be reused by anyone
+func htlcKey(hscKey, id datastore.Key) datastore.Key {
+	return hscKey + ":htlc:" + id
+}
+
+// swagger:model htlc
+type htlc struct {
+	ID        string           `json:"id"` // the lock transaction hash
+	HashLock  string           `json:"hash_lock"`
+	Sender    string           `json:"sender"`
+	Recipient string           `json:"recipient"`
+	Amount    currency.Coin    `json:"amount"`
+	ExpireAt  common.Timestamp `json:"expire_at"`
+}
+
+func (h *htlc) Encode() (b []byte) {
+	var err error
+	if b, err = json.Marshal(h); err != nil {
+		panic(err) // must never happen
+	}
+	return
+}
+
+func (h *htlc) Decode(b []byte) error {
+	return json.Unmarshal(b, h)
+}
+
+func (h *htlc) isExpired(now common.Timestamp) bool {
+	return now >= h.ExpireAt
+}
+
+func (h *htlc) toEvent(status Status, preimage string) event.HTLC {
+	return event.HTLC{
+		HTLCID:    h.ID,
+		HashLock:  h.HashLock,
+		Sender:    h.Sender,
+		Recipient: h.Recipient,
+		Amount:    h.Amount,
+		ExpireAt:  int64(h.ExpireAt),
+		Preimage:  preimage,
+		Status:    int(status),
+	}
+}
+
+// close moves the escrowed tokens to the given client and removes the
+// contract from MPT
+func (h *htlc) close(hscKey datastore.Key, t *transaction.Transaction, to string,
+	status Status, preimage string, balances chainstate.StateContextI) (err error) {
+
+	if err = balances.AddTransfer(state.NewTransfer(t.ToClientID, to, h.Amount)); err != nil {
+		return fmt.Errorf("adding transfer htlc->%s: %v", to, err)
+	}
+	if _, err = balances.DeleteTrieNode(htlcKey(hscKey, h.ID)); err != nil {
+		return fmt.Errorf("deleting htlc: %v", err)
+	}
+	balances.EmitEvent(event.TypeStats, event.TagUpdateHTLC, h.ID,
+		[]event.HTLC{h.toEvent(status, preimage)})
+	return
+}
+
+func checkFill(t *transaction.Transaction, balances chainstate.StateContextI) (
+	err error) {
+
+	var balance currency.Coin
+	balance, err = balances.GetClientBalance(t.ClientID)
+
+	if err != nil && err != util.ErrValueNotPresent {
+		return // unexpected error
+	}
+
+	if err == util.ErrValueNotPresent {
+		return errors.New("no tokens to lock")
+	}
+
+	if t.Value > balance {
+		return errors.New("lock amount is greater than balance")
+	}
+
+	return
+}
+
+//
+// helpers
+//
+
+func (hsc *HTLCSmartContract) getHTLC(
+	id string,
+	balances chainstate.CommonStateContextI,
+) (h *htlc, err error) {
+
+	h = new(htlc)
+	if err = balances.GetTrieNode(htlcKey(hsc.ID, id), h); err != nil {
+		return nil, err
+	}
+	return
+}
+
+//
+// SC functions
+//
+
+// lock escrows the transaction value until the recipient claims it with
+// the preimage of the hash lock, or the time lock expires
+func (hsc *HTLCSmartContract) lock(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var lr lockRequest
+	if err = lr.decode(input); err != nil {
+		return "", common.NewError("htlc_lock_failed",
+			"malformed request: "+err.Error())
+	}
+
+	var conf *config
+	if conf, err = hsc.getConfig(balances); err != nil {
+		return "", common.NewError("htlc_lock_failed",
+			"can't get SC configurations: "+err.Error())
+	}
+
+	if err = lr.validate(t, conf); err != nil {
+		return "", common.NewError("htlc_lock_failed",
+			"invalid request: "+err.Error())
+	}
+
+	if t.Value < conf.MinLock {
+		return "", common.NewError("htlc_lock_failed",
+			"insufficient amount to lock")
+	}
+
+	_, err = hsc.getHTLC(t.Hash, balances)
+	switch err {
+	case util.ErrValueNotPresent:
+	case nil:
+		return "", common.NewError("htlc_lock_failed",
+			"htlc already exists")
+	default:
+		return "", common.NewError("htlc_lock_failed",
+			"can't get htlc: "+err.Error())
+	}
+
+	if err = checkFill(t, balances); err != nil {
+		return "", common.NewError("htlc_lock_failed", err.Error())
+	}
+
+	if err = balances.AddTransfer(state.NewTransfer(t.ClientID, t.ToClientID, t.Value)); err != nil {
+		return "", common.NewError("htlc_lock_failed",
+			"adding transfer: "+err.Error())
+	}
+
+	var h = &htlc{
+		ID:        t.Hash,
+		HashLock:  lr.HashLock,
+		Sender:    t.ClientID,
+		Recipient: lr.Recipient,
+		Amount:    t.Value,
+		ExpireAt:  t.CreationDate + toSeconds(lr.TimeLock),
+	}
+
+	if _, err = balances.InsertTrieNode(htlcKey(hsc.ID, h.ID), h); err != nil {
+		return "", common.NewError("htlc_lock_failed",
+			"can't save htlc: "+err.Error())
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagAddHTLC, h.ID,
+		[]event.HTLC{h.toEvent(Open, "")})
+	return string(h.Encode()), nil
+}
+
+// claim moves the escrowed tokens to the recipient. Anyone knowing the
+// preimage can claim it before the expiration, the tokens are always sent
+// to the recipient.
+func (hsc *HTLCSmartContract) claim(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var cr claimRequest
+	if err = cr.decode(input); err != nil {
+		return "", common.NewError("htlc_claim_failed",
+			"malformed request: "+err.Error())
+	}
+
+	hashLock, err := hashLockOf(cr.Preimage)
+	if err != nil {
+		return "", common.NewError("htlc_claim_failed", err.Error())
+	}
+
+	var h *htlc
+	if h, err = hsc.getHTLC(cr.ID, balances); err != nil {
+		return "", common.NewError("htlc_claim_failed",
+			"can't get htlc: "+err.Error())
+	}
+
+	if h.HashLock != hashLock {
+		return "", common.NewError("htlc_claim_failed",
+			"preimage doesn't match the hash_lock")
+	}
+
+	if h.isExpired(t.CreationDate) {
+		return "", common.NewError("htlc_claim_failed", "htlc is expired")
+	}
+
+	if err = h.close(hsc.ID, t, h.Recipient, Claimed, cr.Preimage, balances); err != nil {
+		return "", common.NewError("htlc_claim_failed", err.Error())
+	}
+
+	return string(h.Encode()), nil
+}
+
+// refund returns the escrowed tokens to the sender after the expiration
+func (hsc *HTLCSmartContract) refund(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var rr refundRequest
+	if err = rr.decode(input); err != nil {
+		return "", common.NewError("htlc_refund_failed",
+			"malformed request: "+err.Error())
+	}
+
+	var h *htlc
+	if h, err = hsc.getHTLC(rr.ID, balances); err != nil {
+		return "", common.NewError("htlc_refund_failed",
+			"can't get htlc: "+err.Error())
+	}
+
+	if h.Sender != t.ClientID {
+		return "", common.NewError("htlc_refund_failed",
+			"only sender can refund the htlc")
+	}
+
+	if !h.isExpired(t.CreationDate) {
+		return "", common.NewErrorf("htlc_refund_failed",
+			"htlc is locked until %d", h.ExpireAt)
+	}
+
+	if err = h.close(hsc.ID, t, h.Sender, Refunded, "", balances); err != nil {
+		return "", common.NewError("htlc_refund_failed", err.Error())
+	}
+
+	return string(h.Encode()), nil
+}
@@ -0,0 +1,173 @@
+package htlcsc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+func newTestHTLCSC() *HTLCSmartContract {
+	hsc := &HTLCSmartContract{SmartContract: new(smartcontractinterface.SmartContract)}
+	hsc.ID = ADDRESS
+	return hsc
+}
+
+func testConfig() *config {
+	return &config{
+		MinLock:     10,
+		MinTimeLock: time.Minute,
+		MaxTimeLock: time.Hour,
+		OwnerId:     encryption.Hash("sc owner"),
+	}
+}
+
+func Test_hashLockOf(t *testing.T) {
+	// sha256 of 0x00
+	hashLock, err := hashLockOf("00")
+	require.NoError(t, err)
+	require.Equal(t, "6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d", hashLock)
+	require.True(t, validHashLock(hashLock))
+
+	_, err = hashLockOf("")
+	require.EqualError(t, err, "invalid preimage size 0")
+	_, err = hashLockOf("zz")
+	require.Error(t, err)
+	require.False(t, validHashLock("00"))
+}
+
+func TestHTLC(t *testing.T) {
+	var (
+		hsc       = newTestHTLCSC()
+		balances  = newTestBalances()
+		sender    = encryption.Hash("sender")
+		recipient = encryption.Hash("recipient")
+		other     = encryption.Hash("other")
+		griefer   = encryption.Hash("griefer")
+		preimage  = encryption.Hash("preimage")
+		now       = common.Timestamp(1000)
+	)
+	hashLock, err := hashLockOf(preimage)
+	require.NoError(t, err)
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), testConfig())
+	require.NoError(t, err)
+	balances.balances[sender] = 1000
+	balances.balances[griefer] = 1000
+
+	call := func(f func(*transaction.Transaction, []byte) (string, error),
+		clientID string, value currency.Coin, now common.Timestamp, req interface{}) (string, error) {
+
+		input, err := json.Marshal(req)
+		require.NoError(t, err)
+		txn := &transaction.Transaction{
+			ClientID:     clientID,
+			ToClientID:   ADDRESS,
+			Value:        value,
+			CreationDate: now,
+		}
+		txn.Hash = encryption.Hash(string(input) + clientID)
+		balances.txn = txn
+		return f(txn, input)
+	}
+	lock := func(in *transaction.Transaction, b []byte) (string, error) { return hsc.lock(in, b, balances) }
+	claim := func(in *transaction.Transaction, b []byte) (string, error) { return hsc.claim(in, b, balances) }
+	refund := func(in *transaction.Transaction, b []byte) (string, error) { return hsc.refund(in, b, balances) }
+
+	lastEvent := func(tag event.EventTag) event.HTLC {
+		require.NotEmpty(t, balances.events)
+		e := balances.events[len(balances.events)-1]
+		require.Equal(t, tag, e.Tag)
+		return e.Data.([]event.HTLC)[0]
+	}
+
+	lr := &lockRequest{
+		Recipient: recipient,
+		HashLock:  hashLock,
+		TimeLock:  10 * time.Minute,
+	}
+
+	t.Run("invalid lock", func(t *testing.T) {
+		_, err := call(lock, sender, 100, now, &lockRequest{Recipient: sender, HashLock: hashLock, TimeLock: time.Minute})
+		require.EqualError(t, err, "htlc_lock_failed: invalid request: sender can't be the recipient")
+		_, err = call(lock, sender, 100, now, &lockRequest{Recipient: recipient, HashLock: preimage[:10], TimeLock: time.Minute})
+		require.EqualError(t, err, "htlc_lock_failed: invalid request: hash_lock must be hex encoded SHA-256 hash")
+		_, err = call(lock, sender, 100, now, &lockRequest{Recipient: recipient, HashLock: hashLock, TimeLock: time.Second})
+		require.EqualError(t, err, "htlc_lock_failed: invalid request: time_lock is too short")
+		_, err = call(lock, sender, 100, now, &lockRequest{Recipient: recipient, HashLock: hashLock, TimeLock: 2 * time.Hour})
+		require.EqualError(t, err, "htlc_lock_failed: invalid request: time_lock is too long")
+		_, err = call(lock, sender, 5, now, lr)
+		require.EqualError(t, err, "htlc_lock_failed: insufficient amount to lock")
+		_, err = call(lock, sender, 2000, now, lr)
+		require.EqualError(t, err, "htlc_lock_failed: lock amount is greater than balance")
+	})
+
+	var h, gh htlc
+	t.Run("lock", func(t *testing.T) {
+		// locking the hash lock first doesn't block the lock of the sender
+		resp, err := call(lock, griefer, 10, now, &lockRequest{Recipient: recipient, HashLock: hashLock, TimeLock: time.Minute})
+		require.NoError(t, err)
+		require.NoError(t, gh.Decode([]byte(resp)))
+
+		resp, err = call(lock, sender, 100, now, lr)
+		require.NoError(t, err)
+		require.NoError(t, h.Decode([]byte(resp)))
+		require.NotEqual(t, gh.ID, h.ID)
+		require.Equal(t, now+600, h.ExpireAt)
+		require.Equal(t, currency.Coin(900), balances.balances[sender])
+		require.Equal(t, currency.Coin(110), balances.balances[ADDRESS])
+		require.Equal(t, int(Open), lastEvent(event.TagAddHTLC).Status)
+
+		_, err = call(lock, sender, 100, now, lr)
+		require.EqualError(t, err, "htlc_lock_failed: htlc already exists")
+	})
+
+	t.Run("refund before expiration", func(t *testing.T) {
+		_, err := call(refund, sender, 0, now+599, &refundRequest{ID: h.ID})
+		require.EqualError(t, err, "htlc_refund_failed: htlc is locked until 1600")
+		_, err = call(refund, other, 0, now+600, &refundRequest{ID: h.ID})
+		require.EqualError(t, err, "htlc_refund_failed: only sender can refund the htlc")
+	})
+
+	t.Run("claim", func(t *testing.T) {
+		_, err := call(claim, other, 0, now, &claimRequest{ID: h.ID, Preimage: encryption.Hash("wrong")})
+		require.EqualError(t, err, "htlc_claim_failed: preimage doesn't match the hash_lock")
+		_, err = call(claim, other, 0, now+600, &claimRequest{ID: h.ID, Preimage: preimage})
+		require.EqualError(t, err, "htlc_claim_failed: htlc is expired")
+
+		_, err = call(claim, other, 0, now+599, &claimRequest{ID: h.ID, Preimage: preimage})
+		require.NoError(t, err)
+		require.Equal(t, currency.Coin(100), balances.balances[recipient])
+		require.Equal(t, currency.Coin(10), balances.balances[ADDRESS])
+		require.Zero(t, balances.balances[other])
+		_, err = hsc.getHTLC(h.ID, balances)
+		require.Error(t, err)
+
+		e := lastEvent(event.TagUpdateHTLC)
+		require.Equal(t, int(Claimed), e.Status)
+		require.Equal(t, preimage, e.Preimage)
+	})
+
+	t.Run("refund", func(t *testing.T) {
+		_, err := call(refund, griefer, 0, now+60, &refundRequest{ID: gh.ID})
+		require.NoError(t, err)
+		require.Equal(t, currency.Coin(1000), balances.balances[griefer])
+
+		resp, err := call(lock, sender, 100, now+1000, lr)
+		require.NoError(t, err)
+		require.NoError(t, h.Decode([]byte(resp)))
+
+		_, err = call(refund, sender, 0, now+1600, &refundRequest{ID: h.ID})
+		require.NoError(t, err)
+		require.Equal(t, currency.Coin(900), balances.balances[sender])
+		require.Zero(t, balances.balances[ADDRESS])
+		require.Equal(t, int(Refunded), lastEvent(event.TagUpdateHTLC).Status)
+	})
+}
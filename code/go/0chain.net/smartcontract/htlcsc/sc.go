@@ -0,0 +1,113 @@
+package htlcsc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"0chain.net/chaincore/smartcontract"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	ADDRESS = "6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e2"
+)
+
+type HTLCSmartContract struct {
+	*smartcontractinterface.SmartContract
+}
+
+func NewHTLCSmartContract() smartcontractinterface.SmartContractInterface {
+	var hscCopy = &HTLCSmartContract{
+		smartcontractinterface.NewSC(ADDRESS),
+	}
+	hscCopy.setSC(hscCopy.SmartContract, &smartcontract.BCContext{})
+	return hscCopy
+}
+
+func (hsc *HTLCSmartContract) GetHandlerStats(ctx context.Context, params url.Values) (interface{}, error) {
+	return hsc.SmartContract.HandlerStats(ctx, params)
+}
+
+func (hsc *HTLCSmartContract) GetExecutionStats() map[string]interface{} {
+	return hsc.SmartContractExecutionStats
+}
+
+func (hsc *HTLCSmartContract) GetName() string {
+	return "htlc"
+}
+
+func (hsc *HTLCSmartContract) GetAddress() string {
+	return ADDRESS
+}
+
+func (hsc *HTLCSmartContract) GetCostTable(balances chainstate.StateContextI) (map[string]int, error) {
+	node, err := hsc.getConfig(balances)
+	if err != nil {
+		return map[string]int{}, err
+	}
+	if node.Cost == nil {
+		return map[string]int{}, err
+	}
+	return node.Cost, nil
+}
+
+func (hsc *HTLCSmartContract) setSC(sc *smartcontractinterface.SmartContract,
+	bcContext smartcontractinterface.BCContextI) {
+
+	hsc.SmartContract = sc
+
+	// escrow tokens {recipient,hash_lock,time_lock}
+	hsc.SmartContractExecutionStats["lock"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "lock"), nil)
+
+	// move escrowed tokens to the recipient revealing the preimage
+	hsc.SmartContractExecutionStats["claim"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "claim"), nil)
+
+	// return escrowed tokens to the sender after expiration
+	hsc.SmartContractExecutionStats["refund"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "refund"), nil)
+
+	hsc.SmartContractExecutionStats["htlcsc-update-settings"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "htlcsc-update-settings"), nil)
+
+	// governance of the settings changes
+	hsc.SmartContractExecutionStats["governance-propose"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "governance-propose"), nil)
+	hsc.SmartContractExecutionStats["governance-vote"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "governance-vote"), nil)
+	hsc.SmartContractExecutionStats["governance-execute"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "governance-execute"), nil)
+}
+
+func (hsc *HTLCSmartContract) Execute(t *transaction.Transaction,
+	function string, input []byte, balances chainstate.StateContextI) (
+	resp string, err error) {
+
+	switch function {
+	case "lock":
+		resp, err = hsc.lock(t, input, balances)
+	case "claim":
+		resp, err = hsc.claim(t, input, balances)
+	case "refund":
+		resp, err = hsc.refund(t, input, balances)
+	case "htlcsc-update-settings":
+		resp, err = hsc.updateConfig(t, input, balances)
+	case "governance-propose":
+		resp, err = hsc.governancePropose(t, input, balances)
+	case "governance-vote":
+		resp, err = hsc.governanceVote(t, input, balances)
+	case "governance-execute":
+		resp, err = hsc.governanceExecute(t, input, balances)
+	default:
+		err = common.NewError("htlc_sc_failed",
+			fmt.Sprintf("no function with %q name", function))
+	}
+	return
+}
@@ -26,6 +26,13 @@ func BenchmarkRestTests(
 				},
 				Endpoint: mrh.getUserPools,
 			},
+			{
+				FuncName: "getUserUnbondingPools",
+				Params: map[string]string{
+					"client_id": data.Clients[0],
+				},
+				Endpoint: mrh.getUserUnbondingPools,
+			},
 			{
 				FuncName: "getStakePoolStat",
 				Params: map[string]string{
@@ -168,6 +175,13 @@ func BenchmarkRestTests(
 				},
 				Endpoint: mrh.getDelegateRewards,
 			},
+			{
+				FuncName: "getDoubleSignEvidences",
+				Params: map[string]string{
+					"miner_id": data.Miners[mockDoubleSignMiner],
+				},
+				Endpoint: mrh.getDoubleSignEvidences,
+			},
 		},
 		ADDRESS,
 		mrh,
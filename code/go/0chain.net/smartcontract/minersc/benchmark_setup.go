@@ -5,7 +5,9 @@ import (
 
 	"github.com/0chain/common/core/currency"
 
+	"0chain.net/core/config"
 	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/governance"
 
 	"0chain.net/smartcontract/stakepool"
 	"0chain.net/smartcontract/stakepool/spenum"
@@ -22,6 +24,13 @@ import (
 var mockRewardAmount currency.Coin = 1680000000
 var mockRewardType = spenum.BlockRewardMiner
 
+// mockUnbondingDelegate of the first miner is released to be claimed
+const mockUnbondingDelegate = 1
+
+// mockDoubleSignMiner has the key of the first client, so it can sign the
+// double sign evidence
+const mockDoubleSignMiner = 0
+
 func AddMockGlobalNode(balances cstate.StateContextI) {
 	var gn GlobalNode
 	gn.readConfig()
@@ -32,7 +41,7 @@ func AddMockGlobalNode(balances cstate.StateContextI) {
 }
 
 func AddMockMiners(
-	clients []string,
+	clients, publicKeys []string,
 	eventDb *event.EventDb,
 	balances cstate.StateContextI,
 	getIdAndPublicKey func() (string, string, error),
@@ -68,6 +77,9 @@ func AddMockMiners(
 		if err != nil {
 			log.Fatal(err)
 		}
+		if i == mockDoubleSignMiner {
+			newNode.PublicKey = publicKeys[0]
+		}
 		newNode.ProviderType = providerType
 		newNode.LastHealthCheck = common.Timestamp(viper.GetInt64(benchmark.MptCreationTime))
 		newNode.Settings.ServiceChargeRatio = viper.GetFloat64(benchmark.MinerMaxCharge)
@@ -91,6 +103,11 @@ func AddMockMiners(
 			} else {
 				pool.Status = spenum.Pending
 			}
+			if i == 0 && j == mockUnbondingDelegate {
+				// released, to claim
+				pool.Status = spenum.Unbonding
+				pool.ReleaseRound = 1
+			}
 			newNode.Pools[poolId] = &pool
 			if eventDb.Debug() {
 				for bk := int64(1); bk <= viper.GetInt64(benchmark.NumBlocks); bk++ {
@@ -142,6 +159,7 @@ func AddMockMiners(
 					RoundCreated:         pool.RoundCreated,
 					RoundPoolLastUpdated: viper.GetInt64(benchmark.NumBlocks),
 					StakedAt:             pool.StakedAt,
+					ReleaseRound:         pool.ReleaseRound,
 				})
 			}
 		}
@@ -190,10 +208,26 @@ func AddMockMiners(
 		}
 		addMockMinersSnapshots(miners, eventDb)
 	}
+	addMockGovernanceProposals(clients, nodes[0], delegatePoolBalance, eventDb, balances)
 
 	return nodes, publickKeys
 }
 
+// addMockGovernanceProposals adds the open proposal to vote on and the one
+// voted by the first miner delegate to execute
+func addMockGovernanceProposals(
+	clients []string,
+	minerID string,
+	weight currency.Coin,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	governance.AddMockProposals(ADDRESS, clients[0], getMinerDelegatePoolId(0, 0, clients), weight,
+		[]governance.StakeRef{{ProviderType: spenum.Miner, ProviderID: minerID}},
+		config.StringMap{Fields: map[string]string{"max_n": "8"}},
+		eventDb, balances)
+}
+
 func AddMockSharders(
 	clients []string,
 	eventDb *event.EventDb,
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	sc "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"0chain.net/smartcontract/provider"
 
 	"0chain.net/core/common"
@@ -83,7 +84,7 @@ func (bt BenchTest) Run(balances cstate.TimedQueryStateContext, b *testing.B) er
 }
 
 func BenchmarkTests(
-	data bk.BenchData, _ bk.SignatureScheme,
+	data bk.BenchData, sigScheme bk.SignatureScheme,
 ) bk.TestSuite {
 	creationTimeRaw := viper.GetInt64("MptCreationTime")
 	creationTime := common.Now()
@@ -211,6 +212,31 @@ func BenchmarkTests(
 				CreationDate: creationTime,
 			},
 		},
+		{
+			name:     "miner.submitDoubleSignEvidence",
+			endpoint: msc.submitDoubleSignEvidence,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				dse := DoubleSignEvidence{
+					MinerID: data.Miners[mockDoubleSignMiner],
+					Round:   1,
+				}
+				_ = sigScheme.SetPublicKey(data.PublicKeys[0])
+				sigScheme.SetPrivateKey(data.PrivateKeys[0])
+				for i, bh := range []*BlockHeader{&dse.Block1, &dse.Block2} {
+					bh.PrevHash = encryption.Hash("prev block")
+					bh.CreationDate = creationTime
+					bh.RoundRandomSeed = int64(i)
+					bh.Hash = encryption.Hash(bh.hashData(dse.MinerID, dse.Round))
+					bh.Signature, _ = sigScheme.Sign(bh.Hash)
+				}
+				return dse.Encode()
+			}(),
+		},
 		{
 			name:     "miner.contributeMpk",
 			endpoint: msc.contributeMpk,
@@ -278,6 +304,57 @@ func BenchmarkTests(
 				},
 			}).Encode(),
 		},
+		// governance
+		{
+			name:     "miner.governance_propose",
+			endpoint: msc.governancePropose,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				ClientID:     getMinerDelegatePoolId(0, 0, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ProposeRequest{
+				Changes: sc.StringMap{
+					Fields: map[string]string{"max_n": "9"},
+				},
+				Stakes: []governance.StakeRef{{
+					ProviderType: spenum.Miner,
+					ProviderID:   data.Miners[0],
+				}},
+			}).Encode(),
+		},
+		{
+			name:     "miner.governance_vote",
+			endpoint: msc.governanceVote,
+			txn: &transaction.Transaction{
+				ClientID:     getMinerDelegatePoolId(0, 0, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.VoteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 0),
+				Approve:    true,
+				Stakes: []governance.StakeRef{{
+					ProviderType: spenum.Miner,
+					ProviderID:   data.Miners[0],
+				}},
+			}).Encode(),
+		},
+		{
+			name:     "miner.governance_execute",
+			endpoint: msc.governanceExecute,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ExecuteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 1),
+			}).Encode(),
+		},
 		{
 			name:     "miner.update_settings",
 			endpoint: msc.updateSettings,
@@ -403,6 +480,48 @@ func BenchmarkTests(
 				ProviderID:   data.Miners[0],
 			}).Encode(),
 		},
+		{
+			name:     "miner.claimUnbondedDelegatePool",
+			endpoint: msc.claimUnbondedDelegatePool,
+			txn: &transaction.Transaction{
+				ClientID:     getMinerDelegatePoolId(0, mockUnbondingDelegate, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&deletePool{
+				ProviderType: spenum.Miner,
+				ProviderID:   data.Miners[0],
+			}).Encode(),
+		},
+		{
+			name:     "miner.redelegatePool",
+			endpoint: msc.redelegatePool,
+			txn: &transaction.Transaction{
+				ClientID:     getMinerDelegatePoolId(0, 0, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&stakepool.RedelegateRequest{
+				ProviderType:   spenum.Miner,
+				ProviderID:     data.Miners[0],
+				ToProviderType: spenum.Miner,
+				ToProviderID:   data.Miners[1],
+			}).Encode(),
+		},
+		{
+			name:     "miner.autoCompoundDelegatePool",
+			endpoint: msc.autoCompoundDelegatePool,
+			txn: &transaction.Transaction{
+				ClientID:     getMinerDelegatePoolId(0, 0, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&stakepool.AutoCompoundRequest{
+				ProviderType: spenum.Miner,
+				ProviderID:   data.Miners[0],
+				AutoCompound: true,
+			}).Encode(),
+		},
 		{
 			name:     "miner.sharder_keep",
 			endpoint: msc.sharderKeep,
@@ -6,9 +6,22 @@ import (
 	"0chain.net/core/common"
 	"0chain.net/smartcontract/stakepool"
 	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/util"
 )
 
+func init() {
+	maxStake := func(balances cstate.CommonStateContextI) (currency.Coin, error) {
+		gn, err := getGlobalNode(balances)
+		if err != nil {
+			return 0, err
+		}
+		return gn.MaxStake, nil
+	}
+	stakepool.RegisterMaxStake(spenum.Miner, maxStake)
+	stakepool.RegisterMaxStake(spenum.Sharder, maxStake)
+}
+
 func (msc *MinerSmartContract) addToDelegatePool(t *transaction.Transaction,
 	input []byte, gn *GlobalNode, balances cstate.StateContextI) (
 	resp string, err error) {
@@ -54,5 +67,33 @@ func (msc *MinerSmartContract) deleteFromDelegatePool(
 	t *transaction.Transaction, inputData []byte, gn *GlobalNode,
 	balances cstate.StateContextI) (resp string, err error) {
 
-	return stakepool.StakePoolUnlock(t, inputData, balances, msc.getStakePoolAdapter)
+	return stakepool.StakePoolUnlock(t, inputData, balances, gn.UnbondingRounds, msc.getStakePoolAdapter)
+}
+
+// claimUnbondedDelegatePool pays out the unbonding delegate pool after its
+// release round
+func (msc *MinerSmartContract) claimUnbondedDelegatePool(
+	t *transaction.Transaction, inputData []byte, _ *GlobalNode,
+	balances cstate.StateContextI) (resp string, err error) {
+
+	return stakepool.StakePoolClaimUnbonded(t, inputData, balances, msc.getStakePoolAdapter)
+}
+
+// autoCompoundDelegatePool turns the auto compounding of the rewards of the
+// delegate pool on or off
+func (msc *MinerSmartContract) autoCompoundDelegatePool(
+	t *transaction.Transaction, inputData []byte, _ *GlobalNode,
+	balances cstate.StateContextI) (resp string, err error) {
+
+	return stakepool.StakePoolAutoCompound(t, inputData, balances, msc.getStakePoolAdapter)
+}
+
+// redelegatePool moves the delegate pool to the stake pool of another
+// miner or sharder without unlocking it
+func (msc *MinerSmartContract) redelegatePool(
+	t *transaction.Transaction, inputData []byte, gn *GlobalNode,
+	balances cstate.StateContextI) (resp string, err error) {
+
+	return stakepool.StakePoolRedelegate(t, inputData, balances, gn.UnbondingRounds,
+		stakepool.ValidationSettings{MaxStake: gn.MaxStake, MinStake: gn.MinStake, MaxNumDelegates: gn.MaxDelegates}, msc.getStakePoolAdapter)
 }
@@ -0,0 +1,246 @@
+package minersc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+)
+
+//go:generate msgp -io=false -tests=false -unexported -v
+
+//msgp:ignore BlockHeader DoubleSignEvidence
+
+// BlockHeader is the part of a block the block hash is computed from,
+// with the block hash and the signature of the generator of the block.
+type BlockHeader struct {
+	PrevHash              string           `json:"prev_hash"`
+	CreationDate          common.Timestamp `json:"creation_date"`
+	RoundRandomSeed       int64            `json:"round_random_seed"`
+	StateChangesCount     int              `json:"state_changes_count"`
+	MerkleTreeRoot        string           `json:"merkle_tree_root"`
+	ReceiptMerkleTreeRoot string           `json:"receipt_merkle_tree_root"`
+	MagicBlockHash        string           `json:"magic_block_hash,omitempty"`
+	Hash                  string           `json:"hash"`
+	Signature             string           `json:"signature"`
+}
+
+// hashData is the same as the block.Block one, the block is generated by
+// the miner in the round.
+func (bh *BlockHeader) hashData(minerID string, round int64) string {
+	hashBuilder := strings.Builder{}
+	hashBuilder.WriteString(minerID)
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(bh.PrevHash)
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(common.TimeToString(bh.CreationDate))
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(strconv.FormatInt(round, 10))
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(strconv.FormatInt(bh.RoundRandomSeed, 10))
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(strconv.Itoa(bh.StateChangesCount))
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(bh.MerkleTreeRoot)
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(bh.ReceiptMerkleTreeRoot)
+	if bh.MagicBlockHash != "" {
+		hashBuilder.WriteString(":")
+		hashBuilder.WriteString(bh.MagicBlockHash)
+	}
+	return hashBuilder.String()
+}
+
+// verify the block is generated and signed by the miner in the round
+func (bh *BlockHeader) verify(mn *MinerNode, round int64, balances cstate.StateContextI) error {
+	if bh.Hash != encryption.Hash(bh.hashData(mn.ID, round)) {
+		return fmt.Errorf("block hash mismatch: %s", bh.Hash)
+	}
+	scheme := balances.GetSignatureScheme()
+	if err := scheme.SetPublicKey(mn.PublicKey); err != nil {
+		return fmt.Errorf("invalid miner public key: %v", err)
+	}
+	ok, err := scheme.Verify(bh.Signature, bh.Hash)
+	if err != nil || !ok {
+		return fmt.Errorf("invalid signature of block: %s", bh.Hash)
+	}
+	return nil
+}
+
+// DoubleSignEvidence of two different blocks signed by the miner in the
+// same round.
+type DoubleSignEvidence struct {
+	MinerID string      `json:"miner_id"`
+	Round   int64       `json:"round"`
+	Block1  BlockHeader `json:"block_1"`
+	Block2  BlockHeader `json:"block_2"`
+}
+
+func (dse *DoubleSignEvidence) Encode() []byte {
+	buff, _ := json.Marshal(dse)
+	return buff
+}
+
+func (dse *DoubleSignEvidence) Decode(input []byte) error {
+	return json.Unmarshal(input, dse)
+}
+
+// doubleSignSlash is saved for the miner slashed for double signing in
+// the round, so the evidence can't be replayed.
+type doubleSignSlash struct {
+	MinerID string        `json:"miner_id"`
+	Round   int64         `json:"round"`
+	TxnHash string        `json:"txn_hash"`
+	Slashed currency.Coin `json:"slashed"`
+}
+
+func doubleSignSlashKey(minerID string, round int64) datastore.Key {
+	return globalKeyHash(fmt.Sprintf("double_sign:%s:%d", minerID, round))
+}
+
+// submitDoubleSignEvidence slashes the stake of the miner signed two
+// different blocks in the same round on top of the same previous block with
+// the same round random seed. The evidence is accepted for the configured
+// number of rounds after the round of the blocks. The hashes of the blocks are
+// recomputed with the miner as the generator of the blocks, so the
+// verification tickets the miner signs for the blocks of the other miners
+// can't be used as the evidence.
+func (msc *MinerSmartContract) submitDoubleSignEvidence(
+	t *transaction.Transaction,
+	input []byte,
+	gn *GlobalNode,
+	balances cstate.StateContextI,
+) (resp string, err error) {
+	var dse DoubleSignEvidence
+	if err = dse.Decode(input); err != nil {
+		return "", common.NewErrorf("submit_double_sign_evidence_failed",
+			"invalid request: %v", err)
+	}
+	if dse.Round <= 0 || dse.Round > balances.GetBlock().Round {
+		return "", common.NewErrorf("submit_double_sign_evidence_failed",
+			"invalid round: %d", dse.Round)
+	}
+	if dse.Round < balances.GetBlock().Round-gn.DoubleSignEvidenceRounds {
+		return "", common.NewErrorf("submit_double_sign_evidence_failed",
+			"evidence of the round %d is too old", dse.Round)
+	}
+	if dse.Block1.Hash == dse.Block2.Hash {
+		return "", common.NewError("submit_double_sign_evidence_failed",
+			"the blocks are the same")
+	}
+	// the blocks of the same round on top of the same previous block, the
+	// blocks of different forks are not double signing
+	if dse.Block1.PrevHash != dse.Block2.PrevHash {
+		return "", common.NewError("submit_double_sign_evidence_failed",
+			"the blocks have different previous blocks")
+	}
+	if dse.Block1.RoundRandomSeed != dse.Block2.RoundRandomSeed {
+		return "", common.NewError("submit_double_sign_evidence_failed",
+			"the blocks have different round random seeds")
+	}
+
+	key := doubleSignSlashKey(dse.MinerID, dse.Round)
+	err = balances.GetTrieNode(key, &doubleSignSlash{})
+	switch err {
+	case nil:
+		return "", common.NewErrorf("submit_double_sign_evidence_failed",
+			"miner %s is already slashed for the round %d", dse.MinerID, dse.Round)
+	case util.ErrValueNotPresent:
+	default:
+		return "", common.NewErrorf("submit_double_sign_evidence_failed",
+			"can't get double sign slash: %v", err)
+	}
+
+	mn, err := getMinerNode(dse.MinerID, balances)
+	if err != nil {
+		return "", common.NewErrorf("submit_double_sign_evidence_failed",
+			"can't get miner: %v", err)
+	}
+	if err = dse.Block1.verify(mn, dse.Round, balances); err != nil {
+		return "", common.NewError("submit_double_sign_evidence_failed", err.Error())
+	}
+	if err = dse.Block2.verify(mn, dse.Round, balances); err != nil {
+		return "", common.NewError("submit_double_sign_evidence_failed", err.Error())
+	}
+
+	slashed, err := slashMiner(mn, gn.DoubleSignSlash, balances)
+	if err != nil {
+		return "", common.NewErrorf("submit_double_sign_evidence_failed",
+			"slashing miner: %v", err)
+	}
+	if err = mn.save(balances); err != nil {
+		return "", common.NewError("submit_double_sign_evidence_failed", err.Error())
+	}
+
+	dss := &doubleSignSlash{
+		MinerID: dse.MinerID,
+		Round:   dse.Round,
+		TxnHash: t.Hash,
+		Slashed: slashed,
+	}
+	if _, err = balances.InsertTrieNode(key, dss); err != nil {
+		return "", common.NewErrorf("submit_double_sign_evidence_failed",
+			"saving double sign slash: %v", err)
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagAddDoubleSignEvidence, dse.MinerID, []event.DoubleSignEvidence{{
+		MinerID:    dse.MinerID,
+		Round:      dse.Round,
+		BlockHash1: dse.Block1.Hash,
+		Signature1: dse.Block1.Signature,
+		BlockHash2: dse.Block2.Hash,
+		Signature2: dse.Block2.Signature,
+		Reporter:   t.ClientID,
+		TxnHash:    t.Hash,
+		Slashed:    slashed,
+	}})
+
+	buff, err := json.Marshal(dss)
+	if err != nil {
+		return "", common.NewError("submit_double_sign_evidence_failed", err.Error())
+	}
+	return string(buff), nil
+}
+
+// slashMiner slashes the fraction of the stake of the miner, returns the
+// slashed tokens
+func slashMiner(mn *MinerNode, fraction float64, balances cstate.StateContextI) (currency.Coin, error) {
+	if mn.StakePool == nil {
+		return 0, errors.New("miner has no stake pool")
+	}
+	before, err := minerStake(mn)
+	if err != nil {
+		return 0, err
+	}
+	if err = mn.StakePool.SlashFraction(fraction, mn.ID, spenum.Miner, balances); err != nil {
+		return 0, err
+	}
+	after, err := minerStake(mn)
+	if err != nil {
+		return 0, err
+	}
+	if err = mn.StakePool.EmitStakeEvent(spenum.Miner, mn.ID, balances); err != nil {
+		return 0, err
+	}
+	return currency.MinusCoin(before, after)
+}
+
+func minerStake(mn *MinerNode) (stake currency.Coin, err error) {
+	for _, dp := range mn.StakePool.Pools {
+		if stake, err = currency.AddCoin(stake, dp.Balance); err != nil {
+			return 0, err
+		}
+	}
+	return stake, nil
+}
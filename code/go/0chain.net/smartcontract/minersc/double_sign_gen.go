@@ -0,0 +1,90 @@
+package minersc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *doubleSignSlash) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "MinerID"
+	o = append(o, 0x84, 0xa7, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.MinerID)
+	// string "Round"
+	o = append(o, 0xa5, 0x52, 0x6f, 0x75, 0x6e, 0x64)
+	o = msgp.AppendInt64(o, z.Round)
+	// string "TxnHash"
+	o = append(o, 0xa7, 0x54, 0x78, 0x6e, 0x48, 0x61, 0x73, 0x68)
+	o = msgp.AppendString(o, z.TxnHash)
+	// string "Slashed"
+	o = append(o, 0xa7, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64)
+	o, err = z.Slashed.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Slashed")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *doubleSignSlash) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "MinerID":
+			z.MinerID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinerID")
+				return
+			}
+		case "Round":
+			z.Round, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Round")
+				return
+			}
+		case "TxnHash":
+			z.TxnHash, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TxnHash")
+				return
+			}
+		case "Slashed":
+			bts, err = z.Slashed.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Slashed")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *doubleSignSlash) Msgsize() (s int) {
+	s = 1 + 8 + msgp.StringPrefixSize + len(z.MinerID) + 6 + msgp.Int64Size + 8 + msgp.StringPrefixSize + len(z.TxnHash) + 8 + z.Slashed.Msgsize()
+	return
+}
@@ -0,0 +1,144 @@
+package minersc
+
+import (
+	"testing"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitDoubleSignEvidence(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		msc      = newTestMinerSC()
+		now      = int64(1000)
+	)
+	gn := setConfig(t, balances)
+	gn.DoubleSignSlash = 0.5
+	gn.DoubleSignEvidenceRounds = 95
+	require.NoError(t, gn.save(balances))
+	balances.block = block.Provider().(*block.Block)
+	balances.block.Round = 100
+
+	m, err := addMiner(t, msc, now, true, balances)
+	require.NoError(t, err)
+	mn, err := getMinerNode(m.miner.id, balances)
+	require.NoError(t, err)
+	staker := newClient(0, balances)
+	mn.StakePool.Pools[staker.id] = &stakepool.DelegatePool{
+		Balance:    10e10,
+		DelegateID: staker.id,
+		Status:     spenum.Active,
+	}
+	require.NoError(t, mn.save(balances))
+
+	header := func(signer *Client, minerID string, round int64, root string, update ...func(*BlockHeader)) BlockHeader {
+		bh := BlockHeader{
+			PrevHash:        encryption.Hash("prev block"),
+			CreationDate:    common.Timestamp(now),
+			RoundRandomSeed: 1,
+			MerkleTreeRoot:  encryption.Hash(root),
+		}
+		for _, u := range update {
+			u(&bh)
+		}
+		bh.Hash = encryption.Hash(bh.hashData(minerID, round))
+		sig, err := signer.scheme.Sign(bh.Hash)
+		require.NoError(t, err)
+		bh.Signature = sig
+		return bh
+	}
+	submit := func(dse *DoubleSignEvidence) error {
+		tx := newTransaction(staker.id, ADDRESS, 0, now)
+		balances.txn = tx
+		gn, err := getGlobalNode(balances)
+		require.NoError(t, err)
+		_, err = msc.submitDoubleSignEvidence(tx, dse.Encode(), gn, balances)
+		return err
+	}
+
+	other := newClient(0, balances)
+	err = submit(&DoubleSignEvidence{
+		MinerID: m.miner.id,
+		Round:   10,
+		Block1:  header(m.miner, m.miner.id, 10, "1"),
+		Block2:  header(m.miner, other.id, 10, "2"),
+	})
+	require.EqualError(t, err, "submit_double_sign_evidence_failed: block hash mismatch: "+
+		header(m.miner, other.id, 10, "2").Hash)
+
+	err = submit(&DoubleSignEvidence{
+		MinerID: m.miner.id,
+		Round:   10,
+		Block1:  header(m.miner, m.miner.id, 10, "1"),
+		Block2:  header(other, m.miner.id, 10, "2"),
+	})
+	require.EqualError(t, err, "submit_double_sign_evidence_failed: invalid signature of block: "+
+		header(other, m.miner.id, 10, "2").Hash)
+
+	err = submit(&DoubleSignEvidence{
+		MinerID: m.miner.id,
+		Round:   10,
+		Block1:  header(m.miner, m.miner.id, 10, "1"),
+		Block2:  header(m.miner, m.miner.id, 10, "1"),
+	})
+	require.EqualError(t, err, "submit_double_sign_evidence_failed: the blocks are the same")
+
+	err = submit(&DoubleSignEvidence{
+		MinerID: m.miner.id,
+		Round:   101,
+		Block1:  header(m.miner, m.miner.id, 101, "1"),
+		Block2:  header(m.miner, m.miner.id, 101, "2"),
+	})
+	require.EqualError(t, err, "submit_double_sign_evidence_failed: invalid round: 101")
+
+	err = submit(&DoubleSignEvidence{
+		MinerID: m.miner.id,
+		Round:   4,
+		Block1:  header(m.miner, m.miner.id, 4, "1"),
+		Block2:  header(m.miner, m.miner.id, 4, "2"),
+	})
+	require.EqualError(t, err, "submit_double_sign_evidence_failed: evidence of the round 4 is too old")
+
+	err = submit(&DoubleSignEvidence{
+		MinerID: m.miner.id,
+		Round:   10,
+		Block1:  header(m.miner, m.miner.id, 10, "1"),
+		Block2: header(m.miner, m.miner.id, 10, "2", func(bh *BlockHeader) {
+			bh.PrevHash = encryption.Hash("other prev block")
+		}),
+	})
+	require.EqualError(t, err, "submit_double_sign_evidence_failed: the blocks have different previous blocks")
+
+	err = submit(&DoubleSignEvidence{
+		MinerID: m.miner.id,
+		Round:   10,
+		Block1:  header(m.miner, m.miner.id, 10, "1"),
+		Block2: header(m.miner, m.miner.id, 10, "2", func(bh *BlockHeader) {
+			bh.RoundRandomSeed = 2
+		}),
+	})
+	require.EqualError(t, err, "submit_double_sign_evidence_failed: the blocks have different round random seeds")
+
+	evidence := &DoubleSignEvidence{
+		MinerID: m.miner.id,
+		Round:   10,
+		Block1:  header(m.miner, m.miner.id, 10, "1"),
+		Block2:  header(m.miner, m.miner.id, 10, "2"),
+	}
+	require.NoError(t, submit(evidence))
+	mn, err = getMinerNode(m.miner.id, balances)
+	require.NoError(t, err)
+	require.EqualValues(t, 5e10, mn.StakePool.Pools[staker.id].Balance)
+
+	var dss doubleSignSlash
+	require.NoError(t, balances.GetTrieNode(doubleSignSlashKey(m.miner.id, 10), &dss))
+	require.EqualValues(t, 5e10, dss.Slashed)
+
+	require.EqualError(t, submit(evidence), "submit_double_sign_evidence_failed: "+
+		"miner "+m.miner.id+" is already slashed for the round 10")
+}
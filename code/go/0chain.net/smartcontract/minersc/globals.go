@@ -22,6 +22,7 @@ import (
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/governance"
 )
 
 //msgp:ignore enums.GlobalSetting
@@ -313,6 +314,9 @@ func (msc *MinerSmartContract) updateGlobals(
 	if err = changes.Decode(inputData); err != nil {
 		return "", common.NewError("update_globals", err.Error())
 	}
+	if err := governance.CheckOwnerChanges(changes); err != nil {
+		return "", common.NewError("update_globals", err.Error())
+	}
 
 	globals, err := getGlobalSettings(balances)
 
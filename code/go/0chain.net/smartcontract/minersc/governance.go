@@ -0,0 +1,143 @@
+package minersc
+
+import (
+	"strings"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/util"
+)
+
+func init() {
+	governance.RegisterStakePools(spenum.Miner,
+		func(providerID string, balances cstate.CommonStateContextI) (stakepool.AbstractStakePool, error) {
+			mn, err := getMinerNode(providerID, balances)
+			if err != nil {
+				return nil, err
+			}
+			return mn.StakePool, nil
+		})
+	governance.RegisterStakePools(spenum.Sharder,
+		func(providerID string, balances cstate.CommonStateContextI) (stakepool.AbstractStakePool, error) {
+			sn, err := getSharderNode(providerID, balances)
+			if err != nil {
+				return nil, err
+			}
+			return sn.StakePool, nil
+		})
+}
+
+// governanceSettings are the miner settings and the global settings changed
+// by the governance, the same way update_settings and update_globals do
+type governanceSettings struct{}
+
+func (governanceSettings) Config(balances cstate.StateContextI) (*governance.Config, error) {
+	gn, err := getGlobalNode(balances)
+	if err != nil {
+		return nil, err
+	}
+	return &gn.Governance, nil
+}
+
+// split the changes into the miner settings and the global settings ones
+func (governanceSettings) split(changes config.StringMap) (settings, globals config.StringMap) {
+	settings.Fields = make(map[string]string)
+	globals.Fields = make(map[string]string)
+	for key, value := range changes.Fields {
+		if _, ok := Settings[key]; ok || strings.HasPrefix(key, governance.Prefix) {
+			settings.Fields[key] = value
+		} else {
+			globals.Fields[key] = value
+		}
+	}
+	return
+}
+
+func (s governanceSettings) update(changes config.StringMap, balances cstate.StateContextI) (
+	*GlobalNode, *GlobalSettings, error) {
+
+	settings, globalChanges := s.split(changes)
+	gn, err := getGlobalNode(balances)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := gn.update(settings); err != nil {
+		return nil, nil, err
+	}
+	if err := gn.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(globalChanges.Fields) == 0 {
+		return gn, nil, nil
+	}
+	globals, err := getGlobalSettings(balances)
+	if err != nil {
+		if err != util.ErrValueNotPresent {
+			return nil, nil, err
+		}
+		globals = &GlobalSettings{
+			Fields: getStringMapFromViper(),
+		}
+	}
+	if err := globals.update(globalChanges); err != nil {
+		return nil, nil, err
+	}
+	return gn, globals, nil
+}
+
+func (s governanceSettings) Validate(changes config.StringMap, balances cstate.StateContextI) error {
+	_, _, err := s.update(changes, balances)
+	return err
+}
+
+func (s governanceSettings) Apply(changes config.StringMap, balances cstate.StateContextI) error {
+	gn, globals, err := s.update(changes, balances)
+	if err != nil {
+		return err
+	}
+	if err := gn.save(balances); err != nil {
+		return err
+	}
+	if globals == nil {
+		return nil
+	}
+	return globals.save(balances)
+}
+
+// governancePropose submits the proposal of the miner settings or the global
+// settings changes, the proposer is a council member or a staker
+func (msc *MinerSmartContract) governancePropose(
+	t *transaction.Transaction,
+	input []byte,
+	_ *GlobalNode,
+	balances cstate.StateContextI,
+) (string, error) {
+	return governance.Propose(t, input, msc.ID, governanceSettings{}, balances)
+}
+
+// governanceVote on the open proposal with the stake of the voter, a vote
+// again replaces the former one
+func (msc *MinerSmartContract) governanceVote(
+	t *transaction.Transaction,
+	input []byte,
+	_ *GlobalNode,
+	balances cstate.StateContextI,
+) (string, error) {
+	return governance.CastVote(t, input, msc.ID, governanceSettings{}, balances)
+}
+
+// governanceExecute closes the proposal after the voting, the passed one
+// is applied to the settings after the timelock. Anyone can do it.
+func (msc *MinerSmartContract) governanceExecute(
+	t *transaction.Transaction,
+	input []byte,
+	_ *GlobalNode,
+	balances cstate.StateContextI,
+) (string, error) {
+	return governance.Execute(t, input, msc.ID, governanceSettings{}, balances)
+}
@@ -0,0 +1,104 @@
+package minersc
+
+import (
+	"testing"
+	"time"
+
+	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernance(t *testing.T) {
+	var (
+		msc      = newTestMinerSC()
+		balances = newTestBalances()
+		staker   = newClient(0, balances)
+		outsider = newClient(0, balances)
+		now      = int64(1000)
+		hour     = int64(time.Hour / time.Second)
+	)
+	gn := setConfig(t, balances)
+	gn.Governance = governance.Config{
+		Quorum:       1,
+		Threshold:    0.5,
+		VotingPeriod: time.Hour,
+		Timelock:     time.Hour,
+		MinStake:     10e10,
+		MaxVoters:    10,
+	}
+	mustSave(t, GlobalNodeKey, gn, balances)
+
+	mn := NewMinerNode()
+	mn.ID = newClient(0, balances).id
+	mn.ProviderType = spenum.Miner
+	mn.Pools[staker.id] = &stakepool.DelegatePool{Balance: 10e10, Status: spenum.Active}
+	mn.Pools[outsider.id] = &stakepool.DelegatePool{Balance: 1e10, Status: spenum.Active}
+	require.NoError(t, mn.save(balances))
+	stakes := []governance.StakeRef{{ProviderType: spenum.Miner, ProviderID: mn.ID}}
+
+	call := func(fn smartContractFunction, clientID string, at int64, req interface{ Encode() []byte }) (string, error) {
+		tx := newTransaction(clientID, ADDRESS, 0, at)
+		balances.txn = tx
+		return fn(tx, req.Encode(), gn, balances)
+	}
+
+	_, err := call(msc.governancePropose, outsider.id, now, &governance.ProposeRequest{
+		Changes: config.StringMap{Fields: map[string]string{"max_n": "50"}},
+		Stakes:  stakes,
+	})
+	require.EqualError(t, err, "governance_propose_failed: "+
+		"stake 10000000000 is less than min stake 100000000000")
+	_, err = call(msc.governancePropose, staker.id, now, &governance.ProposeRequest{
+		Changes: config.StringMap{Fields: map[string]string{"max_n": "1"}},
+		Stakes:  stakes,
+	})
+	require.EqualError(t, err, "governance_propose_failed: "+
+		"invalid changes: max_n is less than min_n: 1 < 3")
+
+	propose := newTransaction(staker.id, ADDRESS, 0, now)
+	balances.txn = propose
+	_, err = msc.governancePropose(propose, (&governance.ProposeRequest{
+		Changes: config.StringMap{Fields: map[string]string{
+			"max_n":                 "50",
+			"governance.max_voters": "20",
+		}},
+		Stakes: stakes,
+	}).Encode(), gn, balances)
+	require.NoError(t, err)
+	id := propose.Hash
+
+	_, err = call(msc.governanceVote, staker.id, now+1, &governance.VoteRequest{
+		ProposalID: id, Approve: true, Stakes: stakes,
+	})
+	require.NoError(t, err)
+	_, err = call(msc.governanceExecute, outsider.id, now+hour+1, &governance.ExecuteRequest{ProposalID: id})
+	require.EqualError(t, err, "governance_execute_failed: "+
+		"proposal is timelocked until 8200")
+
+	_, err = call(msc.governanceExecute, outsider.id, now+2*hour, &governance.ExecuteRequest{ProposalID: id})
+	require.NoError(t, err)
+	gn, err = getGlobalNode(balances)
+	require.NoError(t, err)
+	require.Equal(t, 50, gn.MaxN)
+	require.Equal(t, 20, gn.Governance.MaxVoters)
+}
+
+func TestGovernanceSplit(t *testing.T) {
+	settings, globals := governanceSettings{}.split(config.StringMap{Fields: map[string]string{
+		"max_n":                             "50",
+		"cost.add_miner":                    "100",
+		"governance.quorum":                 "10",
+		"server_chain.block.max_block_size": "10",
+	}})
+	require.Equal(t, map[string]string{
+		"max_n":             "50",
+		"cost.add_miner":    "100",
+		"governance.quorum": "10",
+	}, settings.Fields)
+	require.Equal(t, map[string]string{
+		"server_chain.block.max_block_size": "10",
+	}, globals.Fields)
+}
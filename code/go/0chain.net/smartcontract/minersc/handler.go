@@ -41,6 +41,7 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		rest.MakeEndpoint(miner+"/globalSettings", common.UserRateLimit(mrh.getGlobalSettings)),
 		rest.MakeEndpoint(miner+"/getNodepool", common.UserRateLimit(mrh.getNodePool)),
 		rest.MakeEndpoint(miner+"/getUserPools", common.UserRateLimit(mrh.getUserPools)),
+		rest.MakeEndpoint(miner+"/getUserUnbondingPools", common.UserRateLimit(mrh.getUserUnbondingPools)),
 		rest.MakeEndpoint(miner+"/getStakePoolStat", common.UserRateLimit(mrh.getStakePoolStat)),
 		rest.MakeEndpoint(miner+"/getMinerList", common.UserRateLimit(mrh.getMinerList)),
 		rest.MakeEndpoint(miner+"/get_miners_stats", common.UserRateLimit(mrh.getMinersStats)),
@@ -60,6 +61,7 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		rest.MakeEndpoint(miner+"/get_sharder_geolocations", common.UserRateLimit(mrh.getSharderGeolocations)),
 		rest.MakeEndpoint(miner+"/provider-rewards", common.UserRateLimit(mrh.getProviderRewards)),
 		rest.MakeEndpoint(miner+"/delegate-rewards", common.UserRateLimit(mrh.getDelegateRewards)),
+		rest.MakeEndpoint(miner+"/getDoubleSignEvidences", common.UserRateLimit(mrh.getDoubleSignEvidences)),
 
 		//test endpoints
 		rest.MakeEndpoint("/test/screst/nodeStat", common.UserRateLimit(mrh.testNodeStat)),
@@ -121,6 +123,54 @@ func (mrh *MinerRestHandler) getDelegateRewards(w http.ResponseWriter, r *http.R
 	common.Respond(w, r, rtv, nil)
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d9/getDoubleSignEvidences getDoubleSignEvidences
+// Gets the evidences of the miners slashed for signing two different blocks in the same round
+//
+// parameters:
+//
+//	+name: miner_id
+//	 description: id of the slashed miner, all the miners if omitted
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []DoubleSignEvidence
+//	400:
+//	500:
+func (mrh *MinerRestHandler) getDoubleSignEvidences(w http.ResponseWriter, r *http.Request) {
+	minerID := r.URL.Query().Get("miner_id")
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := mrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	evidences, err := edb.GetDoubleSignEvidences(minerID, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+		return
+	}
+	common.Respond(w, r, evidences, nil)
+}
+
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/provider-rewards provider-rewards
 // Gets list of provider rewards satisfying filter
 //
@@ -988,10 +1038,71 @@ func toUPS(pool event.DelegatePool) stakepool.DelegatePoolStat {
 	dp.ProviderType = pool.ProviderType
 	dp.ProviderId = pool.ProviderID
 	dp.StakedAt = pool.StakedAt
+	dp.UnStake = pool.Status == spenum.Unbonding
+	dp.ReleaseRound = pool.ReleaseRound
+	dp.AutoCompound = pool.AutoCompound
+	dp.TotalCompounded = pool.TotalCompounded
 
 	return dp
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d9/getUserUnbondingPools getMSUserUnbondingPools
+// Gets the pending withdrawals of a user from the miners and the sharders
+// stake pools with the rounds they can be claimed from
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client for which to get the unbonding pools
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []DelegatePool
+//	400:
+//	500:
+func (mrh *MinerRestHandler) getUserUnbondingPools(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	pagination, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := mrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	pools, err := edb.GetUserUnbondingPools(clientID,
+		[]spenum.Provider{spenum.Miner, spenum.Sharder}, pagination)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get unbonding pools", err.Error()))
+		return
+	}
+
+	common.Respond(w, r, pools, nil)
+}
+
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d9/getStakePoolStat getMSStakePoolStat
 // Gets statistic for all locked tokens of a stake pool
 //
@@ -42,6 +42,7 @@ func (msc *MinerSmartContract) InitSmartContractFunctions() {
 
 	msc.smartContractFunctions["kill_miner"] = msc.killMiner
 	msc.smartContractFunctions["kill_sharder"] = msc.killSharder
+	msc.smartContractFunctions["submitDoubleSignEvidence"] = msc.submitDoubleSignEvidence
 
 	msc.smartContractFunctions["miner_health_check"] = msc.minerHealthCheck
 	msc.smartContractFunctions["sharder_health_check"] = msc.sharderHealthCheck
@@ -55,9 +56,15 @@ func (msc *MinerSmartContract) InitSmartContractFunctions() {
 	msc.smartContractFunctions["update_settings"] = msc.updateSettings
 	msc.smartContractFunctions["update_miner_settings"] = msc.UpdateMinerSettings
 	msc.smartContractFunctions["update_sharder_settings"] = msc.UpdateSharderSettings
+	msc.smartContractFunctions["governance_propose"] = msc.governancePropose
+	msc.smartContractFunctions["governance_vote"] = msc.governanceVote
+	msc.smartContractFunctions["governance_execute"] = msc.governanceExecute
 
 	msc.smartContractFunctions["addToDelegatePool"] = msc.addToDelegatePool
 	msc.smartContractFunctions["deleteFromDelegatePool"] = msc.deleteFromDelegatePool
+	msc.smartContractFunctions["claimUnbondedDelegatePool"] = msc.claimUnbondedDelegatePool
+	msc.smartContractFunctions["redelegatePool"] = msc.redelegatePool
+	msc.smartContractFunctions["autoCompoundDelegatePool"] = msc.autoCompoundDelegatePool
 
 	msc.smartContractFunctions["sharder_keep"] = msc.sharderKeep
 }
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	config2 "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"0chain.net/smartcontract/provider"
 
 	"github.com/0chain/common/core/currency"
@@ -262,6 +263,16 @@ type GlobalNode struct {
 	OwnerId              string         `json:"owner_id"`
 	CooldownPeriod       int64          `json:"cooldown_period"`
 	Cost                 map[string]int `json:"cost"`
+	// UnbondingRounds the unlocked delegate pools wait before the claim.
+	UnbondingRounds int64 `json:"unbonding_rounds"`
+	// DoubleSignSlash is the fraction of the stake slashed from a miner
+	// proven to sign two different blocks in the same round.
+	DoubleSignSlash float64 `json:"double_sign_slash"`
+	// DoubleSignEvidenceRounds the double sign evidence is accepted for
+	// after the round of the blocks.
+	DoubleSignEvidenceRounds int64 `json:"double_sign_evidence_rounds"`
+	// Governance of the miner settings and the global settings changes.
+	Governance governance.Config `json:"governance"`
 }
 
 func (gn *GlobalNode) readConfig() (err error) {
@@ -307,8 +318,12 @@ func (gn *GlobalNode) readConfig() (err error) {
 	}
 	gn.OwnerId = config2.SmartContractConfig.GetString(pfx + SettingName[OwnerId])
 	gn.CooldownPeriod = config2.SmartContractConfig.GetInt64(pfx + SettingName[CooldownPeriod])
+	gn.UnbondingRounds = config2.SmartContractConfig.GetInt64(pfx + SettingName[UnbondingRounds])
+	gn.DoubleSignSlash = config2.SmartContractConfig.GetFloat64(pfx + SettingName[DoubleSignSlash])
+	gn.DoubleSignEvidenceRounds = config2.SmartContractConfig.GetInt64(pfx + SettingName[DoubleSignEvidenceRounds])
 	gn.Cost = config2.SmartContractConfig.GetStringMapInt(pfx + "cost")
-	return nil
+	gn.Governance, err = governance.ReadConfig(pfx)
+	return
 }
 
 func (gn *GlobalNode) validate() error {
@@ -339,10 +354,25 @@ func (gn *GlobalNode) validate() error {
 		return fmt.Errorf("%s cannot be negative: %d",
 			NumMinerDelegatesRewarded.String(), gn.NumMinerDelegatesRewarded)
 	}
+	if gn.UnbondingRounds < 0 {
+		return fmt.Errorf("%s cannot be negative: %d",
+			UnbondingRounds.String(), gn.UnbondingRounds)
+	}
+	if gn.DoubleSignSlash < 0 || gn.DoubleSignSlash > 1 {
+		return fmt.Errorf("%s must be in [0, 1]: %v",
+			DoubleSignSlash.String(), gn.DoubleSignSlash)
+	}
+	if gn.DoubleSignEvidenceRounds < 0 {
+		return fmt.Errorf("%s cannot be negative: %d",
+			DoubleSignEvidenceRounds.String(), gn.DoubleSignEvidenceRounds)
+	}
 	if gn.NumShardersRewarded < 0 {
 		return fmt.Errorf("%s cannot be negative: %d",
 			NumShardersRewarded.String(), gn.NumShardersRewarded)
 	}
+	if err := gn.Governance.Validate(); err != nil {
+		return fmt.Errorf("invalid governance: %v", err)
+	}
 	return nil
 }
 
@@ -367,6 +397,9 @@ func (gn *GlobalNode) getConfigMap() (config2.StringMap, error) {
 		}
 		out.Fields[key] = fmt.Sprintf("%v", iSetting)
 	}
+	for key, value := range gn.Governance.Fields() {
+		out.Fields[key] = value
+	}
 	return out, nil
 }
 
@@ -427,6 +460,12 @@ func (gn *GlobalNode) Get(key Setting) (interface{}, error) {
 		return gn.OwnerId, nil
 	case CooldownPeriod:
 		return gn.CooldownPeriod, nil
+	case UnbondingRounds:
+		return gn.UnbondingRounds, nil
+	case DoubleSignSlash:
+		return gn.DoubleSignSlash, nil
+	case DoubleSignEvidenceRounds:
+		return gn.DoubleSignEvidenceRounds, nil
 	default:
 		return nil, errors.New("Setting not implemented")
 	}
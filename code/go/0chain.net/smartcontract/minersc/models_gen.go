@@ -308,9 +308,9 @@ func (z *DKGMinerNodes) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *GlobalNode) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 30
+	// map header, size 34
 	// string "ViewChange"
-	o = append(o, 0xde, 0x0, 0x1e, 0xaa, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65)
+	o = append(o, 0xde, 0x0, 0x22, 0xaa, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65)
 	o = msgp.AppendInt64(o, z.ViewChange)
 	// string "MaxN"
 	o = append(o, 0xa4, 0x4d, 0x61, 0x78, 0x4e)
@@ -441,6 +441,22 @@ func (z *GlobalNode) MarshalMsg(b []byte) (o []byte, err error) {
 		o = msgp.AppendString(o, k)
 		o = msgp.AppendInt(o, za0002)
 	}
+	// string "UnbondingRounds"
+	o = append(o, 0xaf, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73)
+	o = msgp.AppendInt64(o, z.UnbondingRounds)
+	// string "DoubleSignSlash"
+	o = append(o, 0xaf, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68)
+	o = msgp.AppendFloat64(o, z.DoubleSignSlash)
+	// string "DoubleSignEvidenceRounds"
+	o = append(o, 0xb8, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73)
+	o = msgp.AppendInt64(o, z.DoubleSignEvidenceRounds)
+	// string "Governance"
+	o = append(o, 0xaa, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65)
+	o, err = z.Governance.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Governance")
+		return
+	}
 	return
 }
 
@@ -677,6 +693,30 @@ func (z *GlobalNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.Cost[za0001] = za0002
 			}
+		case "UnbondingRounds":
+			z.UnbondingRounds, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "UnbondingRounds")
+				return
+			}
+		case "DoubleSignSlash":
+			z.DoubleSignSlash, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DoubleSignSlash")
+				return
+			}
+		case "DoubleSignEvidenceRounds":
+			z.DoubleSignEvidenceRounds, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DoubleSignEvidenceRounds")
+				return
+			}
+		case "Governance":
+			bts, err = z.Governance.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Governance")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -704,6 +744,7 @@ func (z *GlobalNode) Msgsize() (s int) {
 			s += msgp.StringPrefixSize + len(za0001) + msgp.IntSize
 		}
 	}
+	s += 16 + msgp.Int64Size + 16 + msgp.Float64Size + 25 + msgp.Int64Size + 11 + z.Governance.Msgsize()
 	return
 }
 
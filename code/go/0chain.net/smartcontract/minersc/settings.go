@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/currency"
 
 	cstate "0chain.net/chaincore/chain/state"
@@ -46,6 +47,9 @@ const (
 	MaxMint
 	OwnerId
 	CooldownPeriod
+	UnbondingRounds
+	DoubleSignSlash
+	DoubleSignEvidenceRounds
 	CostAddMiner
 	CostAddSharder
 	CostDeleteMiner
@@ -115,6 +119,9 @@ func initSettingName() {
 	SettingName[MaxMint] = "max_mint"
 	SettingName[OwnerId] = "owner_id"
 	SettingName[CooldownPeriod] = "cooldown_period"
+	SettingName[UnbondingRounds] = "unbonding_rounds"
+	SettingName[DoubleSignSlash] = "double_sign_slash"
+	SettingName[DoubleSignEvidenceRounds] = "double_sign_evidence_rounds"
 	SettingName[HealthCheckPeriod] = "health_check_period"
 	SettingName[CostAddMiner] = "cost.add_miner"
 	SettingName[CostAddSharder] = "cost.add_sharder"
@@ -167,6 +174,9 @@ func initSettings() {
 		MaxMint.String():                     {MaxMint, config.CurrencyCoin},
 		OwnerId.String():                     {OwnerId, config.Key},
 		CooldownPeriod.String():              {CooldownPeriod, config.Int64},
+		UnbondingRounds.String():             {UnbondingRounds, config.Int64},
+		DoubleSignSlash.String():             {DoubleSignSlash, config.Float64},
+		DoubleSignEvidenceRounds.String():    {DoubleSignEvidenceRounds, config.Int64},
 		HealthCheckPeriod.String():           {HealthCheckPeriod, config.Duration},
 		CostAddMiner.String():                {CostAddMiner, config.Cost},
 		CostAddSharder.String():              {CostAddSharder, config.Cost},
@@ -251,6 +261,10 @@ func (gn *GlobalNode) setInt64(key string, change int64) error {
 		gn.Epoch = change
 	case CooldownPeriod:
 		gn.CooldownPeriod = change
+	case UnbondingRounds:
+		gn.UnbondingRounds = change
+	case DoubleSignEvidenceRounds:
+		gn.DoubleSignEvidenceRounds = change
 	default:
 		return fmt.Errorf("key: %v not implemented as int64", key)
 	}
@@ -273,6 +287,8 @@ func (gn *GlobalNode) setFloat64(key string, change float64) error {
 		gn.MaxCharge = change
 	case RewardDeclineRate:
 		gn.RewardDeclineRate = change
+	case DoubleSignSlash:
+		gn.DoubleSignSlash = change
 	default:
 		return fmt.Errorf("key: %v not implemented as float64", key)
 	}
@@ -323,6 +339,10 @@ func isCost(key string) bool {
 }
 
 func (gn *GlobalNode) set(key string, change string) error {
+	if strings.HasPrefix(key, governance.Prefix) {
+		return gn.Governance.Set(strings.TrimPrefix(key, governance.Prefix), change)
+	}
+
 	settings, ok := Settings[key]
 	if !ok {
 		return fmt.Errorf("unsupported key %v", key)
@@ -420,6 +440,10 @@ func (msc *MinerSmartContract) updateSettings(
 		return "", common.NewError("update_settings", err.Error())
 	}
 
+	if err := governance.CheckOwnerChanges(changes); err != nil {
+		return "", common.NewError("update_settings", err.Error())
+	}
+
 	if err := gn.update(changes); err != nil {
 		return "", common.NewError("update_settings", err.Error())
 	}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/currency"
 
 	chainstate "0chain.net/chaincore/chain/state"
@@ -161,6 +162,12 @@ func TestUpdateSettings(t *testing.T) {
 			gn: &GlobalNode{
 				OwnerId: owner,
 				Cost:    make(map[string]int),
+				Governance: governance.Config{
+					Quorum:       1,
+					Threshold:    0.5,
+					VotingPeriod: time.Hour,
+					MaxVoters:    1,
+				},
 			},
 			balances: balances,
 		}
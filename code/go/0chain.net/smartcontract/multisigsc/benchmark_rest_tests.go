@@ -0,0 +1,46 @@
+package multisigsc
+
+import (
+	"0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/rest"
+)
+
+func BenchmarkRestTests(
+	data benchmark.BenchData, _ benchmark.SignatureScheme,
+) benchmark.TestSuite {
+	rh := rest.NewRestHandler(&rest.TestQueryChainer{})
+	mrh := NewMultiSigRestHandler(rh)
+	return benchmark.GetRestTests(
+		[]benchmark.TestParameters{
+			{
+				FuncName: "wallet",
+				Params: map[string]string{
+					"client_id": data.Clients[1],
+				},
+				Endpoint: mrh.getWallet,
+			},
+			{
+				FuncName: "wallet-proposals",
+				Params: map[string]string{
+					"client_id": data.Clients[1],
+					"status":    "pending",
+				},
+				Endpoint: mrh.getWalletProposals,
+			},
+			{
+				FuncName: "pending-proposals",
+				Params: map[string]string{
+					"client_id": data.Clients[1],
+				},
+				Endpoint: mrh.getPendingProposals,
+			},
+			{
+				FuncName: "expiration-queue",
+				Endpoint: mrh.getExpirationQueue,
+			},
+		},
+		Address,
+		mrh,
+		benchmark.MultiSigRest,
+	)
+}
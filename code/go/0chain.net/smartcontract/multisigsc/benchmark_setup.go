@@ -1,15 +1,23 @@
 package multisigsc
 
 import (
+	"log"
+	"strconv"
+
 	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/core/common"
 	"0chain.net/core/viper"
 	"0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/dbs/event"
 )
 
 func AddMockWallets(
 	clients, publicKeys []string,
+	eventDb *event.EventDb,
 	balances cstate.StateContextI,
 ) {
+	wallets := make([]event.MultisigWallet, 0, len(clients))
 	for i := 1; i < len(clients)-1; i++ {
 		wallet := Wallet{
 			ClientID:           clients[i],
@@ -23,5 +31,36 @@ func AddMockWallets(
 		if err != nil {
 			panic(err)
 		}
+		wallets = append(wallets, wallet.toEvent())
+	}
+	if err := eventDb.Store.Get().Create(&wallets).Error; err != nil {
+		log.Fatal(err)
 	}
 }
+
+func AddMockProposals(
+	clients []string,
+	eventDb *event.EventDb,
+) {
+	proposals := make([]event.MultisigProposal, 0, len(clients))
+	for i := 1; i < len(clients)-1; i++ {
+		p := proposal{
+			ProposalID:     getMockProposalID(i),
+			ExpirationDate: common.Now() + ExpirationTime,
+			Transfer: state.Transfer{
+				ClientID:   clients[i],
+				ToClientID: clients[i+1],
+				Amount:     1,
+			},
+			SignerThresholdIDs: clients[:MinSigners],
+		}
+		proposals = append(proposals, p.toEvent(ProposalPending))
+	}
+	if err := eventDb.Store.Get().Create(&proposals).Error; err != nil {
+		log.Fatal(err)
+	}
+}
+
+func getMockProposalID(client int) string {
+	return "mock proposal " + strconv.Itoa(client)
+}
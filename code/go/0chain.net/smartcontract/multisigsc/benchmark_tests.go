@@ -61,6 +61,14 @@ func (bt BenchTest) Run(balances cstate.TimedQueryStateContext, _ *testing.B) er
 			bt.input,
 			balances,
 		)
+	case UpdateFuncName:
+		_, err = msc.voteUpdate(
+			bt.txn.Hash,
+			bt.txn.ClientID,
+			balances.GetBlock().CreationDate,
+			bt.input,
+			balances,
+		)
 	default:
 		panic("unknown endpoint: " + bt.endpoint)
 	}
@@ -127,6 +135,28 @@ func BenchmarkTests(
 				return bytes
 			}(),
 		},
+		{
+			name:     "multi_sig." + UpdateFuncName,
+			endpoint: UpdateFuncName,
+			txn: &transaction.Transaction{
+				ClientID: data.Clients[0],
+				HashIDField: datastore.HashIDField{
+					Hash: "my hash",
+				},
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&UpdateVote{
+					ProposalID: "my proposal",
+					ClientID:   data.Clients[1],
+					Update: WalletUpdate{
+						Type:        ChangeThreshold,
+						NumRequired: MinSigners,
+					},
+				})
+				return bytes
+			}(),
+		},
 	}
 	var testsI []bk.BenchTestI
 	for _, test := range tests {
@@ -0,0 +1,246 @@
+package multisigsc
+
+import (
+	"net/http"
+
+	"0chain.net/core/common"
+	"0chain.net/smartcontract"
+	common2 "0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/rest"
+	"github.com/0chain/common/core/util"
+)
+
+type MultiSigRestHandler struct {
+	rest.RestHandlerI
+}
+
+func NewMultiSigRestHandler(rh rest.RestHandlerI) *MultiSigRestHandler {
+	return &MultiSigRestHandler{rh}
+}
+
+func SetupRestHandler(rh rest.RestHandlerI) {
+	rh.Register(GetEndpoints(rh))
+}
+
+func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
+	mrh := NewMultiSigRestHandler(rh)
+	multisig := "/v1/screst/" + Address
+	return []rest.Endpoint{
+		rest.MakeEndpoint(multisig+"/wallet", common.UserRateLimit(mrh.getWallet)),
+		rest.MakeEndpoint(multisig+"/wallet-proposals", common.UserRateLimit(mrh.getWalletProposals)),
+		rest.MakeEndpoint(multisig+"/pending-proposals", common.UserRateLimit(mrh.getPendingProposals)),
+		rest.MakeEndpoint(multisig+"/expiration-queue", common.UserRateLimit(mrh.getExpirationQueue)),
+	}
+}
+
+// swagger:route GET /v1/screst/27b5ef7120252b79f9dd9c05505dd28f328c80f6863ee446daede08a84d651a7/wallet wallet
+// get a registered multi-sig wallet with its signers
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client id of the multi-sig wallet
+//	 required: true
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: MultisigWallet
+//	400:
+//	500:
+func (mrh *MultiSigRestHandler) getWallet(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	edb := mrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	wallet, err := edb.GetMultisigWallet(clientID)
+	if err != nil {
+		common.Respond(w, r, nil, smartcontract.NewErrNoResourceOrErrInternal(err, true, "can't get wallet"))
+		return
+	}
+	common.Respond(w, r, wallet, nil)
+}
+
+var proposalStatuses = map[string]ProposalStatus{
+	"pending":  ProposalPending,
+	"executed": ProposalExecuted,
+	"expired":  ProposalExpired,
+}
+
+// swagger:route GET /v1/screst/27b5ef7120252b79f9dd9c05505dd28f328c80f6863ee446daede08a84d651a7/wallet-proposals wallet-proposals
+// get proposals of a multi-sig wallet
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client id of the multi-sig wallet
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: status
+//	 description: pending, executed or expired, all proposals if not set
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []MultisigProposal
+//	400:
+//	500:
+func (mrh *MultiSigRestHandler) getWalletProposals(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	var status *int
+	if s := r.URL.Query().Get("status"); s != "" {
+		ps, ok := proposalStatuses[s]
+		if !ok {
+			common.Respond(w, r, nil, common.NewErrBadRequest("invalid status: "+s))
+			return
+		}
+		st := int(ps)
+		status = &st
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := mrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	proposals, err := edb.GetMultisigProposals(clientID, status, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get wallet proposals", err.Error()))
+		return
+	}
+	common.Respond(w, r, proposals, nil)
+}
+
+// swagger:route GET /v1/screst/27b5ef7120252b79f9dd9c05505dd28f328c80f6863ee446daede08a84d651a7/pending-proposals pending-proposals
+// get not executed and not expired proposals of a multi-sig wallet with threshold ids of the signers voted
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client id of the multi-sig wallet
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []MultisigProposal
+//	400:
+//	500:
+func (mrh *MultiSigRestHandler) getPendingProposals(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := mrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	// expired proposals are pruned lazily, skip them
+	proposals, err := edb.GetMultisigPendingProposals(clientID, int64(common.Now()), int(ProposalPending), limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get pending proposals", err.Error()))
+		return
+	}
+	common.Respond(w, r, proposals, nil)
+}
+
+// swagger:model expirationQueueInfo
+type expirationQueueInfo struct {
+	Head proposalRef `json:"head"`
+	Tail proposalRef `json:"tail"`
+	// Expiration date of the head proposal, it's pruned by the first vote
+	// after the date.
+	HeadExpirationDate common.Timestamp `json:"head_expiration_date"`
+}
+
+// swagger:route GET /v1/screst/27b5ef7120252b79f9dd9c05505dd28f328c80f6863ee446daede08a84d651a7/expiration-queue expiration-queue
+// get the queue of proposals of all the wallets sorted by expiration date
+//
+// responses:
+//
+//	200: expirationQueueInfo
+//	500:
+func (mrh *MultiSigRestHandler) getExpirationQueue(w http.ResponseWriter, r *http.Request) {
+	var (
+		balances = mrh.GetQueryStateContext()
+		q        expirationQueue
+		info     expirationQueueInfo
+	)
+	err := balances.GetTrieNode(getExpirationQueueKey(), &q)
+	switch err {
+	case nil:
+	case util.ErrValueNotPresent:
+		common.Respond(w, r, info, nil)
+		return
+	default:
+		common.Respond(w, r, nil, common.NewErrInternal("can't get expiration queue", err.Error()))
+		return
+	}
+
+	info.Head, info.Tail = q.Head, q.Tail
+	if q.Head != (proposalRef{}) {
+		var p proposal
+		err = balances.GetTrieNode(getProposalKey(q.Head.ClientID, q.Head.ProposalID), &p)
+		if err != nil {
+			common.Respond(w, r, nil, common.NewErrInternal("can't get head proposal", err.Error()))
+			return
+		}
+		info.HeadExpirationDate = p.ExpirationDate
+	}
+	common.Respond(w, r, info, nil)
+}
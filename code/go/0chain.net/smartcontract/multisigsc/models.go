@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 
 	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
 	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
 )
 
-//msgp:ignore Vote
+//msgp:ignore Vote UpdateVote CallVote walletSigner
 //go:generate msgp -io=false -tests=false -unexported -v
 
 const (
@@ -19,6 +21,9 @@ const (
 	MaxSigners   = 20
 	MinSigners   = 2
 	MaxFieldSize = 256
+	// MaxCallInputSize limits the input data of a smart contract call
+	// proposal, it's stored in the proposal until the call is executed.
+	MaxCallInputSize = 4 * 1024
 )
 
 type Wallet struct {
@@ -42,6 +47,34 @@ func (w Wallet) Decode(input []byte) error {
 	return err
 }
 
+type walletSigner struct {
+	ThresholdID string `json:"threshold_id"`
+	PublicKey   string `json:"public_key"`
+	ClientID    string `json:"client_id"`
+}
+
+func (w Wallet) toEvent() event.MultisigWallet {
+	signers := make([]walletSigner, 0, len(w.SignerThresholdIDs))
+	for i, id := range w.SignerThresholdIDs {
+		s := walletSigner{ThresholdID: id}
+		if i < len(w.SignerPublicKeys) {
+			s.PublicKey = w.SignerPublicKeys[i]
+			if b, err := hex.DecodeString(s.PublicKey); err == nil {
+				s.ClientID = encryption.Hash(b)
+			}
+		}
+		signers = append(signers, s)
+	}
+	data, _ := json.Marshal(signers)
+	return event.MultisigWallet{
+		WalletID:        w.ClientID,
+		SignatureScheme: w.SignatureScheme,
+		PublicKey:       w.PublicKey,
+		Signers:         string(data),
+		NumRequired:     w.NumRequired,
+	}
+}
+
 func (w Wallet) isEmpty() bool {
 	return w.ClientID == ""
 }
@@ -144,6 +177,56 @@ func hasDuplicates(ss []string) bool {
 	return false
 }
 
+func (w Wallet) signerIndex(signerThresholdID string) int {
+	for i, id := range w.SignerThresholdIDs {
+		if id == signerThresholdID {
+			return i
+		}
+	}
+	return -1
+}
+
+// Apply the update to a copy of the wallet. The result is validated the same
+// way as a newly registered wallet.
+//
+// The contract can't check that the new signer keys are shares of the wallet
+// key, signers must deal them the same way they did on register. Otherwise
+// the signatures reconstructed for transfers won't verify.
+func (w Wallet) applyUpdate(u WalletUpdate) (Wallet, error) {
+	updated := w
+	updated.SignerThresholdIDs = append([]string{}, w.SignerThresholdIDs...)
+	updated.SignerPublicKeys = append([]string{}, w.SignerPublicKeys...)
+
+	switch u.Type {
+	case RotateSignerKey:
+		i := w.signerIndex(u.SignerThresholdID)
+		if i < 0 {
+			return Wallet{}, common.NewError("err_update_signer_not_found", "no signer with the threshold id")
+		}
+		updated.SignerPublicKeys[i] = u.SignerPublicKey
+	case AddSigner:
+		updated.SignerThresholdIDs = append(updated.SignerThresholdIDs, u.SignerThresholdID)
+		updated.SignerPublicKeys = append(updated.SignerPublicKeys, u.SignerPublicKey)
+	case RemoveSigner:
+		i := w.signerIndex(u.SignerThresholdID)
+		if i < 0 {
+			return Wallet{}, common.NewError("err_update_signer_not_found", "no signer with the threshold id")
+		}
+		updated.SignerThresholdIDs = append(updated.SignerThresholdIDs[:i], updated.SignerThresholdIDs[i+1:]...)
+		updated.SignerPublicKeys = append(updated.SignerPublicKeys[:i], updated.SignerPublicKeys[i+1:]...)
+	case ChangeThreshold:
+		updated.NumRequired = u.NumRequired
+	default:
+		return Wallet{}, common.NewError("err_update_unknown_type", "unknown wallet update type")
+	}
+
+	if _, err := updated.valid(w.ClientID); err != nil {
+		return Wallet{}, err
+	}
+
+	return updated, nil
+}
+
 func (w Wallet) isVoteAuthorized(signingClientID string, v Vote) bool {
 	publicKey := w.publicKeyForSigner(signingClientID)
 	if publicKey == "" {
@@ -283,8 +366,126 @@ func (v Vote) getProposalRef() proposalRef {
 	}
 }
 
+// Proposal created by the first vote.
+func (v Vote) newProposal() proposal {
+	return proposal{
+		ProposalID: v.ProposalID,
+		Type:       TransferProposal,
+		Transfer:   v.Transfer,
+	}
+}
+
 func (v Vote) isCompatibleWithProposal(p proposal) bool {
-	return v.Transfer == p.Transfer
+	return p.Type == TransferProposal && v.Transfer == p.Transfer
+}
+
+type WalletUpdateType int
+
+const (
+	// Replace the public key of a signer, e.g. when the key was lost.
+	RotateSignerKey WalletUpdateType = iota + 1
+	AddSigner
+	RemoveSigner
+	ChangeThreshold
+)
+
+// Change of the signers of a registered multi-sig wallet. Applied once the
+// threshold of the signers voted for it.
+type WalletUpdate struct {
+	Type WalletUpdateType `json:"type"`
+
+	// Signer to rotate, add or remove.
+	SignerThresholdID string `json:"signer_threshold_id,omitempty"`
+	// New public key of the signer to rotate or add.
+	SignerPublicKey string `json:"signer_public_key,omitempty"`
+
+	// New threshold.
+	NumRequired int `json:"num_required,omitempty"`
+}
+
+// Vote for a wallet update. Unlike transfer votes it isn't signed, the voter
+// is the client of the transaction.
+type UpdateVote struct {
+	ProposalID string `json:"proposal_id"`
+
+	// Client ID of the multi-sig wallet.
+	ClientID string `json:"client_id"`
+
+	Update WalletUpdate `json:"update"`
+}
+
+func (v UpdateVote) notTooBig() bool {
+	return len(v.ProposalID) <= MaxFieldSize &&
+		len(v.ClientID) <= MaxFieldSize &&
+		len(v.Update.SignerThresholdID) <= MaxFieldSize &&
+		len(v.Update.SignerPublicKey) <= MaxFieldSize
+}
+
+// Proposal created by the first vote.
+func (v UpdateVote) newProposal() proposal {
+	return proposal{
+		ProposalID: v.ProposalID,
+		Type:       WalletUpdateProposal,
+		Transfer:   state.Transfer{ClientID: v.ClientID},
+		Update:     v.Update,
+	}
+}
+
+func (v UpdateVote) isCompatibleWithProposal(p proposal) bool {
+	return p.Type == WalletUpdateProposal && v.Update == p.Update
+}
+
+// Call of a smart contract function made by the multi-sig wallet. Calls
+// can't carry tokens, but the called smart contract can pay the wallet.
+type SmartContractCall struct {
+	// Address of the called smart contract.
+	Address      string `json:"address"`
+	FunctionName string `json:"name"`
+	InputData    string `json:"input"`
+}
+
+// Vote for a smart contract call. Like update votes it isn't signed, the
+// voter is the client of the transaction.
+type CallVote struct {
+	ProposalID string `json:"proposal_id"`
+
+	// Client ID of the multi-sig wallet.
+	ClientID string `json:"client_id"`
+
+	// Address of the called smart contract.
+	Address string `json:"address"`
+
+	transaction.SmartContractData
+}
+
+func (v CallVote) notTooBig() bool {
+	return len(v.ProposalID) <= MaxFieldSize &&
+		len(v.ClientID) <= MaxFieldSize &&
+		len(v.Address) <= MaxFieldSize &&
+		len(v.FunctionName) <= MaxFieldSize &&
+		len(v.InputData) <= MaxCallInputSize
+}
+
+func (v CallVote) getCall() SmartContractCall {
+	return SmartContractCall{
+		Address:      v.Address,
+		FunctionName: v.FunctionName,
+		InputData:    string(v.InputData),
+	}
+}
+
+// Proposal created by the first vote.
+func (v CallVote) newProposal() proposal {
+	return proposal{
+		ProposalID: v.ProposalID,
+		Type:       SmartContractCallProposal,
+		Transfer:   state.Transfer{ClientID: v.ClientID},
+		Call:       v.getCall(),
+	}
+}
+
+func (v CallVote) isCompatibleWithProposal(p proposal) bool {
+	return p.Type == SmartContractCallProposal && v.getCall() == p.Call
 }
 
 // Uniquely identifies a proposal. Can be used to refer to one.
@@ -303,7 +504,26 @@ func (pr *proposalRef) Decode(input []byte) error {
 	return err
 }
 
-// Proposal to transfer tokens out of the multi-sig wallet. Built up from T
+type ProposalType int
+
+const (
+	TransferProposal ProposalType = iota
+	WalletUpdateProposal
+	SmartContractCallProposal
+)
+
+// Status of a proposal stored in event DB. The MPT doesn't keep expired
+// proposals.
+type ProposalStatus int
+
+const (
+	ProposalPending ProposalStatus = iota
+	ProposalExecuted
+	ProposalExpired
+)
+
+// Proposal to transfer tokens out of the multi-sig wallet, to update the
+// wallet or to call a smart contract on behalf of the wallet. Built up from T
 // different votes.
 type proposal struct {
 	// Proposal ID is unique only within a single multi-sig wallet. Globally, a
@@ -315,15 +535,25 @@ type proposal struct {
 	Next proposalRef `json:"next"`
 	Prev proposalRef `json:"prev"`
 
-	Transfer state.Transfer `json:"transfer"`
+	Type ProposalType `json:"type"`
+
+	// Client ID in transfer is that of the multi-sig wallet for any type of
+	// proposal. The rest of the transfer is empty for other proposals.
+	Transfer state.Transfer    `json:"transfer"`
+	Update   WalletUpdate      `json:"update"`
+	Call     SmartContractCall `json:"call"`
 
-	// Pertinent data from votes.
+	// Pertinent data from votes. Signatures are collected for transfers
+	// only.
 	SignerThresholdIDs []string `json:"signer_threshold_ids"`
 	SignerSignatures   []string `json:"signer_signatures"`
 
 	// Filled upon completing a proposal.
 	ClientSignature   string `json:"client_signature"`
 	ExecutedInTxnHash string `json:"executed_in_txn_hash"`
+
+	// Output of the smart contract call.
+	Result string `json:"result"`
 }
 
 func (p *proposal) Encode() []byte {
@@ -340,6 +570,56 @@ func (p proposal) isEmpty() bool {
 	return p.Transfer.ClientID == ""
 }
 
+// Drop votes of signers removed from the wallet after they voted.
+func (p *proposal) dropStaleVotes(w Wallet) {
+	var (
+		ids  = make([]string, 0, len(p.SignerThresholdIDs))
+		sigs = make([]string, 0, len(p.SignerSignatures))
+	)
+	for i, id := range p.SignerThresholdIDs {
+		if w.signerIndex(id) < 0 {
+			continue
+		}
+		ids = append(ids, id)
+		if i < len(p.SignerSignatures) {
+			sigs = append(sigs, p.SignerSignatures[i])
+		}
+	}
+	p.SignerThresholdIDs, p.SignerSignatures = ids, sigs
+}
+
+func (p proposal) status() ProposalStatus {
+	if p.ExecutedInTxnHash != "" {
+		return ProposalExecuted
+	}
+	return ProposalPending
+}
+
+func (p proposal) toEvent(status ProposalStatus) event.MultisigProposal {
+	var data []byte
+	switch p.Type {
+	case WalletUpdateProposal:
+		data, _ = json.Marshal(p.Update)
+	case SmartContractCallProposal:
+		data, _ = json.Marshal(p.Call)
+	default:
+		data, _ = json.Marshal(p.Transfer)
+	}
+	voters, _ := json.Marshal(p.SignerThresholdIDs)
+	return event.MultisigProposal{
+		WalletID:           p.Transfer.ClientID,
+		ProposalID:         p.ProposalID,
+		Type:               int(p.Type),
+		Data:               string(data),
+		Votes:              len(p.SignerThresholdIDs),
+		SignerThresholdIDs: string(voters),
+		ExpirationDate:     int64(p.ExpirationDate),
+		Status:             int(status),
+		ExecutedInTxnHash:  p.ExecutedInTxnHash,
+		Result:             p.Result,
+	}
+}
+
 func (p proposal) isExpired(now common.Timestamp) bool {
 	return now >= p.ExpirationDate
 }
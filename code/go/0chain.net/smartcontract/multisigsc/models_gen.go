@@ -6,6 +6,132 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// MarshalMsg implements msgp.Marshaler
+func (z ProposalStatus) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ProposalStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = ProposalStatus(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z ProposalStatus) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z ProposalType) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ProposalType) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = ProposalType(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z ProposalType) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z SmartContractCall) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Address"
+	o = append(o, 0x83, 0xa7, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	o = msgp.AppendString(o, z.Address)
+	// string "FunctionName"
+	o = append(o, 0xac, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.FunctionName)
+	// string "InputData"
+	o = append(o, 0xa9, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61)
+	o = msgp.AppendString(o, z.InputData)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SmartContractCall) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Address":
+			z.Address, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Address")
+				return
+			}
+		case "FunctionName":
+			z.FunctionName, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FunctionName")
+				return
+			}
+		case "InputData":
+			z.InputData, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "InputData")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z SmartContractCall) Msgsize() (s int) {
+	s = 1 + 8 + msgp.StringPrefixSize + len(z.Address) + 13 + msgp.StringPrefixSize + len(z.FunctionName) + 10 + msgp.StringPrefixSize + len(z.InputData)
+	return
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *Wallet) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
@@ -143,6 +269,117 @@ func (z *Wallet) Msgsize() (s int) {
 	return
 }
 
+// MarshalMsg implements msgp.Marshaler
+func (z *WalletUpdate) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "Type"
+	o = append(o, 0x84, 0xa4, 0x54, 0x79, 0x70, 0x65)
+	o = msgp.AppendInt(o, int(z.Type))
+	// string "SignerThresholdID"
+	o = append(o, 0xb1, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44)
+	o = msgp.AppendString(o, z.SignerThresholdID)
+	// string "SignerPublicKey"
+	o = append(o, 0xaf, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79)
+	o = msgp.AppendString(o, z.SignerPublicKey)
+	// string "NumRequired"
+	o = append(o, 0xab, 0x4e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64)
+	o = msgp.AppendInt(o, z.NumRequired)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *WalletUpdate) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Type":
+			{
+				var zb0002 int
+				zb0002, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Type")
+					return
+				}
+				z.Type = WalletUpdateType(zb0002)
+			}
+		case "SignerThresholdID":
+			z.SignerThresholdID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SignerThresholdID")
+				return
+			}
+		case "SignerPublicKey":
+			z.SignerPublicKey, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SignerPublicKey")
+				return
+			}
+		case "NumRequired":
+			z.NumRequired, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumRequired")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *WalletUpdate) Msgsize() (s int) {
+	s = 1 + 5 + msgp.IntSize + 18 + msgp.StringPrefixSize + len(z.SignerThresholdID) + 16 + msgp.StringPrefixSize + len(z.SignerPublicKey) + 12 + msgp.IntSize
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z WalletUpdateType) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *WalletUpdateType) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = WalletUpdateType(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z WalletUpdateType) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *expirationQueue) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
@@ -277,9 +514,9 @@ func (z *expirationQueue) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *proposal) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 9
+	// map header, size 13
 	// string "ProposalID"
-	o = append(o, 0x89, 0xaa, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44)
+	o = append(o, 0x8d, 0xaa, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ProposalID)
 	// string "ExpirationDate"
 	o = append(o, 0xae, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65)
@@ -306,6 +543,9 @@ func (z *proposal) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "ProposalID"
 	o = append(o, 0xaa, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44)
 	o = msgp.AppendString(o, z.Prev.ProposalID)
+	// string "Type"
+	o = append(o, 0xa4, 0x54, 0x79, 0x70, 0x65)
+	o = msgp.AppendInt(o, int(z.Type))
 	// string "Transfer"
 	o = append(o, 0xa8, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72)
 	o, err = z.Transfer.MarshalMsg(o)
@@ -313,6 +553,25 @@ func (z *proposal) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Transfer")
 		return
 	}
+	// string "Update"
+	o = append(o, 0xa6, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65)
+	o, err = z.Update.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Update")
+		return
+	}
+	// string "Call"
+	o = append(o, 0xa4, 0x43, 0x61, 0x6c, 0x6c)
+	// map header, size 3
+	// string "Address"
+	o = append(o, 0x83, 0xa7, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	o = msgp.AppendString(o, z.Call.Address)
+	// string "FunctionName"
+	o = append(o, 0xac, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Call.FunctionName)
+	// string "InputData"
+	o = append(o, 0xa9, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61)
+	o = msgp.AppendString(o, z.Call.InputData)
 	// string "SignerThresholdIDs"
 	o = append(o, 0xb2, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x73)
 	o = msgp.AppendArrayHeader(o, uint32(len(z.SignerThresholdIDs)))
@@ -331,6 +590,9 @@ func (z *proposal) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "ExecutedInTxnHash"
 	o = append(o, 0xb1, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x54, 0x78, 0x6e, 0x48, 0x61, 0x73, 0x68)
 	o = msgp.AppendString(o, z.ExecutedInTxnHash)
+	// string "Result"
+	o = append(o, 0xa6, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74)
+	o = msgp.AppendString(o, z.Result)
 	return
 }
 
@@ -434,23 +696,80 @@ func (z *proposal) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					}
 				}
 			}
+		case "Type":
+			{
+				var zb0004 int
+				zb0004, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Type")
+					return
+				}
+				z.Type = ProposalType(zb0004)
+			}
 		case "Transfer":
 			bts, err = z.Transfer.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Transfer")
 				return
 			}
+		case "Update":
+			bts, err = z.Update.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Update")
+				return
+			}
+		case "Call":
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Call")
+				return
+			}
+			for zb0005 > 0 {
+				zb0005--
+				field, bts, err = msgp.ReadMapKeyZC(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Call")
+					return
+				}
+				switch msgp.UnsafeString(field) {
+				case "Address":
+					z.Call.Address, bts, err = msgp.ReadStringBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Call", "Address")
+						return
+					}
+				case "FunctionName":
+					z.Call.FunctionName, bts, err = msgp.ReadStringBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Call", "FunctionName")
+						return
+					}
+				case "InputData":
+					z.Call.InputData, bts, err = msgp.ReadStringBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Call", "InputData")
+						return
+					}
+				default:
+					bts, err = msgp.Skip(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Call")
+						return
+					}
+				}
+			}
 		case "SignerThresholdIDs":
-			var zb0004 uint32
-			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			var zb0006 uint32
+			zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "SignerThresholdIDs")
 				return
 			}
-			if cap(z.SignerThresholdIDs) >= int(zb0004) {
-				z.SignerThresholdIDs = (z.SignerThresholdIDs)[:zb0004]
+			if cap(z.SignerThresholdIDs) >= int(zb0006) {
+				z.SignerThresholdIDs = (z.SignerThresholdIDs)[:zb0006]
 			} else {
-				z.SignerThresholdIDs = make([]string, zb0004)
+				z.SignerThresholdIDs = make([]string, zb0006)
 			}
 			for za0001 := range z.SignerThresholdIDs {
 				z.SignerThresholdIDs[za0001], bts, err = msgp.ReadStringBytes(bts)
@@ -460,16 +779,16 @@ func (z *proposal) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			}
 		case "SignerSignatures":
-			var zb0005 uint32
-			zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			var zb0007 uint32
+			zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "SignerSignatures")
 				return
 			}
-			if cap(z.SignerSignatures) >= int(zb0005) {
-				z.SignerSignatures = (z.SignerSignatures)[:zb0005]
+			if cap(z.SignerSignatures) >= int(zb0007) {
+				z.SignerSignatures = (z.SignerSignatures)[:zb0007]
 			} else {
-				z.SignerSignatures = make([]string, zb0005)
+				z.SignerSignatures = make([]string, zb0007)
 			}
 			for za0002 := range z.SignerSignatures {
 				z.SignerSignatures[za0002], bts, err = msgp.ReadStringBytes(bts)
@@ -490,6 +809,12 @@ func (z *proposal) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "ExecutedInTxnHash")
 				return
 			}
+		case "Result":
+			z.Result, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Result")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -504,7 +829,7 @@ func (z *proposal) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *proposal) Msgsize() (s int) {
-	s = 1 + 11 + msgp.StringPrefixSize + len(z.ProposalID) + 15 + z.ExpirationDate.Msgsize() + 5 + 1 + 9 + msgp.StringPrefixSize + len(z.Next.ClientID) + 11 + msgp.StringPrefixSize + len(z.Next.ProposalID) + 5 + 1 + 9 + msgp.StringPrefixSize + len(z.Prev.ClientID) + 11 + msgp.StringPrefixSize + len(z.Prev.ProposalID) + 9 + z.Transfer.Msgsize() + 19 + msgp.ArrayHeaderSize
+	s = 1 + 11 + msgp.StringPrefixSize + len(z.ProposalID) + 15 + z.ExpirationDate.Msgsize() + 5 + 1 + 9 + msgp.StringPrefixSize + len(z.Next.ClientID) + 11 + msgp.StringPrefixSize + len(z.Next.ProposalID) + 5 + 1 + 9 + msgp.StringPrefixSize + len(z.Prev.ClientID) + 11 + msgp.StringPrefixSize + len(z.Prev.ProposalID) + 5 + msgp.IntSize + 9 + z.Transfer.Msgsize() + 7 + z.Update.Msgsize() + 5 + 1 + 8 + msgp.StringPrefixSize + len(z.Call.Address) + 13 + msgp.StringPrefixSize + len(z.Call.FunctionName) + 10 + msgp.StringPrefixSize + len(z.Call.InputData) + 19 + msgp.ArrayHeaderSize
 	for za0001 := range z.SignerThresholdIDs {
 		s += msgp.StringPrefixSize + len(z.SignerThresholdIDs[za0001])
 	}
@@ -512,7 +837,7 @@ func (z *proposal) Msgsize() (s int) {
 	for za0002 := range z.SignerSignatures {
 		s += msgp.StringPrefixSize + len(z.SignerSignatures[za0002])
 	}
-	s += 16 + msgp.StringPrefixSize + len(z.ClientSignature) + 18 + msgp.StringPrefixSize + len(z.ExecutedInTxnHash)
+	s += 16 + msgp.StringPrefixSize + len(z.ClientSignature) + 18 + msgp.StringPrefixSize + len(z.ExecutedInTxnHash) + 7 + msgp.StringPrefixSize + len(z.Result)
 	return
 }
 
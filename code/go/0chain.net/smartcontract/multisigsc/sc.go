@@ -14,6 +14,8 @@ import (
 	"0chain.net/chaincore/smartcontractinterface"
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/dbs/event"
 	. "github.com/0chain/common/core/logging"
 	"github.com/0chain/common/core/util"
 	"go.uber.org/zap"
@@ -24,6 +26,8 @@ const (
 	Address          = "27b5ef7120252b79f9dd9c05505dd28f328c80f6863ee446daede08a84d651a7"
 	RegisterFuncName = "register"
 	VoteFuncName     = "vote"
+	UpdateFuncName   = "update"
+	CallFuncName     = "call"
 	LogTimingInfo    = false
 )
 
@@ -74,6 +78,10 @@ func (ms *MultiSigSmartContract) Execute(t *transaction.Transaction, funcName st
 		return ms.register(t.ClientID, inputData, balances)
 	case VoteFuncName:
 		return ms.vote(t.Hash, t.ClientID, balances.GetBlock().CreationDate, inputData, balances)
+	case UpdateFuncName:
+		return ms.voteUpdate(t.Hash, t.ClientID, balances.GetBlock().CreationDate, inputData, balances)
+	case CallFuncName:
+		return ms.voteCall(t.Hash, t.ClientID, balances.GetBlock().CreationDate, inputData, balances)
 	default:
 		return "err_execute_function_not_found: no multi sig smart contract function with that name: " + funcName, nil
 	}
@@ -159,7 +167,7 @@ func (ms MultiSigSmartContract) vote(currentTxnHash, signingClientID string, now
 
 	// Every vote is associated with a proposal. If an appropriate proposal does
 	// not exist yet, create one.
-	p, err := ms.findOrCreateProposal(now, v, balances)
+	p, err := ms.findOrCreateProposal(now, v.newProposal(), balances)
 	if err != nil {
 		// I/O error.
 		return "", err
@@ -195,7 +203,8 @@ func (ms MultiSigSmartContract) vote(currentTxnHash, signingClientID string, now
 		return "", common.NewError("err_vote_auth", " authorization failure")
 	}
 
-	remaining := w.NumRequired - len(p.SignerSignatures)
+	p.dropStaleVotes(w)
+	remaining := w.NumRequired - len(p.SignerThresholdIDs)
 
 	// Check if this is a duplicate vote.
 	for _, id := range p.SignerThresholdIDs {
@@ -251,6 +260,178 @@ func (ms MultiSigSmartContract) vote(currentTxnHash, signingClientID string, now
 	return msg, nil
 }
 
+// Vote for an update of the signers or the threshold of a multi-sig wallet.
+// The update is applied by the vote reaching the threshold.
+func (ms MultiSigSmartContract) voteUpdate(currentTxnHash, signingClientID string, now common.Timestamp, inputData []byte, balances state.StateContextI) (string, error) {
+	var v UpdateVote
+
+	err := json.Unmarshal(inputData, &v)
+	if err != nil {
+		return "", err
+	}
+
+	if !v.notTooBig() {
+		return "", common.NewError("err_vote_too_big", "an input field exceeded allowable length")
+	}
+	if v.Update.Type < RotateSignerKey || v.Update.Type > ChangeThreshold {
+		return "", common.NewError("err_update_unknown_type", "unknown wallet update type")
+	}
+
+	p, w, msg, err := ms.addUnsignedVote(now, signingClientID, v.ClientID, v.newProposal(), v.isCompatibleWithProposal, balances)
+	if err != nil || msg != "" {
+		return msg, err
+	}
+
+	// Invalid result fails this vote, the proposal stays pending until it
+	// expires.
+	updated, err := w.applyUpdate(p.Update)
+	if err != nil {
+		return "", err
+	}
+
+	err = ms.putWallet(updated, balances)
+	if err != nil {
+		// I/O error.
+		return "", err
+	}
+
+	p.ExecutedInTxnHash = currentTxnHash
+
+	err = ms.putProposal(&p, balances)
+	if err != nil {
+		// I/O error.
+		return "", err
+	}
+
+	return "success 0: wallet updated", nil
+}
+
+// Vote for a call of a smart contract function on behalf of a multi-sig
+// wallet. The call is executed by the vote reaching the threshold, in the
+// same transaction.
+func (ms MultiSigSmartContract) voteCall(currentTxnHash, signingClientID string, now common.Timestamp, inputData []byte, balances state.StateContextI) (string, error) {
+	cb, ok := balances.(state.CallStateContextI)
+	if !ok {
+		return "", common.NewError("err_call_not_supported", "smart contract calls are not supported")
+	}
+
+	var v CallVote
+
+	err := json.Unmarshal(inputData, &v)
+	if err != nil {
+		return "", err
+	}
+
+	if !v.notTooBig() {
+		return "", common.NewError("err_vote_too_big", "an input field exceeded allowable length")
+	}
+	if v.Address == Address || smartcontract.GetSmartContract(v.Address) == nil {
+		return "", common.NewError("err_call_invalid_address", "invalid smart contract address")
+	}
+
+	p, w, msg, err := ms.addUnsignedVote(now, signingClientID, v.ClientID, v.newProposal(), v.isCompatibleWithProposal, balances)
+	if err != nil || msg != "" {
+		return msg, err
+	}
+
+	// The call is made by the wallet. Failed call fails this vote, the
+	// proposal stays pending until it expires.
+	txn := &transaction.Transaction{
+		HashIDField:     datastore.HashIDField{Hash: currentTxnHash},
+		ClientID:        w.ClientID,
+		ToClientID:      p.Call.Address,
+		CreationDate:    now,
+		TransactionType: transaction.TxnTypeSmartContract,
+		SmartContractData: &transaction.SmartContractData{
+			FunctionName: p.Call.FunctionName,
+			InputData:    json.RawMessage(p.Call.InputData),
+		},
+	}
+	result, err := smartcontract.ExecuteSmartContract(txn, cb.WithTransaction(txn))
+	if err != nil {
+		return "", common.NewError("err_call_failed", err.Error())
+	}
+
+	p.Result = result
+	p.ExecutedInTxnHash = currentTxnHash
+
+	err = ms.putProposal(&p, balances)
+	if err != nil {
+		// I/O error.
+		return "", err
+	}
+
+	return "success 0: smart contract call executed with result " + result, nil
+}
+
+// Add a vote of a signer to a proposal which doesn't need signatures, the
+// voter is the client of the transaction. Non-empty message means the vote
+// didn't reach the threshold and there is nothing to execute.
+func (ms MultiSigSmartContract) addUnsignedVote(now common.Timestamp, signingClientID, walletID string, newProposal proposal,
+	isCompatible func(proposal) bool, balances state.StateContextI) (proposal, Wallet, string, error) {
+
+	err := ms.pruneExpirationQueue(now, balances)
+	if err != nil {
+		// I/O error.
+		if err != util.ErrValueNotPresent && err != util.ErrNodeNotFound {
+			return proposal{}, Wallet{}, "", err
+		} //else there are no expiration queue.
+	}
+
+	// Check that the multi-sig wallet is registered and the voter is its
+	// signer before creating a proposal.
+	w, err := ms.getWallet(walletID, balances)
+	if err != nil {
+		if err == util.ErrValueNotPresent {
+			return proposal{}, Wallet{}, "", common.NewError("err_vote_wallet_not_registered", " wallet not registered")
+		}
+		// I/O error.
+		return proposal{}, Wallet{}, "", err
+	}
+
+	signerThresholdID := w.thresholdIdForSigner(signingClientID)
+	if signerThresholdID == "" {
+		return proposal{}, Wallet{}, "", common.NewError("err_vote_auth", " authorization failure")
+	}
+
+	p, err := ms.findOrCreateProposal(now, newProposal, balances)
+	if err != nil {
+		// I/O error.
+		return proposal{}, Wallet{}, "", err
+	}
+
+	if !isCompatible(p) {
+		return proposal{}, Wallet{}, "", common.NewError("err_vote_not_compatible", " previous votes for same proposal differed")
+	}
+
+	if p.ExecutedInTxnHash != "" {
+		return p, w, "success 0: proposal previously executed in transaction hash " + p.ExecutedInTxnHash, nil
+	}
+
+	p.dropStaleVotes(w)
+	remaining := w.NumRequired - len(p.SignerThresholdIDs)
+
+	for _, id := range p.SignerThresholdIDs {
+		if id == signerThresholdID {
+			return p, w, fmt.Sprintf("success %d: already voted, still need %d other votes", remaining, remaining), nil
+		}
+	}
+
+	p.SignerThresholdIDs = append(p.SignerThresholdIDs, signerThresholdID)
+	remaining--
+
+	if remaining > 0 {
+		err = ms.putProposal(&p, balances)
+		if err != nil {
+			// I/O error.
+			return proposal{}, Wallet{}, "", err
+		}
+		return p, w, fmt.Sprintf("success %d: need %d more votes", remaining, remaining), nil
+	}
+
+	return p, w, "", nil
+}
+
 // Prune the oldest proposal if it has expired.
 func (ms MultiSigSmartContract) pruneExpirationQueue(now common.Timestamp, balances state.StateContextI) error {
 	q, err := ms.getOrCreateExpirationQueue(balances)
@@ -344,12 +525,17 @@ func (ms MultiSigSmartContract) prune(ref proposalRef, balances c_state.StateCon
 		return err
 	}
 
+	if p.status() == ProposalPending {
+		balances.EmitEvent(event.TypeStats, event.TagAddOrOverwriteMultisigProposal, p.getKey(),
+			[]event.MultisigProposal{p.toEvent(ProposalExpired)})
+	}
+
 	return nil
 }
 
-func (ms MultiSigSmartContract) findOrCreateProposal(now common.Timestamp, v Vote, balances state.StateContextI) (proposal, error) {
+func (ms MultiSigSmartContract) findOrCreateProposal(now common.Timestamp, newProposal proposal, balances state.StateContextI) (proposal, error) {
 	// Start by trying to find an existing proposal.
-	p, err := ms.getProposal(v.getProposalRef(), balances)
+	p, err := ms.getProposal(newProposal.ref(), balances)
 	if err != nil {
 		return proposal{}, err
 	}
@@ -366,7 +552,7 @@ func (ms MultiSigSmartContract) findOrCreateProposal(now common.Timestamp, v Vot
 
 	// If it didn't exist or was expired, create it and update expiration queue.
 	if p.isEmpty() {
-		p, err = ms.createProposal(now, v, balances)
+		p, err = ms.createProposal(now, newProposal, balances)
 		if err != nil {
 			return proposal{}, err
 		}
@@ -376,7 +562,7 @@ func (ms MultiSigSmartContract) findOrCreateProposal(now common.Timestamp, v Vot
 }
 
 // Create a proposal and add it to the expiration queue. Performs I/O.
-func (ms MultiSigSmartContract) createProposal(now common.Timestamp, v Vote, balances state.StateContextI) (proposal, error) {
+func (ms MultiSigSmartContract) createProposal(now common.Timestamp, p proposal, balances state.StateContextI) (proposal, error) {
 	q, err := ms.getOrCreateExpirationQueue(balances)
 	if err != nil {
 		if err != util.ErrValueNotPresent && err != util.ErrNodeNotFound {
@@ -385,21 +571,16 @@ func (ms MultiSigSmartContract) createProposal(now common.Timestamp, v Vote, bal
 	}
 
 	// Create proposal.
-	p := proposal{
-		ProposalID:     v.ProposalID,
-		ExpirationDate: now + ExpirationTime,
+	p.ExpirationDate = now + ExpirationTime
 
-		Next: proposalRef{},
-		Prev: q.Tail,
+	p.Next = proposalRef{}
+	p.Prev = q.Tail
 
-		Transfer: v.Transfer,
+	p.SignerThresholdIDs = []string{}
+	p.SignerSignatures = []string{}
 
-		SignerThresholdIDs: []string{},
-		SignerSignatures:   []string{},
-
-		ClientSignature:   "",
-		ExecutedInTxnHash: "",
-	}
+	p.ClientSignature = ""
+	p.ExecutedInTxnHash = ""
 
 	err = ms.putProposal(&p, balances)
 	if err != nil {
@@ -471,7 +652,13 @@ func (ms MultiSigSmartContract) putWallet(w Wallet, balances c_state.StateContex
 	//	return err
 	//}
 	_, err := balances.InsertTrieNode(w.getKey(), &w)
-	return err
+	if err != nil {
+		return err
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagAddOrOverwriteMultisigWallet, w.ClientID,
+		[]event.MultisigWallet{w.toEvent()})
+	return nil
 }
 
 func (ms MultiSigSmartContract) getProposal(ref proposalRef, balances c_state.StateContextI) (proposal, error) {
@@ -492,7 +679,13 @@ func (ms MultiSigSmartContract) putProposal(p *proposal, balances c_state.StateC
 	//}
 
 	_, err := balances.InsertTrieNode(p.getKey(), p)
-	return err
+	if err != nil {
+		return err
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagAddOrOverwriteMultisigProposal, p.getKey(),
+		[]event.MultisigProposal{p.toEvent(p.status())})
+	return nil
 }
 
 func (ms MultiSigSmartContract) getOrCreateExpirationQueue(balances c_state.StateContextI) (expirationQueue, error) {
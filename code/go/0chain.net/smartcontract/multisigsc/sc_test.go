@@ -0,0 +1,306 @@
+package multisigsc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+type testSigner struct {
+	clientID    string
+	publicKey   string
+	thresholdID string
+}
+
+func newTestSigner(t *testing.T, thresholdID string) testSigner {
+	scheme := encryption.NewBLS0ChainScheme()
+	require.NoError(t, scheme.GenerateKeys())
+	pk := scheme.GetPublicKey()
+	b, err := hex.DecodeString(pk)
+	require.NoError(t, err)
+	return testSigner{
+		clientID:    encryption.Hash(b),
+		publicKey:   pk,
+		thresholdID: thresholdID,
+	}
+}
+
+func newTestWallet(t *testing.T, numRequired int, signers ...testSigner) Wallet {
+	owner := newTestSigner(t, "")
+	w := Wallet{
+		ClientID:        owner.clientID,
+		SignatureScheme: encryption.SignatureSchemeBls0chain,
+		PublicKey:       owner.publicKey,
+		NumRequired:     numRequired,
+	}
+	for _, s := range signers {
+		w.SignerThresholdIDs = append(w.SignerThresholdIDs, s.thresholdID)
+		w.SignerPublicKeys = append(w.SignerPublicKeys, s.publicKey)
+	}
+	return w
+}
+
+func TestWallet_applyUpdate(t *testing.T) {
+	var signers []testSigner
+	for i := 1; i <= 4; i++ {
+		signers = append(signers, newTestSigner(t, strconv.Itoa(i)))
+	}
+	w := newTestWallet(t, 2, signers[:3]...)
+	_, err := w.valid(w.ClientID)
+	require.NoError(t, err)
+
+	updated, err := w.applyUpdate(WalletUpdate{
+		Type:              AddSigner,
+		SignerThresholdID: signers[3].thresholdID,
+		SignerPublicKey:   signers[3].publicKey,
+	})
+	require.NoError(t, err)
+	require.Len(t, updated.SignerPublicKeys, 4)
+	require.Len(t, w.SignerPublicKeys, 3)
+
+	_, err = w.applyUpdate(WalletUpdate{
+		Type:              AddSigner,
+		SignerThresholdID: signers[3].thresholdID,
+		SignerPublicKey:   signers[0].publicKey,
+	})
+	require.EqualError(t, err, "duplicate_signers: duplicate signers are present")
+
+	updated, err = w.applyUpdate(WalletUpdate{
+		Type:              RotateSignerKey,
+		SignerThresholdID: signers[0].thresholdID,
+		SignerPublicKey:   signers[3].publicKey,
+	})
+	require.NoError(t, err)
+	require.Equal(t, signers[0].thresholdID, updated.thresholdIdForSigner(signers[3].clientID))
+	require.Empty(t, updated.thresholdIdForSigner(signers[0].clientID))
+
+	updated, err = w.applyUpdate(WalletUpdate{Type: RemoveSigner, SignerThresholdID: signers[1].thresholdID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "3"}, updated.SignerThresholdIDs)
+	require.Equal(t, []string{"1", "2", "3"}, w.SignerThresholdIDs)
+
+	_, err = w.applyUpdate(WalletUpdate{Type: RemoveSigner, SignerThresholdID: "5"})
+	require.EqualError(t, err, "err_update_signer_not_found: no signer with the threshold id")
+
+	_, err = w.applyUpdate(WalletUpdate{Type: ChangeThreshold, NumRequired: 4})
+	require.Error(t, err)
+	updated, err = w.applyUpdate(WalletUpdate{Type: ChangeThreshold, NumRequired: 3})
+	require.NoError(t, err)
+	require.Equal(t, 3, updated.NumRequired)
+}
+
+func TestMultiSigSmartContract_voteUpdate(t *testing.T) {
+	var (
+		ms       = MultiSigSmartContract{SmartContract: new(smartcontractinterface.SmartContract)}
+		balances = newTestBalances()
+		now      = common.Timestamp(1000)
+		signers  []testSigner
+	)
+	for i := 1; i <= 4; i++ {
+		signers = append(signers, newTestSigner(t, strconv.Itoa(i)))
+	}
+	w := newTestWallet(t, 2, signers[:3]...)
+	require.NoError(t, ms.putWallet(w, balances))
+
+	vote := func(signer testSigner, v UpdateVote) (string, error) {
+		input, err := json.Marshal(&v)
+		require.NoError(t, err)
+		return ms.voteUpdate(encryption.Hash(input), signer.clientID, now, input, balances)
+	}
+
+	add := UpdateVote{
+		ProposalID: "add",
+		ClientID:   w.ClientID,
+		Update: WalletUpdate{
+			Type:              AddSigner,
+			SignerThresholdID: signers[3].thresholdID,
+			SignerPublicKey:   signers[3].publicKey,
+		},
+	}
+
+	resp, err := vote(signers[0], add)
+	require.NoError(t, err)
+	require.Equal(t, "success 1: need 1 more votes", resp)
+
+	resp, err = vote(signers[0], add)
+	require.NoError(t, err)
+	require.Equal(t, "success 1: already voted, still need 1 other votes", resp)
+
+	_, err = vote(signers[3], add)
+	require.EqualError(t, err, "err_vote_auth:  authorization failure")
+
+	changed := add
+	changed.Update.Type = RotateSignerKey
+	_, err = vote(signers[1], changed)
+	require.EqualError(t, err, "err_vote_not_compatible:  previous votes for same proposal differed")
+
+	resp, err = vote(signers[1], add)
+	require.NoError(t, err)
+	require.Equal(t, "success 0: wallet updated", resp)
+
+	got, err := ms.getWallet(w.ClientID, balances)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3", "4"}, got.SignerThresholdIDs)
+
+	var walletEvents []event.MultisigWallet
+	for _, e := range balances.events {
+		if e.Tag == event.TagAddOrOverwriteMultisigWallet {
+			walletEvents = append(walletEvents, e.Data.([]event.MultisigWallet)...)
+		}
+	}
+	require.Len(t, walletEvents, 2)
+	var walletSigners []walletSigner
+	require.NoError(t, json.Unmarshal([]byte(walletEvents[1].Signers), &walletSigners))
+	require.Equal(t, walletSigner{
+		ThresholdID: signers[3].thresholdID,
+		PublicKey:   signers[3].publicKey,
+		ClientID:    signers[3].clientID,
+	}, walletSigners[3])
+
+	// the vote of a removed signer isn't counted anymore
+	threshold := UpdateVote{
+		ProposalID: "threshold",
+		ClientID:   w.ClientID,
+		Update:     WalletUpdate{Type: ChangeThreshold, NumRequired: 3},
+	}
+	_, err = vote(signers[2], threshold)
+	require.NoError(t, err)
+
+	remove := UpdateVote{
+		ProposalID: "remove",
+		ClientID:   w.ClientID,
+		Update:     WalletUpdate{Type: RemoveSigner, SignerThresholdID: signers[2].thresholdID},
+	}
+	_, err = vote(signers[0], remove)
+	require.NoError(t, err)
+	_, err = vote(signers[1], remove)
+	require.NoError(t, err)
+
+	resp, err = vote(signers[3], threshold)
+	require.NoError(t, err)
+	require.Equal(t, "success 1: need 1 more votes", resp)
+
+	// invalid result fails the last vote
+	invalid := UpdateVote{
+		ProposalID: "invalid",
+		ClientID:   w.ClientID,
+		Update:     WalletUpdate{Type: ChangeThreshold, NumRequired: 4},
+	}
+	_, err = vote(signers[0], invalid)
+	require.NoError(t, err)
+	_, err = vote(signers[1], invalid)
+	require.EqualError(t, err, "too_many_signers_required: number of signers required is less than 2")
+}
+
+type testCalleeSC struct {
+	*smartcontractinterface.SmartContract
+}
+
+func (sc *testCalleeSC) Execute(t *transaction.Transaction, funcName string, input []byte,
+	balances cstate.StateContextI) (string, error) {
+
+	if funcName != "pay" {
+		return "", errors.New("unknown function")
+	}
+	if err := balances.AddTransfer(state.NewTransfer(t.ToClientID, t.ClientID, 10)); err != nil {
+		return "", err
+	}
+	return "paid " + t.ClientID + " " + string(input), nil
+}
+
+func (sc *testCalleeSC) GetHandlerStats(context.Context, url.Values) (interface{}, error) {
+	return nil, nil
+}
+func (sc *testCalleeSC) GetExecutionStats() map[string]interface{} { return nil }
+func (sc *testCalleeSC) GetName() string                           { return "callee" }
+func (sc *testCalleeSC) GetAddress() string                        { return sc.ID }
+func (sc *testCalleeSC) GetCostTable(cstate.StateContextI) (map[string]int, error) {
+	return nil, nil
+}
+
+func TestMultiSigSmartContract_voteCall(t *testing.T) {
+	var (
+		ms       = MultiSigSmartContract{SmartContract: new(smartcontractinterface.SmartContract)}
+		balances = newTestBalances()
+		now      = common.Timestamp(1000)
+		callee   = encryption.Hash("callee sc")
+		signers  []testSigner
+	)
+	smartcontract.ContractMap[callee] = &testCalleeSC{smartcontractinterface.NewSC(callee)}
+	defer delete(smartcontract.ContractMap, callee)
+
+	for i := 1; i <= 3; i++ {
+		signers = append(signers, newTestSigner(t, strconv.Itoa(i)))
+	}
+	w := newTestWallet(t, 2, signers...)
+	require.NoError(t, ms.putWallet(w, balances))
+	balances.balances[callee] = 100
+
+	vote := func(signer testSigner, v CallVote) (string, error) {
+		input, err := json.Marshal(&v)
+		require.NoError(t, err)
+		balances.txn = &transaction.Transaction{ClientID: signer.clientID, ToClientID: Address}
+		return ms.voteCall(encryption.Hash(input), signer.clientID, now, input, balances)
+	}
+	call := func(proposalID, address, function string) CallVote {
+		v := CallVote{ProposalID: proposalID, ClientID: w.ClientID, Address: address}
+		v.FunctionName = function
+		v.InputData = json.RawMessage(`{"n":1}`)
+		return v
+	}
+
+	_, err := vote(signers[0], call("invalid", Address, "pay"))
+	require.EqualError(t, err, "err_call_invalid_address: invalid smart contract address")
+	_, err = vote(signers[0], call("invalid", encryption.Hash("unknown"), "pay"))
+	require.EqualError(t, err, "err_call_invalid_address: invalid smart contract address")
+
+	big := call("big", callee, "pay")
+	big.InputData = json.RawMessage(`"` + strings.Repeat("a", MaxCallInputSize) + `"`)
+	_, err = vote(signers[0], big)
+	require.EqualError(t, err, "err_vote_too_big: an input field exceeded allowable length")
+
+	resp, err := vote(signers[0], call("pay", callee, "pay"))
+	require.NoError(t, err)
+	require.Equal(t, "success 1: need 1 more votes", resp)
+	require.Zero(t, balances.balances[w.ClientID])
+
+	resp, err = vote(signers[1], call("pay", callee, "pay"))
+	require.NoError(t, err)
+	require.Equal(t, `success 0: smart contract call executed with result paid `+w.ClientID+` {"n":1}`, resp)
+	require.Equal(t, currency.Coin(10), balances.balances[w.ClientID])
+
+	p, err := ms.getProposal(proposalRef{ClientID: w.ClientID, ProposalID: "pay"}, balances)
+	require.NoError(t, err)
+	require.Equal(t, `paid `+w.ClientID+` {"n":1}`, p.Result)
+
+	e := balances.events[len(balances.events)-1]
+	require.Equal(t, event.TagAddOrOverwriteMultisigProposal, e.Tag)
+	ep := e.Data.([]event.MultisigProposal)[0]
+	require.Equal(t, int(ProposalExecuted), ep.Status)
+	require.Equal(t, int(SmartContractCallProposal), ep.Type)
+	require.Equal(t, 2, ep.Votes)
+	require.Equal(t, `["1","2"]`, ep.SignerThresholdIDs)
+
+	// failed call fails the vote
+	_, err = vote(signers[0], call("fail", callee, "unknown"))
+	require.NoError(t, err)
+	_, err = vote(signers[2], call("fail", callee, "unknown"))
+	require.EqualError(t, err, "err_call_failed: unknown function")
+}
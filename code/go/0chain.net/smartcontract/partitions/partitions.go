@@ -436,6 +436,26 @@ func (p *Partitions) GetRandomItems(state state.StateContextI, r *rand.Rand, vs
 	return setPartitionItems(its, vs)
 }
 
+// NumPartitions returns the number of the partitions, the last one included
+func (p *Partitions) NumPartitions() int {
+	return p.Last.Loc + 1
+}
+
+// GetPartitionItems returns the items of the partition at the index
+func (p *Partitions) GetPartitionItems(state state.StateContextI, index int, vs interface{}) error {
+	part, err := p.getPartition(state, index)
+	if err != nil {
+		return err
+	}
+
+	its, err := part.itemRange(0, part.length())
+	if err != nil {
+		return err
+	}
+
+	return setPartitionItems(its, vs)
+}
+
 func (p *Partitions) Size(state state.StateContextI) (int, error) {
 	if p.Last.length() == 0 {
 		return 0, nil
@@ -840,6 +840,34 @@ func TestGetRandomItems(t *testing.T) {
 	}
 }
 
+func TestGetPartitionItems(t *testing.T) {
+	for _, num := range []int{0, 1, 10, 25} {
+		t.Run(fmt.Sprint(num), func(t *testing.T) {
+			pn := "test_ps"
+			s := prepareState(t, pn, 10, num)
+			p, err := GetPartitions(s, pn)
+			require.NoError(t, err)
+
+			var all []testItem
+			for i := 0; i < p.NumPartitions(); i++ {
+				var its []testItem
+				require.NoError(t, p.GetPartitionItems(s, i, &its))
+				require.LessOrEqual(t, len(its), 10)
+				for _, it := range its {
+					var sit testItem
+					require.NoError(t, p.Get(s, it.ID, &sit))
+					require.Equal(t, sit, it)
+				}
+				all = append(all, its...)
+			}
+			require.Len(t, all, num)
+
+			var its []testItem
+			require.Error(t, p.GetPartitionItems(s, p.NumPartitions(), &its))
+		})
+	}
+}
+
 func FuzzAdd(f *testing.F) {
 	rand.Seed(time.Now().UnixNano())
 	f.Add(10)
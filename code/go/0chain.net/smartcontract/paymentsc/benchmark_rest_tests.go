@@ -0,0 +1,38 @@
+package paymentsc
+
+import (
+	benchmark "0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/rest"
+)
+
+func BenchmarkRestTests(
+	data benchmark.BenchData, _ benchmark.SignatureScheme,
+) benchmark.TestSuite {
+	rh := rest.NewRestHandler(&rest.TestQueryChainer{})
+	prh := NewPaymentRestHandler(rh)
+	return benchmark.GetRestTests(
+		[]benchmark.TestParameters{
+			{
+				FuncName: "payment-config",
+				Endpoint: prh.getConfig,
+			},
+			{
+				FuncName: "schedule",
+				Params: map[string]string{
+					"schedule_id": getMockScheduleId(0),
+				},
+				Endpoint: prh.getSchedule,
+			},
+			{
+				FuncName: "client-schedules",
+				Params: map[string]string{
+					"client_id": data.Clients[0],
+				},
+				Endpoint: prh.getClientSchedules,
+			},
+		},
+		ADDRESS,
+		prh,
+		benchmark.PaymentRest,
+	)
+}
@@ -0,0 +1,107 @@
+package paymentsc
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/core/common"
+	config2 "0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/viper"
+	"0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+const (
+	mockScheduleAmount   = 1e10
+	mockScheduleCount    = 10
+	mockScheduleInterval = time.Hour
+	mockGovernanceWeight = 100e10
+)
+
+func AddMockConfig(balances cstate.StateContextI) {
+	var (
+		conf config
+		err  error
+	)
+	conf.OwnerId = viper.GetString(benchmark.PaymentOwner)
+	conf.MinLock, err = currency.MultFloat64(1e10, viper.GetFloat64(benchmark.PaymentMinLock))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	conf.MinInterval = viper.GetDuration(benchmark.PaymentMinInterval)
+	conf.MaxPayments = viper.GetInt(benchmark.PaymentMaxPayments)
+	conf.MaxDescriptionLength = viper.GetInt(benchmark.PaymentMaxDescriptionLength)
+	conf.Governance, err = governance.ReadConfig(benchmark.SmartContract + benchmark.PaymentSc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), &conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// AddMockGovernanceProposals adds the open proposal to vote on and the one
+// voted by the first client to execute, the client votes with the stake in
+// the first miner
+func AddMockGovernanceProposals(
+	clients, miners []string,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	governance.AddMockProposals(ADDRESS, clients[0], clients[0], mockGovernanceWeight,
+		[]governance.StakeRef{{ProviderType: spenum.Miner, ProviderID: miners[0]}},
+		config2.StringMap{Fields: map[string]string{Settings[MaxPayments]: "4"}},
+		eventDb, balances)
+}
+
+func mockGovernanceStakes(data benchmark.BenchData) []governance.StakeRef {
+	return []governance.StakeRef{{
+		ProviderType: spenum.Miner,
+		ProviderID:   data.Miners[0],
+	}}
+}
+
+// AddMockSchedules adds a schedule owned by each client paying to the
+// next client, with the first payment due.
+func AddMockSchedules(
+	clients []string,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	now := balances.GetTransaction().CreationDate
+	schedules := make([]event.PaymentSchedule, 0, len(clients))
+	for i := 0; i < len(clients); i++ {
+		s := schedule{
+			ID:          getMockScheduleId(i),
+			ClientID:    clients[i],
+			Recipient:   clients[(i+1)%len(clients)],
+			Description: "mock description",
+			Amount:      mockScheduleAmount,
+			Interval:    mockScheduleInterval,
+			StartTime:   now - common.Timestamp(mockScheduleInterval.Seconds()),
+			Count:       mockScheduleCount,
+			Balance:     mockScheduleAmount * mockScheduleCount,
+		}
+		if err := s.save(balances); err != nil {
+			log.Fatal(err)
+		}
+		schedules = append(schedules, s.toEvent(ScheduleActive))
+	}
+	if err := eventDb.Store.Get().Create(&schedules).Error; err != nil {
+		log.Fatal(err)
+	}
+}
+
+func getMockScheduleId(client int) string {
+	return scheduleKey(ADDRESS, encryption.Hash("mock payment schedule for"+strconv.Itoa(client)))
+}
@@ -0,0 +1,201 @@
+package paymentsc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sc "0chain.net/core/config"
+
+	"github.com/spf13/viper"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	sci "0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	bk "0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/governance"
+)
+
+type BenchTest struct {
+	name     string
+	endpoint func(
+		*transaction.Transaction,
+		[]byte,
+		cstate.StateContextI,
+	) (string, error)
+	txn   *transaction.Transaction
+	input []byte
+}
+
+func (bt BenchTest) Name() string {
+	return bt.name
+}
+
+func (bt BenchTest) Transaction() *transaction.Transaction {
+	return &transaction.Transaction{
+		HashIDField: datastore.HashIDField{
+			Hash: bt.txn.Hash,
+		},
+		ClientID:     bt.txn.ClientID,
+		ToClientID:   bt.txn.ToClientID,
+		Value:        bt.txn.Value,
+		CreationDate: bt.txn.CreationDate,
+	}
+}
+
+func (bt BenchTest) Run(balances cstate.TimedQueryStateContext, _ *testing.B) error {
+	_, err := bt.endpoint(bt.Transaction(), bt.input, balances)
+	return err
+}
+
+func BenchmarkTests(
+	data bk.BenchData, _ bk.SignatureScheme,
+) bk.TestSuite {
+	creationTimeRaw := viper.GetInt64("MptCreationTime")
+	creationTime := common.Now()
+	if creationTimeRaw != 0 {
+		creationTime = common.Timestamp(creationTimeRaw)
+	}
+
+	var psc = PaymentSmartContract{
+		SmartContract: sci.NewSC(ADDRESS),
+	}
+	psc.setSC(psc.SmartContract, &smartcontract.BCContext{})
+	scheduleRequestInput := func() []byte {
+		bytes, _ := json.Marshal(&scheduleRequest{
+			ScheduleID: getMockScheduleId(0),
+		})
+		return bytes
+	}()
+	var tests = []BenchTest{
+		{
+			name:     "payment.create_schedule",
+			endpoint: psc.createSchedule,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				Value:        mockScheduleAmount,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&createRequest{
+					Recipient:   data.Clients[1],
+					Description: "my description",
+					Amount:      mockScheduleAmount,
+					Interval:    time.Hour,
+					Count:       1,
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "payment.topup_schedule",
+			endpoint: psc.topUpSchedule,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				Value:        mockScheduleAmount,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&topUpRequest{
+					ScheduleID: getMockScheduleId(0),
+					Count:      1,
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "payment.trigger_schedule",
+			endpoint: psc.triggerSchedule,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: scheduleRequestInput,
+		},
+		{
+			name:     "payment.cancel_schedule",
+			endpoint: psc.cancelSchedule,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: scheduleRequestInput,
+		},
+		{
+			name:     "payment.updateConfig",
+			endpoint: psc.updateConfig,
+			txn: &transaction.Transaction{
+				ClientID:     viper.GetString(bk.PaymentOwner),
+				CreationDate: creationTime,
+			},
+			input: (&sc.StringMap{
+				Fields: map[string]string{
+					Settings[MinLock]:              "1",
+					Settings[MinInterval]:          "2s",
+					Settings[MaxPayments]:          "5",
+					Settings[MaxDescriptionLength]: "7",
+				},
+			}).Encode(),
+		},
+		{
+			name:     "payment.governance-propose",
+			endpoint: psc.governancePropose,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ProposeRequest{
+				Changes: sc.StringMap{
+					Fields: map[string]string{Settings[MaxPayments]: "3"},
+				},
+				Stakes: mockGovernanceStakes(data),
+			}).Encode(),
+		},
+		{
+			name:     "payment.governance-vote",
+			endpoint: psc.governanceVote,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.VoteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 0),
+				Approve:    true,
+				Stakes:     mockGovernanceStakes(data),
+			}).Encode(),
+		},
+		{
+			name:     "payment.governance-execute",
+			endpoint: psc.governanceExecute,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ExecuteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 1),
+			}).Encode(),
+		},
+	}
+	var testsI []bk.BenchTestI
+	for _, test := range tests {
+		testsI = append(testsI, test)
+	}
+	return bk.TestSuite{
+		Source:     bk.Payment,
+		Benchmarks: testsI,
+	}
+}
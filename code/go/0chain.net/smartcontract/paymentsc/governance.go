@@ -0,0 +1,80 @@
+package paymentsc
+
+import (
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	config2 "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+)
+
+// governanceSettings are the payment settings changed by the governance,
+// the same way paymentsc-update-settings does
+type governanceSettings struct {
+	psc *PaymentSmartContract
+}
+
+func (s governanceSettings) Config(balances chainstate.StateContextI) (*governance.Config, error) {
+	conf, err := s.psc.getConfig(balances)
+	if err != nil {
+		return nil, err
+	}
+	return &conf.Governance, nil
+}
+
+func (s governanceSettings) update(changes config2.StringMap, balances chainstate.StateContextI) (*config, error) {
+	conf, err := s.psc.getConfig(balances)
+	if err != nil {
+		return nil, err
+	}
+	if err := conf.update(&changes); err != nil {
+		return nil, err
+	}
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (s governanceSettings) Validate(changes config2.StringMap, balances chainstate.StateContextI) error {
+	_, err := s.update(changes, balances)
+	return err
+}
+
+func (s governanceSettings) Apply(changes config2.StringMap, balances chainstate.StateContextI) error {
+	conf, err := s.update(changes, balances)
+	if err != nil {
+		return err
+	}
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	return err
+}
+
+// governancePropose submits the proposal of the payment settings changes,
+// the proposer is a council member or a staker
+func (psc *PaymentSmartContract) governancePropose(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.Propose(t, input, psc.ID, governanceSettings{psc}, balances)
+}
+
+// governanceVote on the open proposal with the stake of the voter, a vote
+// again replaces the former one
+func (psc *PaymentSmartContract) governanceVote(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.CastVote(t, input, psc.ID, governanceSettings{psc}, balances)
+}
+
+// governanceExecute closes the proposal after the voting, the passed one
+// is applied to the settings after the timelock. Anyone can do it.
+func (psc *PaymentSmartContract) governanceExecute(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.Execute(t, input, psc.ID, governanceSettings{psc}, balances)
+}
@@ -0,0 +1,133 @@
+package paymentsc
+
+import (
+	"net/http"
+
+	"0chain.net/core/common"
+	"0chain.net/smartcontract"
+	common2 "0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/rest"
+)
+
+type PaymentRestHandler struct {
+	rest.RestHandlerI
+}
+
+func NewPaymentRestHandler(rh rest.RestHandlerI) *PaymentRestHandler {
+	return &PaymentRestHandler{rh}
+}
+
+func SetupRestHandler(rh rest.RestHandlerI) {
+	rh.Register(GetEndpoints(rh))
+}
+
+func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
+	prh := NewPaymentRestHandler(rh)
+	payment := "/v1/screst/" + ADDRESS
+	return []rest.Endpoint{
+		rest.MakeEndpoint(payment+"/schedule", common.UserRateLimit(prh.getSchedule)),
+		rest.MakeEndpoint(payment+"/client-schedules", common.UserRateLimit(prh.getClientSchedules)),
+		rest.MakeEndpoint(payment+"/payment-config", common.UserRateLimit(prh.getConfig)),
+	}
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e1/schedule schedule
+// get an active payment schedule
+//
+// parameters:
+//
+//	+name: schedule_id
+//	 description: schedule id
+//	 required: true
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: paymentSchedule
+//	400:
+//	500:
+func (prh *PaymentRestHandler) getSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID := r.URL.Query().Get("schedule_id")
+	if scheduleID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing schedule_id"))
+		return
+	}
+
+	s, err := getSchedule(scheduleID, prh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, smartcontract.NewErrNoResourceOrErrInternal(err, true, "can't get schedule"))
+		return
+	}
+	common.Respond(w, r, s, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e1/client-schedules client-schedules
+// get payment schedules created by a client, including completed and cancelled ones
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: owner of the schedules
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []PaymentSchedule
+//	400:
+//	500:
+func (prh *PaymentRestHandler) getClientSchedules(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := prh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	schedules, err := edb.GetClientPaymentSchedules(clientID, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get client schedules", err.Error()))
+		return
+	}
+	common.Respond(w, r, schedules, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e1/payment-config payment-config
+// get payment configuration settings
+//
+// responses:
+//
+//	200: StringMap
+//	500:
+func (prh *PaymentRestHandler) getConfig(w http.ResponseWriter, r *http.Request) {
+	conf, err := getConfigReadOnly(prh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get config", err.Error()))
+		return
+	}
+	common.Respond(w, r, conf.getConfigMap(), nil)
+}
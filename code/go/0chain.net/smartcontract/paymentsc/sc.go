@@ -0,0 +1,119 @@
+package paymentsc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"0chain.net/chaincore/smartcontract"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	ADDRESS = "6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e1"
+)
+
+type PaymentSmartContract struct {
+	*smartcontractinterface.SmartContract
+}
+
+func NewPaymentSmartContract() smartcontractinterface.SmartContractInterface {
+	var pscCopy = &PaymentSmartContract{
+		smartcontractinterface.NewSC(ADDRESS),
+	}
+	pscCopy.setSC(pscCopy.SmartContract, &smartcontract.BCContext{})
+	return pscCopy
+}
+
+func (psc *PaymentSmartContract) GetHandlerStats(ctx context.Context, params url.Values) (interface{}, error) {
+	return psc.SmartContract.HandlerStats(ctx, params)
+}
+
+func (psc *PaymentSmartContract) GetExecutionStats() map[string]interface{} {
+	return psc.SmartContractExecutionStats
+}
+
+func (psc *PaymentSmartContract) GetName() string {
+	return "payment"
+}
+
+func (psc *PaymentSmartContract) GetAddress() string {
+	return ADDRESS
+}
+
+func (psc *PaymentSmartContract) GetCostTable(balances chainstate.StateContextI) (map[string]int, error) {
+	node, err := psc.getConfig(balances)
+	if err != nil {
+		return map[string]int{}, err
+	}
+	if node.Cost == nil {
+		return map[string]int{}, err
+	}
+	return node.Cost, nil
+}
+
+func (psc *PaymentSmartContract) setSC(sc *smartcontractinterface.SmartContract,
+	bcContext smartcontractinterface.BCContextI) {
+
+	psc.SmartContract = sc
+
+	// escrow tokens for a new schedule {recipient,amount,interval,count}
+	psc.SmartContractExecutionStats["create_schedule"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "create_schedule"), nil)
+
+	// add tokens or payments to an existing schedule, by its owner
+	psc.SmartContractExecutionStats["topup_schedule"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "topup_schedule"), nil)
+
+	// pay all due payments of a schedule, by anyone
+	psc.SmartContractExecutionStats["trigger_schedule"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "trigger_schedule"), nil)
+
+	// stop a schedule returning the tokens left to its owner
+	psc.SmartContractExecutionStats["cancel_schedule"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "cancel_schedule"), nil)
+
+	psc.SmartContractExecutionStats["paymentsc-update-settings"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "paymentsc-update-settings"), nil)
+
+	// governance of the settings changes
+	psc.SmartContractExecutionStats["governance-propose"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "governance-propose"), nil)
+	psc.SmartContractExecutionStats["governance-vote"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "governance-vote"), nil)
+	psc.SmartContractExecutionStats["governance-execute"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "governance-execute"), nil)
+}
+
+func (psc *PaymentSmartContract) Execute(t *transaction.Transaction,
+	function string, input []byte, balances chainstate.StateContextI) (
+	resp string, err error) {
+
+	switch function {
+	case "create_schedule":
+		resp, err = psc.createSchedule(t, input, balances)
+	case "topup_schedule":
+		resp, err = psc.topUpSchedule(t, input, balances)
+	case "trigger_schedule":
+		resp, err = psc.triggerSchedule(t, input, balances)
+	case "cancel_schedule":
+		resp, err = psc.cancelSchedule(t, input, balances)
+	case "paymentsc-update-settings":
+		resp, err = psc.updateConfig(t, input, balances)
+	case "governance-propose":
+		resp, err = psc.governancePropose(t, input, balances)
+	case "governance-vote":
+		resp, err = psc.governanceVote(t, input, balances)
+	case "governance-execute":
+		resp, err = psc.governanceExecute(t, input, balances)
+	default:
+		err = common.NewError("payment_sc_failed",
+			fmt.Sprintf("no function with %q name", function))
+	}
+	return
+}
@@ -0,0 +1,530 @@
+package paymentsc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	sci "0chain.net/chaincore/smartcontractinterface"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+//msgp:ignore createRequest scheduleRequest topUpRequest
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+// ScheduleStatus is the state of a payment schedule stored in event DB.
+// The MPT keeps only active schedules.
+type ScheduleStatus int
+
+const (
+	ScheduleActive ScheduleStatus = iota
+	ScheduleCompleted
+	ScheduleCancelled
+)
+
+// internal errors
+
+var errNotDue = errors.New("no payments due")
+
+func toSeconds(dur time.Duration) common.Timestamp {
+	return common.Timestamp(dur / time.Second)
+}
+
+//
+// requests
+//
+
+type createRequest struct {
+	Recipient   datastore.Key    `json:"recipient"`
+	Description string           `json:"description"`
+	Amount      currency.Coin    `json:"amount"`
+	Interval    time.Duration    `json:"interval"`
+	StartTime   common.Timestamp `json:"start_time"`
+	Count       int              `json:"count"`
+}
+
+func (cr *createRequest) decode(b []byte) error {
+	return json.Unmarshal(b, cr)
+}
+
+// validate the createRequest, zero start time means the first payment is
+// due immediately
+func (cr *createRequest) validate(t *transaction.Transaction, conf *config) (err error) {
+	switch {
+	case cr.Recipient == "":
+		return errors.New("missing recipient")
+	case cr.Recipient == t.ClientID:
+		return errors.New("owner can't be the recipient")
+	case cr.Amount == 0:
+		return errors.New("zero payment amount")
+	case cr.Interval < conf.MinInterval:
+		return errors.New("interval is too short")
+	case cr.Count < 1:
+		return errors.New("invalid payments count (< 1)")
+	case cr.Count > conf.MaxPayments:
+		return errors.New("too many payments")
+	case len(cr.Description) > conf.MaxDescriptionLength:
+		return errors.New("entry description is too long")
+	case cr.StartTime != 0 && cr.StartTime < t.CreationDate:
+		return errors.New("schedule starts before now")
+	}
+	return
+}
+
+type scheduleRequest struct {
+	ScheduleID datastore.Key `json:"schedule_id"`
+}
+
+func (sr *scheduleRequest) decode(b []byte) (err error) {
+	if err = json.Unmarshal(b, sr); err != nil {
+		return
+	}
+	if sr.ScheduleID == "" {
+		return errors.New("missing schedule_id")
+	}
+	return
+}
+
+// topUpRequest adds the transaction value to the schedule balance and
+// Count payments to the schedule
+type topUpRequest struct {
+	ScheduleID datastore.Key `json:"schedule_id"`
+	Count      int           `json:"count"`
+}
+
+func (tr *topUpRequest) decode(b []byte) (err error) {
+	if err = json.Unmarshal(b, tr); err != nil {
+		return
+	}
+	if tr.ScheduleID == "" {
+		return errors.New("missing schedule_id")
+	}
+	return
+}
+
+//
+// schedule
+//
+
+func scheduleKey(pscKey, scheduleID datastore.Key) datastore.Key {
+	return pscKey + ":paymentschedule:" + scheduleID
+}
+
+// swagger:model paymentSchedule
+type schedule struct {
+	ID          string           `json:"id"`
+	ClientID    string           `json:"client_id"` // the schedule owner
+	Recipient   string           `json:"recipient"`
+	Description string           `json:"description"`
+	Amount      currency.Coin    `json:"amount"`     // tokens of a single payment
+	Interval    time.Duration    `json:"interval"`   // between payments
+	StartTime   common.Timestamp `json:"start_time"` // the first payment is due
+	Count       int              `json:"count"`      // total payments
+	Paid        int              `json:"paid"`       // payments made
+	Balance     currency.Coin    `json:"balance"`    // escrowed tokens left
+}
+
+func newScheduleFromRequest(t *transaction.Transaction, cr *createRequest) *schedule {
+	s := &schedule{
+		ClientID:    t.ClientID,
+		Recipient:   cr.Recipient,
+		Description: cr.Description,
+		Amount:      cr.Amount,
+		Interval:    cr.Interval,
+		StartTime:   cr.StartTime,
+		Count:       cr.Count,
+	}
+	if s.StartTime == 0 {
+		s.StartTime = t.CreationDate
+	}
+	return s
+}
+
+// Encode the schedule to JSON value.
+func (s *schedule) Encode() (b []byte) {
+	var err error
+	if b, err = json.Marshal(s); err != nil {
+		panic(err) // must never happen
+	}
+	return
+}
+
+// Decode the schedule from JSON.
+func (s *schedule) Decode(b []byte) error {
+	return json.Unmarshal(b, s)
+}
+
+// due returns number of payments due at given time, regardless the balance
+func (s *schedule) due(now common.Timestamp) int {
+	if now < s.StartTime || s.Paid >= s.Count {
+		return 0
+	}
+	var due = int((now-s.StartTime)/toSeconds(s.Interval)) + 1
+	if due > s.Count {
+		due = s.Count
+	}
+	return due - s.Paid
+}
+
+// next returns time of the next payment
+func (s *schedule) next() common.Timestamp {
+	return s.StartTime + common.Timestamp(s.Paid)*toSeconds(s.Interval)
+}
+
+func (s *schedule) isCompleted() bool {
+	return s.Paid >= s.Count
+}
+
+// fill moves the transaction value to the schedule balance
+func (s *schedule) fill(t *transaction.Transaction,
+	balances chainstate.StateContextI) (err error) {
+
+	if err = checkFill(t, balances); err != nil {
+		return
+	}
+
+	if err = balances.AddTransfer(state.NewTransfer(t.ClientID, t.ToClientID, t.Value)); err != nil {
+		return
+	}
+
+	s.Balance, err = currency.AddCoin(s.Balance, t.Value)
+	return
+}
+
+// pay moves all due payments covered by the balance to the recipient,
+// returning number of payments made
+func (s *schedule) pay(t *transaction.Transaction,
+	balances chainstate.StateContextI) (paid int, err error) {
+
+	var due = s.due(t.CreationDate)
+	if due == 0 {
+		return 0, errNotDue
+	}
+
+	if s.Balance < s.Amount {
+		return 0, errors.New("not enough tokens to pay")
+	}
+
+	if covered := int(s.Balance / s.Amount); covered < due {
+		due = covered
+	}
+
+	value, err := currency.MultCoin(s.Amount, currency.Coin(due))
+	if err != nil {
+		return 0, err
+	}
+
+	if err = balances.AddTransfer(state.NewTransfer(t.ToClientID, s.Recipient, value)); err != nil {
+		return 0, fmt.Errorf("adding transfer schedule->recipient %s: %v",
+			s.Recipient, err)
+	}
+
+	if s.Balance, err = currency.MinusCoin(s.Balance, value); err != nil {
+		return 0, err
+	}
+	s.Paid += due
+	return due, nil
+}
+
+// refund moves all tokens left to the schedule owner
+func (s *schedule) refund(t *transaction.Transaction,
+	balances chainstate.StateContextI) (err error) {
+
+	if s.Balance == 0 {
+		return
+	}
+
+	if err = balances.AddTransfer(state.NewTransfer(t.ToClientID, s.ClientID, s.Balance)); err != nil {
+		return fmt.Errorf("adding transfer schedule->owner: %v", err)
+	}
+	s.Balance = 0
+	return
+}
+
+func (s *schedule) save(balances chainstate.StateContextI) (err error) {
+	_, err = balances.InsertTrieNode(s.ID, s)
+	return
+}
+
+func (s *schedule) toEvent(status ScheduleStatus) event.PaymentSchedule {
+	return event.PaymentSchedule{
+		ScheduleID:  s.ID,
+		ClientID:    s.ClientID,
+		Recipient:   s.Recipient,
+		Description: s.Description,
+		Amount:      s.Amount,
+		Interval:    int64(toSeconds(s.Interval)),
+		StartTime:   int64(s.StartTime),
+		Count:       s.Count,
+		Paid:        s.Paid,
+		Balance:     s.Balance,
+		Status:      int(status),
+	}
+}
+
+func (s *schedule) emit(status ScheduleStatus, balances chainstate.StateContextI) {
+	balances.EmitEvent(event.TypeStats, event.TagAddOrOverwritePaymentSchedule, s.ID,
+		[]event.PaymentSchedule{s.toEvent(status)})
+}
+
+// close removes completed or cancelled schedule from MPT refunding its
+// balance to the owner
+func (s *schedule) close(t *transaction.Transaction, status ScheduleStatus,
+	balances chainstate.StateContextI) (err error) {
+
+	if err = s.refund(t, balances); err != nil {
+		return
+	}
+	if _, err = balances.DeleteTrieNode(s.ID); err != nil {
+		return fmt.Errorf("deleting schedule: %v", err)
+	}
+	s.emit(status, balances)
+	return
+}
+
+func checkFill(t *transaction.Transaction, balances chainstate.StateContextI) (
+	err error) {
+
+	var balance currency.Coin
+	balance, err = balances.GetClientBalance(t.ClientID)
+
+	if err != nil && err != util.ErrValueNotPresent {
+		return // unexpected error
+	}
+
+	if err == util.ErrValueNotPresent {
+		return errors.New("no tokens to lock")
+	}
+
+	if t.Value > balance {
+		return errors.New("lock amount is greater than balance")
+	}
+
+	return
+}
+
+//
+// helpers
+//
+
+func getSchedule(
+	scheduleID datastore.Key,
+	balances chainstate.CommonStateContextI,
+) (s *schedule, err error) {
+	var psc = PaymentSmartContract{
+		SmartContract: sci.NewSC(ADDRESS),
+	}
+	return psc.getSchedule(scheduleID, balances)
+}
+
+func (psc *PaymentSmartContract) getSchedule(
+	scheduleID datastore.Key,
+	balances chainstate.CommonStateContextI,
+) (s *schedule, err error) {
+
+	s = new(schedule)
+	if err = balances.GetTrieNode(scheduleID, s); err != nil {
+		return nil, err
+	}
+	return
+}
+
+//
+// SC functions
+//
+
+func (psc *PaymentSmartContract) createSchedule(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var cr createRequest
+	if err = cr.decode(input); err != nil {
+		return "", common.NewError("create_schedule_failed",
+			"malformed request: "+err.Error())
+	}
+
+	var conf *config
+	if conf, err = psc.getConfig(balances); err != nil {
+		return "", common.NewError("create_schedule_failed",
+			"can't get SC configurations: "+err.Error())
+	}
+
+	if err = cr.validate(t, conf); err != nil {
+		return "", common.NewError("create_schedule_failed",
+			"invalid request: "+err.Error())
+	}
+
+	if t.Value < conf.MinLock {
+		return "", common.NewError("create_schedule_failed",
+			"insufficient amount to lock")
+	}
+
+	if t.Value < cr.Amount {
+		return "", common.NewError("create_schedule_failed",
+			"not enough tokens for the first payment")
+	}
+
+	var s = newScheduleFromRequest(t, &cr)
+	s.ID = scheduleKey(psc.ID, t.Hash) // set ID by this transaction
+
+	if err = s.fill(t, balances); err != nil {
+		return "", common.NewError("create_schedule_failed",
+			"can't fill schedule: "+err.Error())
+	}
+
+	if err = s.save(balances); err != nil {
+		return "", common.NewError("create_schedule_failed",
+			"can't save schedule: "+err.Error())
+	}
+
+	s.emit(ScheduleActive, balances)
+	return string(s.Encode()), nil
+}
+
+func (psc *PaymentSmartContract) topUpSchedule(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var tr topUpRequest
+	if err = tr.decode(input); err != nil {
+		return "", common.NewError("topup_schedule_failed",
+			"invalid request: "+err.Error())
+	}
+
+	if t.Value == 0 && tr.Count == 0 {
+		return "", common.NewError("topup_schedule_failed",
+			"nothing to top up")
+	}
+
+	if tr.Count < 0 {
+		return "", common.NewError("topup_schedule_failed",
+			"negative payments count")
+	}
+
+	var conf *config
+	if conf, err = psc.getConfig(balances); err != nil {
+		return "", common.NewError("topup_schedule_failed",
+			"can't get SC configurations: "+err.Error())
+	}
+
+	var s *schedule
+	if s, err = psc.getSchedule(tr.ScheduleID, balances); err != nil {
+		return "", common.NewError("topup_schedule_failed",
+			"can't get schedule: "+err.Error())
+	}
+
+	if s.ClientID != t.ClientID {
+		return "", common.NewError("topup_schedule_failed",
+			"only owner can top up the schedule")
+	}
+
+	if s.Count+tr.Count > conf.MaxPayments {
+		return "", common.NewError("topup_schedule_failed",
+			"too many payments")
+	}
+	s.Count += tr.Count
+
+	if t.Value > 0 {
+		if err = s.fill(t, balances); err != nil {
+			return "", common.NewError("topup_schedule_failed",
+				"can't fill schedule: "+err.Error())
+		}
+	}
+
+	if err = s.save(balances); err != nil {
+		return "", common.NewError("topup_schedule_failed",
+			"can't save schedule: "+err.Error())
+	}
+
+	s.emit(ScheduleActive, balances)
+	return string(s.Encode()), nil
+}
+
+// triggerSchedule pays all due payments of a schedule, anyone can trigger
+// it. The schedule is closed after the last payment.
+func (psc *PaymentSmartContract) triggerSchedule(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var sr scheduleRequest
+	if err = sr.decode(input); err != nil {
+		return "", common.NewError("trigger_schedule_failed",
+			"invalid request: "+err.Error())
+	}
+
+	var s *schedule
+	if s, err = psc.getSchedule(sr.ScheduleID, balances); err != nil {
+		return "", common.NewError("trigger_schedule_failed",
+			"can't get schedule: "+err.Error())
+	}
+
+	if _, err = s.pay(t, balances); err != nil {
+		if err == errNotDue {
+			return "", common.NewErrorf("trigger_schedule_failed",
+				"next payment is due at %d", s.next())
+		}
+		return "", common.NewError("trigger_schedule_failed",
+			"paying schedule: "+err.Error())
+	}
+
+	if s.isCompleted() {
+		if err = s.close(t, ScheduleCompleted, balances); err != nil {
+			return "", common.NewError("trigger_schedule_failed",
+				"closing schedule: "+err.Error())
+		}
+		return string(s.Encode()), nil
+	}
+
+	if err = s.save(balances); err != nil {
+		return "", common.NewError("trigger_schedule_failed",
+			"can't save schedule: "+err.Error())
+	}
+
+	s.emit(ScheduleActive, balances)
+	return string(s.Encode()), nil
+}
+
+// cancelSchedule pays all payments already due, and returns tokens left
+// to the schedule owner
+func (psc *PaymentSmartContract) cancelSchedule(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var sr scheduleRequest
+	if err = sr.decode(input); err != nil {
+		return "", common.NewError("cancel_schedule_failed",
+			"invalid request: "+err.Error())
+	}
+
+	var s *schedule
+	if s, err = psc.getSchedule(sr.ScheduleID, balances); err != nil {
+		return "", common.NewError("cancel_schedule_failed",
+			"can't get schedule: "+err.Error())
+	}
+
+	if s.ClientID != t.ClientID {
+		return "", common.NewError("cancel_schedule_failed",
+			"only owner can cancel the schedule")
+	}
+
+	// the recipient keeps payments due before the cancellation
+	if s.Balance >= s.Amount {
+		if _, err = s.pay(t, balances); err != nil && err != errNotDue {
+			return "", common.NewError("cancel_schedule_failed",
+				"paying schedule: "+err.Error())
+		}
+	}
+
+	if err = s.close(t, ScheduleCancelled, balances); err != nil {
+		return "", common.NewError("cancel_schedule_failed",
+			"closing schedule: "+err.Error())
+	}
+
+	return `{"schedule_id":"` + s.ID + `","action":"cancelled"}`, nil
+}
@@ -0,0 +1,185 @@
+package paymentsc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+func newTestPaymentSC() *PaymentSmartContract {
+	psc := &PaymentSmartContract{SmartContract: new(smartcontractinterface.SmartContract)}
+	psc.ID = ADDRESS
+	return psc
+}
+
+func testConfig() *config {
+	return &config{
+		MinLock:              10,
+		MinInterval:          time.Minute,
+		MaxPayments:          10,
+		MaxDescriptionLength: 20,
+		OwnerId:              encryption.Hash("sc owner"),
+	}
+}
+
+func Test_schedule_due(t *testing.T) {
+	s := &schedule{StartTime: 100, Interval: time.Minute, Count: 3}
+	require.Equal(t, 0, s.due(99))
+	require.Equal(t, 1, s.due(100))
+	require.Equal(t, 1, s.due(159))
+	require.Equal(t, 2, s.due(160))
+	require.Equal(t, 3, s.due(1000))
+
+	s.Paid = 2
+	require.Equal(t, 1, s.due(1000))
+	require.Equal(t, common.Timestamp(220), s.next())
+	s.Paid = 3
+	require.Equal(t, 0, s.due(1000))
+}
+
+func TestPaymentSchedule(t *testing.T) {
+	var (
+		psc       = newTestPaymentSC()
+		balances  = newTestBalances()
+		owner     = encryption.Hash("owner")
+		recipient = encryption.Hash("recipient")
+		other     = encryption.Hash("other")
+		now       = common.Timestamp(1000)
+	)
+	_, err := balances.InsertTrieNode(scConfigKey(ADDRESS), testConfig())
+	require.NoError(t, err)
+	balances.balances[owner] = 1000
+	balances.balances[other] = 1000
+
+	call := func(f func(*transaction.Transaction, []byte) (string, error),
+		clientID string, value currency.Coin, now common.Timestamp, req interface{}) (string, error) {
+
+		input, err := json.Marshal(req)
+		require.NoError(t, err)
+		txn := &transaction.Transaction{
+			ClientID:     clientID,
+			ToClientID:   ADDRESS,
+			Value:        value,
+			CreationDate: now,
+		}
+		txn.Hash = encryption.Hash(string(input) + clientID)
+		balances.txn = txn
+		return f(txn, input)
+	}
+	create := func(in *transaction.Transaction, b []byte) (string, error) { return psc.createSchedule(in, b, balances) }
+	topUp := func(in *transaction.Transaction, b []byte) (string, error) { return psc.topUpSchedule(in, b, balances) }
+	trigger := func(in *transaction.Transaction, b []byte) (string, error) { return psc.triggerSchedule(in, b, balances) }
+	cancel := func(in *transaction.Transaction, b []byte) (string, error) { return psc.cancelSchedule(in, b, balances) }
+
+	lastEvent := func() event.PaymentSchedule {
+		require.NotEmpty(t, balances.events)
+		e := balances.events[len(balances.events)-1]
+		require.Equal(t, event.TagAddOrOverwritePaymentSchedule, e.Tag)
+		return e.Data.([]event.PaymentSchedule)[0]
+	}
+
+	cr := &createRequest{
+		Recipient: recipient,
+		Amount:    100,
+		Interval:  time.Minute,
+		StartTime: now + 60,
+		Count:     3,
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := call(create, owner, 200, now, &createRequest{Recipient: owner, Amount: 100, Interval: time.Minute, Count: 1})
+		require.EqualError(t, err, "create_schedule_failed: invalid request: owner can't be the recipient")
+		_, err = call(create, owner, 200, now, &createRequest{Recipient: recipient, Amount: 100, Interval: time.Second, Count: 1})
+		require.EqualError(t, err, "create_schedule_failed: invalid request: interval is too short")
+		_, err = call(create, owner, 200, now, &createRequest{Recipient: recipient, Amount: 100, Interval: time.Minute, Count: 11})
+		require.EqualError(t, err, "create_schedule_failed: invalid request: too many payments")
+		_, err = call(create, owner, 50, now, cr)
+		require.EqualError(t, err, "create_schedule_failed: not enough tokens for the first payment")
+	})
+
+	var s schedule
+	t.Run("create", func(t *testing.T) {
+		resp, err := call(create, owner, 200, now, cr)
+		require.NoError(t, err)
+		require.NoError(t, s.Decode([]byte(resp)))
+		require.Equal(t, currency.Coin(200), s.Balance)
+		require.Equal(t, currency.Coin(800), balances.balances[owner])
+		require.Equal(t, currency.Coin(200), balances.balances[ADDRESS])
+		require.Equal(t, int(ScheduleActive), lastEvent().Status)
+	})
+
+	t.Run("trigger not due", func(t *testing.T) {
+		_, err := call(trigger, other, 0, now, &scheduleRequest{ScheduleID: s.ID})
+		require.EqualError(t, err, "trigger_schedule_failed: next payment is due at 1060")
+	})
+
+	t.Run("trigger limited by balance", func(t *testing.T) {
+		_, err := call(trigger, other, 0, now+200, &scheduleRequest{ScheduleID: s.ID})
+		require.NoError(t, err)
+		require.Equal(t, currency.Coin(200), balances.balances[recipient])
+		got, err := psc.getSchedule(s.ID, balances)
+		require.NoError(t, err)
+		require.Equal(t, 2, got.Paid)
+		require.Zero(t, got.Balance)
+
+		_, err = call(trigger, other, 0, now+200, &scheduleRequest{ScheduleID: s.ID})
+		require.EqualError(t, err, "trigger_schedule_failed: paying schedule: not enough tokens to pay")
+	})
+
+	t.Run("top up", func(t *testing.T) {
+		_, err := call(topUp, other, 100, now+200, &topUpRequest{ScheduleID: s.ID})
+		require.EqualError(t, err, "topup_schedule_failed: only owner can top up the schedule")
+		_, err = call(topUp, owner, 0, now+200, &topUpRequest{ScheduleID: s.ID, Count: 8})
+		require.EqualError(t, err, "topup_schedule_failed: too many payments")
+
+		_, err = call(topUp, owner, 250, now+200, &topUpRequest{ScheduleID: s.ID, Count: 1})
+		require.NoError(t, err)
+		got, err := psc.getSchedule(s.ID, balances)
+		require.NoError(t, err)
+		require.Equal(t, 4, got.Count)
+		require.Equal(t, currency.Coin(250), got.Balance)
+		require.Equal(t, 4, lastEvent().Count)
+	})
+
+	t.Run("trigger completes", func(t *testing.T) {
+		_, err := call(trigger, other, 0, now+1000, &scheduleRequest{ScheduleID: s.ID})
+		require.NoError(t, err)
+		require.Equal(t, currency.Coin(400), balances.balances[recipient])
+		require.Equal(t, currency.Coin(800-250+50), balances.balances[owner])
+		require.Zero(t, balances.balances[ADDRESS])
+		_, err = psc.getSchedule(s.ID, balances)
+		require.Error(t, err)
+
+		e := lastEvent()
+		require.Equal(t, int(ScheduleCompleted), e.Status)
+		require.Equal(t, 4, e.Paid)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		resp, err := call(create, owner, 300, now, cr)
+		require.NoError(t, err)
+		require.NoError(t, s.Decode([]byte(resp)))
+
+		_, err = call(cancel, other, 0, now+60, &scheduleRequest{ScheduleID: s.ID})
+		require.EqualError(t, err, "cancel_schedule_failed: only owner can cancel the schedule")
+
+		var (
+			ownerBalance     = balances.balances[owner]
+			recipientBalance = balances.balances[recipient]
+		)
+		_, err = call(cancel, owner, 0, now+60, &scheduleRequest{ScheduleID: s.ID})
+		require.NoError(t, err)
+		require.Equal(t, recipientBalance+100, balances.balances[recipient])
+		require.Equal(t, ownerBalance+200, balances.balances[owner])
+		require.Equal(t, int(ScheduleCancelled), lastEvent().Status)
+	})
+}
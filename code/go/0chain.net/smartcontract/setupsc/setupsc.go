@@ -7,8 +7,10 @@ import (
 	sci "0chain.net/chaincore/smartcontractinterface"
 	"0chain.net/core/viper"
 	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/htlcsc"
 	"0chain.net/smartcontract/minersc"
 	"0chain.net/smartcontract/multisigsc"
+	"0chain.net/smartcontract/paymentsc"
 	"0chain.net/smartcontract/storagesc"
 	"0chain.net/smartcontract/vestingsc"
 	"0chain.net/smartcontract/zcnsc"
@@ -23,6 +25,8 @@ const (
 	Miner
 	Vesting
 	Zcn
+	Payment
+	HTLC
 )
 
 var (
@@ -33,6 +37,8 @@ var (
 		"miner",
 		"vesting",
 		"zcn",
+		"payment",
+		"htlc",
 	}
 
 	SCCode = map[string]SCName{
@@ -42,6 +48,8 @@ var (
 		"miner":    Miner,
 		"vesting":  Vesting,
 		"zcn":      Zcn,
+		"payment":  Payment,
+		"htlc":     HTLC,
 	}
 )
 
@@ -73,6 +81,10 @@ func newSmartContract(name string) sci.SmartContractInterface {
 		return vestingsc.NewVestingSmartContract()
 	case Zcn:
 		return zcnsc.NewZCNSmartContract()
+	case Payment:
+		return paymentsc.NewPaymentSmartContract()
+	case HTLC:
+		return htlcsc.NewHTLCSmartContract()
 	default:
 		return nil
 	}
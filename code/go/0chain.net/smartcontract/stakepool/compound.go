@@ -0,0 +1,166 @@
+package stakepool
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+)
+
+// AutoCompoundRequest turns the auto compounding of the rewards of the
+// delegate pool of the transaction client on or off
+type AutoCompoundRequest struct {
+	ProviderType spenum.Provider `json:"provider_type,omitempty"`
+	ProviderID   string          `json:"provider_id,omitempty"`
+	AutoCompound bool            `json:"auto_compound"`
+}
+
+func (acr *AutoCompoundRequest) Encode() []byte {
+	bytes, _ := json.Marshal(acr)
+	return bytes
+}
+
+func (acr *AutoCompoundRequest) decode(p []byte) (err error) {
+	return json.Unmarshal(p, acr)
+}
+
+// StakePoolAutoCompound sets the auto compound flag of the delegate pool of
+// the client. The rewards of the auto compounding pool are rolled into its
+// balance when they are distributed, instead of waiting to be collected.
+func StakePoolAutoCompound(t *transaction.Transaction, input []byte, balances cstate.StateContextI,
+	get func(providerType spenum.Provider, providerID string, balances cstate.CommonStateContextI) (AbstractStakePool, error),
+) (resp string, err error) {
+	var acr AutoCompoundRequest
+	if err = acr.decode(input); err != nil {
+		return "", common.NewErrorf("stake_pool_auto_compound_failed",
+			"invalid request: %v", err)
+	}
+	var sp AbstractStakePool
+	if sp, err = get(acr.ProviderType, acr.ProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_auto_compound_failed",
+			"can't get stake pool: %v", err)
+	}
+	dp, ok := sp.GetPools()[t.ClientID]
+	if !ok {
+		return "", common.NewErrorf("stake_pool_auto_compound_failed",
+			"no such delegate pool: %v", t.ClientID)
+	}
+	if dp.Status != spenum.Active && dp.Status != spenum.Pending {
+		return "", common.NewErrorf("stake_pool_auto_compound_failed",
+			"could not change pool in %s status", dp.Status)
+	}
+
+	dp.AutoCompound = acr.AutoCompound
+	dpUpdate := newDelegatePoolUpdate(t.ClientID, acr.ProviderID, acr.ProviderType)
+	dpUpdate.Updates["auto_compound"] = dp.AutoCompound
+	dpUpdate.emitUpdate(balances)
+
+	if err = sp.Save(acr.ProviderType, acr.ProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_auto_compound_failed",
+			"saving stake pool: %v", err)
+	}
+
+	return toJson(acr), nil
+}
+
+// maxStakes of the delegate pools of the providers, registered by the smart
+// contracts of the providers
+var maxStakes = make(map[spenum.Provider]func(balances cstate.CommonStateContextI) (currency.Coin, error))
+
+// RegisterMaxStake registers the max stake of the delegate pools of the
+// provider type, the rewards compounded into the pools can't exceed it
+func RegisterMaxStake(providerType spenum.Provider,
+	get func(balances cstate.CommonStateContextI) (currency.Coin, error)) {
+	maxStakes[providerType] = get
+}
+
+func getMaxStake(providerType spenum.Provider, balances cstate.CommonStateContextI) (currency.Coin, error) {
+	get, ok := maxStakes[providerType]
+	if !ok {
+		return 0, nil
+	}
+	return get(balances)
+}
+
+// compoundRewards rolls the rewards just distributed to the active auto
+// compounding delegate pools into their balances. A pool whose balance would
+// exceed the max stake of the provider type keeps the reward to be collected. The
+// compounded rewards are minted to the smart contract of the stake pool
+// minter, that is the one holding the stake, so they are paid out with the
+// balance on unlock. The compounded rewards are emitted as locked stake of
+// the delegates.
+func (sp *StakePool) compoundRewards(spUpdate *StakePoolReward, balances cstate.StateContextI) error {
+	var (
+		total    currency.Coin
+		maxStake currency.Coin
+		loaded   bool
+	)
+	for _, id := range sp.OrderedPoolIds() {
+		dp := sp.Pools[id]
+		reward := spUpdate.DelegateRewards[dp.DelegateID]
+		if !dp.AutoCompound || dp.Status != spenum.Active || reward == 0 {
+			continue
+		}
+
+		if !loaded {
+			var err error
+			if maxStake, err = getMaxStake(spUpdate.Type, balances); err != nil {
+				return fmt.Errorf("getting max stake: %v", err)
+			}
+			loaded = true
+		}
+		balance, err := currency.AddCoin(dp.Balance, reward)
+		if err != nil {
+			return err
+		}
+		if maxStake > 0 && balance > maxStake {
+			continue
+		}
+		dpReward, err := currency.MinusCoin(dp.Reward, reward)
+		if err != nil {
+			return err
+		}
+		if total, err = currency.AddCoin(total, reward); err != nil {
+			return err
+		}
+		dp.Balance = balance
+		dp.Reward = dpReward
+		spUpdate.DelegateCompounded[dp.DelegateID] = reward
+
+		i, err := reward.Int64()
+		if err != nil {
+			return err
+		}
+		balances.EmitEvent(event.TypeStats, event.TagLockStakePool, id, event.DelegatePoolLock{
+			Client:       dp.DelegateID,
+			ProviderId:   spUpdate.ID,
+			ProviderType: spUpdate.Type,
+			Amount:       i,
+			Total:        i,
+		})
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	minter, err := cstate.GetMinter(sp.Minter)
+	if err != nil {
+		return err
+	}
+	if err := balances.AddMint(&state.Mint{
+		Minter:     minter,
+		ToClientID: minter,
+		Amount:     total,
+	}); err != nil {
+		return fmt.Errorf("minting compounded rewards: %v", err)
+	}
+
+	return sp.EmitStakeEvent(spUpdate.Type, spUpdate.ID, balances)
+}
@@ -31,6 +31,7 @@ func NewStakePoolReward(pId string, pType spenum.Provider, rewardType spenum.Rew
 	spu.Type = pType
 	spu.DelegateRewards = make(map[string]currency.Coin)
 	spu.DelegatePenalties = make(map[string]currency.Coin)
+	spu.DelegateCompounded = make(map[string]currency.Coin)
 	spu.RewardType = rewardType
 
 	var allocationID string
@@ -64,5 +65,7 @@ func stakePoolRewardToStakePoolRewardEvent(spu StakePoolReward) *dbs.StakePoolRe
 		DelegatePenalties: spu.DelegatePenalties,
 		RewardType:        spu.RewardType,
 		AllocationID:      spu.AllocationID,
+
+		DelegateCompounded: spu.DelegateCompounded,
 	}
 }
@@ -16,6 +16,7 @@ import (
 
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
 	"0chain.net/core/datastore"
 )
 
@@ -64,49 +65,103 @@ func (sp *StakePool) LockPool(
 		return "", err
 	}
 
-	var newPoolId = txn.ClientID
+	lock, err := sp.lockPool(txn.ClientID, txn.Value, txn.CreationDate, providerType, providerId, status, balances)
+	if err != nil {
+		return "", err
+	}
+
+	if err := balances.AddTransfer(state.NewTransfer(
+		txn.ClientID, txn.ToClientID, txn.Value,
+	)); err != nil {
+		return "", err
+	}
+
+	return toJson(lock), nil
+}
+
+// RedelegatePool adds the stake moved from a delegate pool of another
+// provider to the delegate pool of the client. The tokens stay in the smart
+// contract, so there is no transfer. A new delegate pool keeps the status
+// and the auto compounding of the moved one, the unbonded pool is staked
+// again like a new lock is.
+func (sp *StakePool) RedelegatePool(
+	clientID string,
+	from DelegatePool,
+	stakedAt common.Timestamp,
+	providerType spenum.Provider,
+	providerId datastore.Key,
+	balances cstate.StateContextI,
+) (string, error) {
+	status := from.Status
+	if status == spenum.Unbonding {
+		status = spenum.Active
+	}
+	_, existed := sp.Pools[clientID]
+	lock, err := sp.lockPool(clientID, from.Balance, stakedAt, providerType, providerId, status, balances)
+	if err != nil {
+		return "", err
+	}
+	if dp := sp.Pools[clientID]; !existed && from.AutoCompound {
+		dp.AutoCompound = true
+		update := newDelegatePoolUpdate(clientID, providerId, providerType)
+		update.Updates["auto_compound"] = dp.AutoCompound
+		update.emitUpdate(balances)
+	}
+	return toJson(lock), nil
+}
+
+func (sp *StakePool) lockPool(
+	clientID string,
+	value currency.Coin,
+	stakedAt common.Timestamp,
+	providerType spenum.Provider,
+	providerId datastore.Key,
+	status spenum.PoolStatus,
+	balances cstate.StateContextI,
+) (*event.DelegatePoolLock, error) {
+	var newPoolId = clientID
 	dp, ok := sp.Pools[newPoolId]
 	if !ok {
 		// new stake
 		dp = &DelegatePool{
-			Balance:      txn.Value,
+			Balance:      value,
 			Reward:       0,
 			Status:       status,
-			DelegateID:   txn.ClientID,
+			DelegateID:   clientID,
 			RoundCreated: balances.GetBlock().Round,
-			StakedAt:     txn.CreationDate,
+			StakedAt:     stakedAt,
 		}
 		sp.Pools[newPoolId] = dp
 		dp.EmitNew(newPoolId, providerId, providerType, balances)
 	} else {
 		// stake from the same clients
-		if dp.DelegateID != txn.ClientID {
-			return "", fmt.Errorf("could not stake for different delegate id: %s, txn client id: %s", dp.DelegateID, txn.ClientID)
+		if dp.DelegateID != clientID {
+			return nil, fmt.Errorf("could not stake for different delegate id: %s, txn client id: %s", dp.DelegateID, clientID)
 		}
 
 		//  check status, only allow staking more when current pool is active
 		if dp.Status != spenum.Active && dp.Status != spenum.Pending {
-			return "", fmt.Errorf("could not stake pool in %s status", dp.Status)
+			return nil, fmt.Errorf("could not stake pool in %s status", dp.Status)
 		}
 
-		b, err := currency.AddCoin(dp.Balance, txn.Value)
+		b, err := currency.AddCoin(dp.Balance, value)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		dp.Balance = b
-		dp.StakedAt = txn.CreationDate
+		dp.StakedAt = stakedAt
 
 		update := newDelegatePoolUpdate(newPoolId, providerId, providerType)
 		update.Updates["balance"] = dp.Balance
 		update.emitUpdate(balances)
 	}
 
-	i, _ := txn.Value.Int64()
-	logging.Logger.Info("emmit TagLockStakePool", zap.String("client_id", txn.ClientID), zap.String("provider_id", providerId))
+	i, _ := value.Int64()
+	logging.Logger.Info("emmit TagLockStakePool", zap.String("client_id", clientID), zap.String("provider_id", providerId))
 
 	lock := event.DelegatePoolLock{
-		Client:       txn.ClientID,
+		Client:       clientID,
 		ProviderId:   providerId,
 		ProviderType: providerType,
 		Amount:       i,
@@ -115,13 +170,7 @@ func (sp *StakePool) LockPool(
 	}
 	balances.EmitEvent(event.TypeStats, event.TagLockStakePool, newPoolId, lock)
 
-	if err := balances.AddTransfer(state.NewTransfer(
-		txn.ClientID, txn.ToClientID, txn.Value,
-	)); err != nil {
-		return "", err
-	}
-
-	return toJson(lock), nil
+	return &lock, nil
 }
 
 func (sp *StakePool) EmitStakeEvent(providerType spenum.Provider, providerID string, balances cstate.StateContextI) error {
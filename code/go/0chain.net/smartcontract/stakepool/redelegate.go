@@ -0,0 +1,179 @@
+package stakepool
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+)
+
+// RedelegateRequest moves the delegate pool of the transaction client from
+// the stake pool of a provider to the stake pool of another one
+type RedelegateRequest struct {
+	ProviderType   spenum.Provider `json:"provider_type,omitempty"`
+	ProviderID     string          `json:"provider_id,omitempty"`
+	ToProviderType spenum.Provider `json:"to_provider_type,omitempty"`
+	ToProviderID   string          `json:"to_provider_id,omitempty"`
+}
+
+func (rr *RedelegateRequest) Encode() []byte {
+	bytes, _ := json.Marshal(rr)
+	return bytes
+}
+
+func (rr *RedelegateRequest) decode(p []byte) (err error) {
+	return json.Unmarshal(p, rr)
+}
+
+// StakePoolRedelegate moves the whole delegate pool of the client to another
+// provider of the same smart contract, without the second fee and the client
+// balance of the unlock and lock. The pool leaves the provider by the rules of
+// the unlock: after the min lock period, and with the unbonding rounds it
+// should be unlocked first and it's redelegated instead of claimed after its
+// release round. The rewards of the pool are paid out to the client, the
+// tokens stay in the smart contract.
+func StakePoolRedelegate(t *transaction.Transaction, input []byte, balances cstate.StateContextI,
+	unbondingRounds int64, vs ValidationSettings,
+	get func(providerType spenum.Provider, providerID string, balances cstate.CommonStateContextI) (AbstractStakePool, error),
+) (resp string, err error) {
+	var rr RedelegateRequest
+	if err = rr.decode(input); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"invalid request: %v", err)
+	}
+	if rr.ProviderType == rr.ToProviderType && rr.ProviderID == rr.ToProviderID {
+		return "", common.NewError("stake_pool_redelegate_failed",
+			"can't redelegate to the same provider")
+	}
+
+	var from, to AbstractStakePool
+	if from, err = get(rr.ProviderType, rr.ProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"can't get stake pool: %v", err)
+	}
+	if to, err = get(rr.ToProviderType, rr.ToProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"can't get target stake pool: %v", err)
+	}
+	if to.IsDead() {
+		return "", common.NewError("stake_pool_redelegate_failed",
+			"target provider is killed")
+	}
+
+	dp, ok := from.GetPools()[t.ClientID]
+	if !ok {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"no such delegate pool: %v", t.ClientID)
+	}
+	if err = checkRedelegation(dp, unbondingRounds, balances.GetBlock().Round); err != nil {
+		return "", common.NewError("stake_pool_redelegate_failed", err.Error())
+	}
+	moved := *dp
+	if err = validateRedelegation(t.ClientID, moved.Balance, to, vs); err != nil {
+		return "", common.NewError("stake_pool_redelegate_failed", err.Error())
+	}
+
+	if _, err = from.UnlockPool(t.ClientID, rr.ProviderType, rr.ProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed", "%v", err)
+	}
+	dp.Balance = 0
+	dp.Status = spenum.Deleted
+	if err = from.DeletePool(t.ClientID, rr.ProviderType, rr.ProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"deleting stake pool: %v", err)
+	}
+
+	out, err := to.RedelegatePool(t.ClientID, moved, t.CreationDate, rr.ToProviderType, rr.ToProviderID, balances)
+	if err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"stake pool digging error: %v", err)
+	}
+
+	if err = from.Save(rr.ProviderType, rr.ProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"saving stake pool: %v", err)
+	}
+	if err = to.Save(rr.ToProviderType, rr.ToProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"saving target stake pool: %v", err)
+	}
+
+	if err = from.EmitStakeEvent(rr.ProviderType, rr.ProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"stake pool staking error: %v", err)
+	}
+	if err = to.EmitStakeEvent(rr.ToProviderType, rr.ToProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_redelegate_failed",
+			"target stake pool staking error: %v", err)
+	}
+
+	return out, nil
+}
+
+// checkRedelegation checks the delegate pool can leave its provider like it's
+// unlocked, the pool is unbonded first if the smart contract has the
+// unbonding rounds
+func checkRedelegation(dp *DelegatePool, unbondingRounds, round int64) error {
+	if err := checkMinLockPeriod(dp); err != nil {
+		return err
+	}
+	switch {
+	case unbondingRounds <= 0 && (dp.Status == spenum.Active || dp.Status == spenum.Pending):
+		return nil
+	case unbondingRounds > 0 && dp.Status == spenum.Unbonding:
+		if round < dp.ReleaseRound {
+			return fmt.Errorf("delegate pool is unbonding until round %d, current round %d",
+				dp.ReleaseRound, round)
+		}
+		return nil
+	case unbondingRounds > 0 && (dp.Status == spenum.Active || dp.Status == spenum.Pending):
+		return fmt.Errorf("delegate pool should be unlocked and unbonded for %d rounds first",
+			unbondingRounds)
+	default:
+		return fmt.Errorf("could not redelegate pool in %s status", dp.Status)
+	}
+}
+
+// validateRedelegation checks the moved stake against the stake limits of
+// the smart contract and the settings of the target stake pool
+func validateRedelegation(clientID string, amount currency.Coin, to AbstractStakePool, vs ValidationSettings) error {
+	if amount == 0 {
+		return fmt.Errorf("no stake to redelegate: %v", amount)
+	}
+	settings := to.GetSettings()
+	if amount < vs.MinStake {
+		return fmt.Errorf("too small stake to redelegate: %v < %v", amount, vs.MinStake)
+	}
+	if amount < settings.MinStake {
+		return fmt.Errorf("too small stake for the target provider: %v < %v", amount, settings.MinStake)
+	}
+
+	poolStakeAfter := amount
+	if pool, ok := to.GetPools()[clientID]; ok {
+		var err error
+		if poolStakeAfter, err = currency.AddCoin(pool.Balance, amount); err != nil {
+			return err
+		}
+	}
+	if poolStakeAfter > vs.MaxStake {
+		return fmt.Errorf("too large stake to redelegate: %v > %v", poolStakeAfter, vs.MaxStake)
+	}
+
+	if !to.HasStakePool(clientID) {
+		numPools := len(to.GetPools())
+		if numPools >= vs.MaxNumDelegates {
+			return fmt.Errorf("max_delegates reached: %v, no more stake pools allowed",
+				vs.MaxNumDelegates)
+		}
+		if settings.MaxNumDelegates > 0 && numPools >= settings.MaxNumDelegates {
+			return fmt.Errorf("max delegates of the target provider reached: %v",
+				settings.MaxNumDelegates)
+		}
+	}
+
+	return nil
+}
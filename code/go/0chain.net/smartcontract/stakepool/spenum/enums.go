@@ -47,9 +47,10 @@ const (
 	Active PoolStatus = iota
 	Pending
 	Deleted
+	Unbonding
 )
 
-var poolString = []string{"active", "pending", "deleted"}
+var poolString = []string{"active", "pending", "deleted", "unbonding"}
 
 func (p PoolStatus) String() string {
 	if int(p) < len(poolString) && int(p) >= 0 {
@@ -79,6 +80,9 @@ const (
 	ChallengePassReward
 	ChallengeSlashPenalty
 	CancellationChargeReward
+	ReservationForfeitReward
+	BlobberMigrationPenalty
+	BlobberMigrationReward
 	NumOfRewards
 )
 
@@ -95,6 +99,9 @@ func initRewardString() {
 	rewardString[ChallengePassReward] = "challenge_pass_reward"
 	rewardString[ChallengeSlashPenalty] = "challenge_slash"
 	rewardString[CancellationChargeReward] = "cancellation_charge"
+	rewardString[ReservationForfeitReward] = "reservation_forfeit"
+	rewardString[BlobberMigrationPenalty] = "blobber_migration_penalty"
+	rewardString[BlobberMigrationReward] = "blobber_migration"
 	rewardString[NumOfRewards] = "invalid"
 }
 
@@ -43,6 +43,8 @@ type AbstractStakePool interface {
 	GetSettings() Settings
 	Empty(sscID, poolID, clientID string, balances cstate.StateContextI) error
 	UnlockPool(clientID string, providerType spenum.Provider, providerId datastore.Key, balances cstate.StateContextI) (string, error)
+	UnbondPool(clientID string, providerType spenum.Provider, providerId datastore.Key, releaseRound int64, balances cstate.StateContextI) (string, error)
+	RedelegatePool(clientID string, from DelegatePool, stakedAt common.Timestamp, providerType spenum.Provider, providerId datastore.Key, balances cstate.StateContextI) (string, error)
 	DeletePool(clientID string, providerType spenum.Provider, providerId datastore.Key, balances cstate.StateContextI) error
 	Kill(float64, string, spenum.Provider, cstate.StateContextI) error
 	IsDead() bool
@@ -72,6 +74,8 @@ type DelegatePool struct {
 	RoundCreated int64             `json:"round_created"` // used for cool down
 	DelegateID   string            `json:"delegate_id"`
 	StakedAt     common.Timestamp  `json:"staked_at"`
+	ReleaseRound int64             `json:"release_round,omitempty"` // unbonding pool can be claimed from
+	AutoCompound bool              `json:"auto_compound,omitempty"` // roll rewards into the balance
 }
 
 // swagger:model stakePoolStat
@@ -99,6 +103,10 @@ type DelegatePoolStat struct {
 	Status       string           `json:"status"`
 	RoundCreated int64            `json:"round_created"`
 	StakedAt     common.Timestamp `json:"staked_at"`
+	ReleaseRound int64            `json:"release_round,omitempty"`
+
+	AutoCompound    bool          `json:"auto_compound"`
+	TotalCompounded currency.Coin `json:"total_compounded"` // rewards rolled into the balance
 }
 
 // swagger:model userPoolStat
@@ -127,6 +135,11 @@ func ToProviderStakePoolStats(provider *event.Provider, delegatePools []event.De
 			Status:       spenum.PoolStatus(dp.Status).String(),
 			RoundCreated: dp.RoundCreated,
 			StakedAt:     dp.StakedAt,
+			UnStake:      dp.Status == spenum.Unbonding,
+			ReleaseRound: dp.ReleaseRound,
+
+			AutoCompound:    dp.AutoCompound,
+			TotalCompounded: dp.TotalCompounded,
 		}
 		dpStats.Balance = dp.Balance
 
@@ -217,6 +230,18 @@ func (sp *StakePool) GetOrderedPools() []*DelegatePool {
 	return pools
 }
 
+// bondedPools returns the ordered delegate pools except the unbonding ones,
+// the unbonding pools are slashed but not rewarded
+func (sp *StakePool) bondedPools() []*DelegatePool {
+	pools := make([]*DelegatePool, 0, len(sp.Pools))
+	for _, dp := range sp.GetOrderedPools() {
+		if dp.Status != spenum.Unbonding {
+			pools = append(pools, dp)
+		}
+	}
+	return pools
+}
+
 func (sp *StakePool) HasStakePool(user string) bool {
 	_, found := sp.Pools[user]
 	return found
@@ -394,7 +419,7 @@ func (sp *StakePool) DistributeRewardsRandN(
 	var spUpdate = NewStakePoolReward(providerId, providerType, rewardType)
 
 	// if no stake pools pay all rewards to the provider
-	if len(sp.Pools) == 0 {
+	if len(sp.bondedPools()) == 0 {
 		sp.Reward, err = currency.AddCoin(sp.Reward, value)
 		if err != nil {
 			return err
@@ -471,6 +496,9 @@ func (sp *StakePool) DistributeRewardsRandN(
 			return err
 		}
 	}
+	if err := sp.compoundRewards(spUpdate, balances); err != nil {
+		return err
+	}
 	if err := spUpdate.Emit(event.TagStakePoolReward, balances); err != nil {
 		return err
 	}
@@ -484,6 +512,9 @@ func (sp *StakePool) getRandPools(seed int64, n int) []*DelegatePool {
 
 	pls := make([]*DelegatePool, 0, len(sp.Pools))
 	for _, pool := range sp.Pools {
+		if pool.Status == spenum.Unbonding {
+			continue
+		}
 		pls = append(pls, pool)
 	}
 
@@ -565,7 +596,8 @@ func (sp *StakePool) DistributeRewards(
 	}()
 
 	// if no stake pools pay all rewards to the provider
-	if len(sp.Pools) == 0 {
+	pools := sp.bondedPools()
+	if len(pools) == 0 {
 		sp.Reward, err = currency.AddCoin(sp.Reward, value)
 		if err != nil {
 			return err
@@ -610,12 +642,10 @@ func (sp *StakePool) DistributeRewards(
 		return fmt.Errorf("no stake")
 	}
 
-	orderedPoolIds := sp.OrderedPoolIds()
-	for _, id := range orderedPoolIds {
+	for _, dp := range pools {
 		if valueBalance == 0 {
 			break
 		}
-		dp := sp.Pools[id]
 		ratio := float64(dp.Balance) / float64(stake)
 		reward, err := currency.MultFloat64(valueLeft, ratio)
 		if err != nil {
@@ -638,11 +668,14 @@ func (sp *StakePool) DistributeRewards(
 	}
 
 	if valueBalance > 0 {
-		err = sp.equallyDistributeRewards(valueBalance, spUpdate)
+		err = equallyDistributeRewards(valueBalance, pools, spUpdate)
 		if err != nil {
 			return err
 		}
 	}
+	if err := sp.compoundRewards(spUpdate, balances); err != nil {
+		return err
+	}
 	if err := spUpdate.Emit(event.TagStakePoolReward, balances); err != nil {
 		return err
 	}
@@ -650,10 +683,9 @@ func (sp *StakePool) DistributeRewards(
 	return nil
 }
 
+// stake returns the total stake of the delegate pools except the unbonding ones
 func (sp *StakePool) stake() (stake currency.Coin, err error) {
-	orderedPoolIds := sp.OrderedPoolIds()
-	for _, id := range orderedPoolIds {
-		dp := sp.Pools[id]
+	for _, dp := range sp.bondedPools() {
 		newStake, err := currency.AddCoin(stake, dp.Balance)
 		if err != nil {
 			return 0, err
@@ -663,10 +695,6 @@ func (sp *StakePool) stake() (stake currency.Coin, err error) {
 	return
 }
 
-func (sp *StakePool) equallyDistributeRewards(coins currency.Coin, spUpdate *StakePoolReward) error {
-	return equallyDistributeRewards(coins, sp.GetOrderedPools(), spUpdate)
-}
-
 func equallyDistributeRewards(coins currency.Coin, pools []*DelegatePool, spUpdate *StakePoolReward) error {
 	share, r, err := currency.DistributeCoin(coins, int64(len(pools)))
 	if err != nil {
@@ -806,8 +834,11 @@ func validateLockRequest(t *transaction.Transaction, sp AbstractStakePool, vs Va
 	return "", nil
 }
 
-// StakePoolUnlock unlock tokens from provider, stake pool can return excess tokens from stake pool
+// StakePoolUnlock unlock tokens from provider, stake pool can return excess tokens from stake pool.
+// The delegate pool is unbonding for the unbonding rounds before the tokens
+// can be claimed, they are returned at once without the unbonding rounds.
 func StakePoolUnlock(t *transaction.Transaction, input []byte, balances cstate.StateContextI,
+	unbondingRounds int64,
 	get func(providerType spenum.Provider, providerID string, balances cstate.CommonStateContextI) (AbstractStakePool, error),
 ) (resp string, err error) {
 	var spr StakePoolRequest
@@ -828,41 +859,35 @@ func StakePoolUnlock(t *transaction.Transaction, input []byte, balances cstate.S
 	if !ok {
 		return "", common.NewErrorf("stake_pool_unlock_failed", "no such delegate pool: %v ", t.ClientID)
 	}
-
-	// if StakeAt has valid value and lock period is less than MinLockPeriod
-	if dp.StakedAt > 0 {
-		stakedAt := common.ToTime(dp.StakedAt)
-		minLockPeriod := config.SmartContractConfig.GetDuration("stakepool.min_lock_period")
-		if !stakedAt.Add(minLockPeriod).Before(time.Now()) {
-			return "", common.NewErrorf("stake_pool_unlock_failed", "token can only be unstaked till: %s", stakedAt.Add(minLockPeriod))
-		}
+	if dp.Status == spenum.Unbonding {
+		return "", common.NewErrorf("stake_pool_unlock_failed",
+			"delegate pool is unbonding until round %d", dp.ReleaseRound)
 	}
 
-	output, err := sp.UnlockPool(t.ClientID, spr.ProviderType, spr.ProviderID, balances)
-	if err != nil {
-		return "", common.NewErrorf("stake_pool_unlock_failed", "%v", err)
+	if err = checkMinLockPeriod(dp); err != nil {
+		return "", common.NewError("stake_pool_unlock_failed", err.Error())
 	}
 
-	err = sp.Empty(t.ToClientID, t.ClientID, t.ClientID, balances)
-	if err != nil {
-		return "", common.NewErrorf("stake_pool_unlock_failed",
-			"unlocking tokens: %v", err)
+	if unbondingRounds <= 0 {
+		output, err := releasePool(t, spr, sp, balances)
+		if err != nil {
+			return "", common.NewErrorf("stake_pool_unlock_failed", "%v", err)
+		}
+		return output, nil
 	}
 
-	err = sp.DeletePool(t.ClientID, spr.ProviderType, spr.ProviderID, balances)
+	output, err := sp.UnbondPool(t.ClientID, spr.ProviderType, spr.ProviderID,
+		balances.GetBlock().Round+unbondingRounds, balances)
 	if err != nil {
-		return "", common.NewErrorf("stake_pool_unlock_failed",
-			"deleting stake pool: %v", err)
+		return "", common.NewErrorf("stake_pool_unlock_failed", "%v", err)
 	}
 
-	// Save the pool
 	if err = sp.Save(spr.ProviderType, spr.ProviderID, balances); err != nil {
 		return "", common.NewErrorf("stake_pool_unlock_failed",
 			"saving stake pool: %v", err)
 	}
 
-	err = sp.EmitStakeEvent(spr.ProviderType, spr.ProviderID, balances)
-	if err != nil {
+	if err = sp.EmitStakeEvent(spr.ProviderType, spr.ProviderID, balances); err != nil {
 		return "", common.NewErrorf("stake_pool_unlock_failed",
 			"stake pool staking error: %v", err)
 	}
@@ -870,6 +895,19 @@ func StakePoolUnlock(t *transaction.Transaction, input []byte, balances cstate.S
 	return output, nil
 }
 
+// checkMinLockPeriod checks the delegate pool is staked for the min lock
+// period at least, if StakeAt has valid value
+func checkMinLockPeriod(dp *DelegatePool) error {
+	if dp.StakedAt > 0 {
+		stakedAt := common.ToTime(dp.StakedAt)
+		minLockPeriod := config.SmartContractConfig.GetDuration("stakepool.min_lock_period")
+		if !stakedAt.Add(minLockPeriod).Before(time.Now()) {
+			return fmt.Errorf("token can only be unstaked till: %s", stakedAt.Add(minLockPeriod))
+		}
+	}
+	return nil
+}
+
 func toJson(val interface{}) string {
 	var b, err = json.Marshal(val)
 	if err != nil {
@@ -10,6 +10,8 @@ import (
 	"0chain.net/chaincore/chain/state"
 	"0chain.net/smartcontract/stakepool/spenum"
 	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/logging"
+	"go.uber.org/zap"
 )
 
 func TestStakePool_DistributeRewards(t *testing.T) {
@@ -184,6 +186,104 @@ func TestStakePool_DistributeRewards(t *testing.T) {
 	}
 }
 
+func TestStakePool_CompoundRewards(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	var (
+		balances = newTestBalances(t, false)
+		sp       = NewStakePool()
+	)
+	sp.Pools["compound"] = &DelegatePool{DelegateID: "compound", Balance: 50, AutoCompound: true}
+	sp.Pools["collect"] = &DelegatePool{DelegateID: "collect", Balance: 50, Reward: 5}
+	sp.Pools["unbonding"] = &DelegatePool{DelegateID: "unbonding", Balance: 100,
+		Status: spenum.Unbonding, AutoCompound: true}
+
+	err := sp.DistributeRewards(100, "provider_id", spenum.Blobber, spenum.BlockRewardBlobber, balances)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 100, sp.Pools["compound"].Balance)
+	require.EqualValues(t, 0, sp.Pools["compound"].Reward)
+	require.EqualValues(t, 50, sp.Pools["collect"].Balance)
+	require.EqualValues(t, 55, sp.Pools["collect"].Reward)
+	require.EqualValues(t, 100, sp.Pools["unbonding"].Balance)
+	require.EqualValues(t, 0, sp.Pools["unbonding"].Reward)
+}
+
+func TestStakePool_CompoundRewardsMaxStake(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	RegisterMaxStake(spenum.Validator, func(state.CommonStateContextI) (currency.Coin, error) {
+		return 120, nil
+	})
+	defer delete(maxStakes, spenum.Validator)
+
+	var (
+		balances = newTestBalances(t, false)
+		sp       = NewStakePool()
+	)
+	sp.Pools["below"] = &DelegatePool{DelegateID: "below", Balance: 50, AutoCompound: true}
+	sp.Pools["above"] = &DelegatePool{DelegateID: "above", Balance: 100, AutoCompound: true}
+
+	err := sp.DistributeRewards(60, "provider_id", spenum.Validator, spenum.ValidationReward, balances)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 70, sp.Pools["below"].Balance)
+	require.EqualValues(t, 0, sp.Pools["below"].Reward)
+	require.EqualValues(t, 100, sp.Pools["above"].Balance)
+	require.EqualValues(t, 40, sp.Pools["above"].Reward)
+}
+
+func TestStakePool_UnbondingPools(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	newSP := func() *StakePool {
+		sp := NewStakePool()
+		sp.Pools["active"] = &DelegatePool{DelegateID: "active", Balance: 50}
+		sp.Pools["unbonding"] = &DelegatePool{DelegateID: "unbonding", Balance: 50,
+			Status: spenum.Unbonding}
+		return sp
+	}
+
+	t.Run("stake", func(t *testing.T) {
+		stake, err := newSP().stake()
+		require.NoError(t, err)
+		require.EqualValues(t, 50, stake)
+	})
+
+	t.Run("distribute rewards", func(t *testing.T) {
+		sp := newSP()
+		err := sp.DistributeRewards(100, "provider_id", spenum.Blobber, spenum.BlockRewardBlobber,
+			newTestBalances(t, false))
+		require.NoError(t, err)
+		require.EqualValues(t, 100, sp.Pools["active"].Reward)
+		require.EqualValues(t, 0, sp.Pools["unbonding"].Reward)
+	})
+
+	t.Run("distribute rewards to random pools", func(t *testing.T) {
+		sp := newSP()
+		err := sp.DistributeRewardsRandN(100, "provider_id", spenum.Blobber, 1, 2,
+			spenum.BlockRewardBlobber, newTestBalances(t, false))
+		require.NoError(t, err)
+		require.EqualValues(t, 100, sp.Pools["active"].Reward)
+		require.EqualValues(t, 0, sp.Pools["unbonding"].Reward)
+	})
+
+	t.Run("all unbonding", func(t *testing.T) {
+		sp := newSP()
+		delete(sp.Pools, "active")
+		err := sp.DistributeRewards(100, "provider_id", spenum.Blobber, spenum.BlockRewardBlobber,
+			newTestBalances(t, false))
+		require.NoError(t, err)
+		require.EqualValues(t, 100, sp.Reward)
+		require.EqualValues(t, 0, sp.Pools["unbonding"].Reward)
+	})
+
+	t.Run("slash", func(t *testing.T) {
+		sp := newSP()
+		err := sp.SlashFraction(0.5, "provider_id", spenum.Blobber, newTestBalances(t, false))
+		require.NoError(t, err)
+		require.EqualValues(t, 25, sp.Pools["active"].Balance)
+		require.EqualValues(t, 25, sp.Pools["unbonding"].Balance)
+	})
+}
+
 func TestGetOrderedPools(t *testing.T) {
 	sp := &StakePool{
 		Pools: map[string]*DelegatePool{
@@ -406,3 +506,75 @@ func Test_validateLockRequest(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkRedelegation(t *testing.T) {
+	tests := []struct {
+		name            string
+		dp              DelegatePool
+		unbondingRounds int64
+		err             string
+	}{
+		{name: "active", dp: DelegatePool{Status: spenum.Active}},
+		{name: "pending", dp: DelegatePool{Status: spenum.Pending}},
+		{
+			name:            "not unbonded",
+			dp:              DelegatePool{Status: spenum.Active},
+			unbondingRounds: 10,
+			err:             "delegate pool should be unlocked and unbonded for 10 rounds first",
+		},
+		{
+			name:            "unbonding",
+			dp:              DelegatePool{Status: spenum.Unbonding, ReleaseRound: 6},
+			unbondingRounds: 10,
+			err:             "delegate pool is unbonding until round 6, current round 5",
+		},
+		{
+			name:            "unbonded",
+			dp:              DelegatePool{Status: spenum.Unbonding, ReleaseRound: 5},
+			unbondingRounds: 10,
+		},
+		{
+			name: "deleted",
+			dp:   DelegatePool{Status: spenum.Deleted},
+			err:  "could not redelegate pool in deleted status",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRedelegation(&tt.dp, tt.unbondingRounds, 5)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestStakePool_RedelegatePool(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	balances := newTestBalances(t, false)
+
+	// a new pool keeps the status and the auto compounding
+	sp := NewStakePool()
+	_, err := sp.RedelegatePool("pending", DelegatePool{Balance: 10, Status: spenum.Pending, AutoCompound: true},
+		1, spenum.Miner, "provider_id", balances)
+	require.NoError(t, err)
+	require.Equal(t, spenum.Pending, sp.Pools["pending"].Status)
+	require.True(t, sp.Pools["pending"].AutoCompound)
+
+	// the unbonded pool is staked again
+	_, err = sp.RedelegatePool("unbonded", DelegatePool{Balance: 10, Status: spenum.Unbonding},
+		1, spenum.Miner, "provider_id", balances)
+	require.NoError(t, err)
+	require.Equal(t, spenum.Active, sp.Pools["unbonded"].Status)
+	require.False(t, sp.Pools["unbonded"].AutoCompound)
+
+	// the existing pool keeps its own settings
+	_, err = sp.RedelegatePool("unbonded", DelegatePool{Balance: 5, Status: spenum.Pending, AutoCompound: true},
+		2, spenum.Miner, "provider_id", balances)
+	require.NoError(t, err)
+	require.EqualValues(t, 15, sp.Pools["unbonded"].Balance)
+	require.Equal(t, spenum.Active, sp.Pools["unbonded"].Status)
+	require.False(t, sp.Pools["unbonded"].AutoCompound)
+}
@@ -0,0 +1,114 @@
+package stakepool
+
+import (
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+// UnbondingPool is the unlocked delegate pool waiting for the release round
+type UnbondingPool struct {
+	Client       string          `json:"client"`
+	ProviderId   string          `json:"provider_id"`
+	ProviderType spenum.Provider `json:"provider_type"`
+	ReleaseRound int64           `json:"release_round"`
+}
+
+// UnbondPool starts unbonding of the delegate pool. The unbonding pool stays
+// in the stake pool, and is slashed with it, until it's claimed after the
+// release round. It's not rewarded and not counted in the stake.
+func (sp *StakePool) UnbondPool(clientID string, providerType spenum.Provider, providerId datastore.Key,
+	releaseRound int64, balances cstate.StateContextI) (string, error) {
+	dp, ok := sp.Pools[clientID]
+	if !ok {
+		return "", fmt.Errorf("can't find pool of %v", clientID)
+	}
+	if dp.Status == spenum.Unbonding {
+		return "", fmt.Errorf("pool of %v is unbonding already", clientID)
+	}
+
+	dp.Status = spenum.Unbonding
+	dp.ReleaseRound = releaseRound
+
+	dpUpdate := newDelegatePoolUpdate(clientID, providerId, providerType)
+	dpUpdate.Updates["status"] = dp.Status
+	dpUpdate.Updates["release_round"] = dp.ReleaseRound
+	dpUpdate.emitUpdate(balances)
+
+	return toJson(UnbondingPool{
+		Client:       clientID,
+		ProviderId:   providerId,
+		ProviderType: providerType,
+		ReleaseRound: releaseRound,
+	}), nil
+}
+
+// StakePoolClaimUnbonded pays out the unbonding delegate pool of the client,
+// the balance and the rewards, after the release round
+func StakePoolClaimUnbonded(t *transaction.Transaction, input []byte, balances cstate.StateContextI,
+	get func(providerType spenum.Provider, providerID string, balances cstate.CommonStateContextI) (AbstractStakePool, error),
+) (resp string, err error) {
+	var spr StakePoolRequest
+	if err = spr.decode(input); err != nil {
+		return "", common.NewErrorf("stake_pool_claim_unbonded_failed",
+			"can't decode request: %v", err)
+	}
+	var sp AbstractStakePool
+	if sp, err = get(spr.ProviderType, spr.ProviderID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_claim_unbonded_failed",
+			"can't get related stake pool: %v", err)
+	}
+	dp, ok := sp.GetPools()[t.ClientID]
+	if !ok {
+		return "", common.NewErrorf("stake_pool_claim_unbonded_failed",
+			"no such delegate pool: %v", t.ClientID)
+	}
+	if dp.Status != spenum.Unbonding {
+		return "", common.NewError("stake_pool_claim_unbonded_failed",
+			"delegate pool is not unbonding")
+	}
+	if round := balances.GetBlock().Round; round < dp.ReleaseRound {
+		return "", common.NewErrorf("stake_pool_claim_unbonded_failed",
+			"delegate pool is unbonding until round %d, current round %d",
+			dp.ReleaseRound, round)
+	}
+
+	output, err := releasePool(t, spr, sp, balances)
+	if err != nil {
+		return "", common.NewErrorf("stake_pool_claim_unbonded_failed", "%v", err)
+	}
+	return output, nil
+}
+
+// releasePool pays out the balance and the rewards of the delegate pool of
+// the transaction client and removes it from the stake pool
+func releasePool(t *transaction.Transaction, spr StakePoolRequest, sp AbstractStakePool,
+	balances cstate.StateContextI) (string, error) {
+	output, err := sp.UnlockPool(t.ClientID, spr.ProviderType, spr.ProviderID, balances)
+	if err != nil {
+		return "", err
+	}
+
+	if err := sp.Empty(t.ToClientID, t.ClientID, t.ClientID, balances); err != nil {
+		return "", fmt.Errorf("unlocking tokens: %v", err)
+	}
+
+	if err := sp.DeletePool(t.ClientID, spr.ProviderType, spr.ProviderID, balances); err != nil {
+		return "", fmt.Errorf("deleting stake pool: %v", err)
+	}
+
+	// Save the pool
+	if err := sp.Save(spr.ProviderType, spr.ProviderID, balances); err != nil {
+		return "", fmt.Errorf("saving stake pool: %v", err)
+	}
+
+	if err := sp.EmitStakeEvent(spr.ProviderType, spr.ProviderID, balances); err != nil {
+		return "", fmt.Errorf("stake pool staking error: %v", err)
+	}
+
+	return output, nil
+}
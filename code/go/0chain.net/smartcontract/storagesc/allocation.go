@@ -92,6 +92,15 @@ type newAllocationRequest struct {
 	ThirdPartyExtendable bool       `json:"third_party_extendable"`
 	FileOptionsChanged   bool       `json:"file_options_changed"`
 	FileOptions          uint16     `json:"file_options"`
+	MinReputation        float64    `json:"min_reputation"`
+	// GeoConstraints on the blobbers locations, the earlier blobbers of
+	// the list are preferred
+	GeoConstraints *GeoConstraints `json:"geo_constraints,omitempty"`
+	// Reservations of the client converted into the allocation, the
+	// blobbers of the reservations must be requested.
+	Reservations []string `json:"reservations,omitempty"`
+	// AssuranceTier of the allocation, see the assurance config
+	AssuranceTier int `json:"assurance_tier,omitempty"`
 }
 
 // storageAllocation from the request
@@ -108,6 +117,9 @@ func (nar *newAllocationRequest) storageAllocation(conf *Config, now common.Time
 	sa.WritePriceRange = nar.WritePriceRange
 	sa.ThirdPartyExtendable = nar.ThirdPartyExtendable
 	sa.FileOptions = nar.FileOptions
+	sa.MinReputation = nar.MinReputation
+	sa.GeoConstraints = nar.GeoConstraints
+	sa.setAssuranceTier(nar.AssuranceTier, conf)
 
 	return
 }
@@ -129,6 +141,20 @@ func (nar *newAllocationRequest) validate(conf *Config) error {
 		return errors.New("invalid write_price range")
 	}
 
+	if nar.MinReputation < 0 || nar.MinReputation > 1 {
+		return errors.New("min_reputation must be between 0 and 1")
+	}
+
+	if nar.GeoConstraints != nil {
+		if err := nar.GeoConstraints.validate(nar.DataShards + nar.ParityShards); err != nil {
+			return fmt.Errorf("invalid geo_constraints: %v", err)
+		}
+	}
+
+	if err := validateAssuranceTier(nar.AssuranceTier); err != nil {
+		return err
+	}
+
 	if nar.Size < conf.MinAllocSize {
 		return errors.New("insufficient allocation size")
 	}
@@ -268,16 +294,16 @@ func (sc *StorageSmartContract) newAllocationRequestInternal(
 	if len(spMap) != len(blobbers) {
 		return "", common.NewErrorf("allocation_creation_failed", "missing blobber's stake pool: %v", err)
 	}
-	var sns []*storageNodeResponse
-	for i := 0; i < len(blobbers); i++ {
-		stake, err := spMap[blobbers[i].ID].stake()
+	var reservations *allocationReservations
+	if len(request.Reservations) > 0 {
+		reservations, err = sc.useReservations(txn.ClientID, txn.CreationDate, request.Reservations, blobbers, spMap, balances)
 		if err != nil {
-			return "", common.NewErrorf("allocation_creation_failed", "cannot total stake pool for blobber %s: %v", blobbers[i].ID, err)
+			return "", common.NewError("allocation_creation_failed", err.Error())
 		}
-		snr := StoragNodeToStorageNodeResponse(*blobbers[i])
-		snr.TotalOffers = spMap[blobbers[i].ID].TotalOffers
-		snr.TotalStake = stake
-		sns = append(sns, &snr)
+	}
+	sns, err := storageNodesWithStake(blobbers, spMap)
+	if err != nil {
+		return "", common.NewErrorf("allocation_creation_failed", "%v", err)
 	}
 
 	sa, blobberNodes, err := setupNewAllocation(request, sns, m, txn.CreationDate, conf, txn.Hash)
@@ -285,6 +311,14 @@ func (sc *StorageSmartContract) newAllocationRequestInternal(
 		return "", err
 	}
 
+	var deposit currency.Coin
+	if reservations != nil {
+		deposit, err = reservations.convert(sa, blobberNodes, sc.ID, balances)
+		if err != nil {
+			return "", common.NewError("allocation_creation_failed", err.Error())
+		}
+	}
+
 	for _, b := range blobberNodes {
 		_, err = balances.InsertTrieNode(b.GetKey(), b)
 		if err != nil {
@@ -325,6 +359,11 @@ func (sc *StorageSmartContract) newAllocationRequestInternal(
 			zap.Error(err))
 		return "", common.NewError("allocation_creation_failed", err.Error())
 	}
+	if deposit > 0 {
+		if err := sa.addReservationDeposit(txn.ClientID, deposit, balances); err != nil {
+			return "", common.NewError("allocation_creation_failed", err.Error())
+		}
+	}
 
 	cost, err := sa.cost()
 	if err != nil {
@@ -464,6 +503,22 @@ func validateBlobbers(
 		return nil, 0, errors.New("Not enough blobbers to honor the allocation: " + strings.Join(errs, ", "))
 	}
 
+	if sa.GeoConstraints != nil {
+		locs := make([]StorageNodeGeolocation, 0, len(list))
+		for _, b := range list {
+			locs = append(locs, b.Geolocation)
+		}
+		idx, err := sa.GeoConstraints.selectBlobbers(locs, size)
+		if err != nil {
+			return nil, 0, err
+		}
+		picked := make([]*StorageNode, 0, size)
+		for _, i := range idx {
+			picked = append(picked, list[i])
+		}
+		list = picked
+	}
+
 	sa.BlobberAllocs = make([]*BlobberAllocation, 0)
 	sa.Stats = &StorageAllocationStats{}
 
@@ -482,6 +537,13 @@ type updateAllocationRequest struct {
 	SetThirdPartyExtendable bool   `json:"set_third_party_extendable"`
 	FileOptionsChanged      bool   `json:"file_options_changed"`
 	FileOptions             uint16 `json:"file_options"`
+	// AssuranceTier changes the tier of the allocation, the blobbers terms
+	// are updated the way the extension does
+	AssuranceTier *int `json:"assurance_tier,omitempty"`
+
+	// extendBy extends the current expiration instead of setting it to one
+	// time unit from now, it's used by the auto-renewal only
+	extendBy time.Duration
 }
 
 func (uar *updateAllocationRequest) decode(b []byte) error {
@@ -499,6 +561,7 @@ func (uar *updateAllocationRequest) validate(
 		len(uar.Name) == 0 &&
 		(!uar.SetThirdPartyExtendable || (uar.SetThirdPartyExtendable && alloc.ThirdPartyExtendable)) &&
 		(!uar.FileOptionsChanged || uar.FileOptions == alloc.FileOptions) &&
+		!uar.assuranceTierChanged(alloc) &&
 		(alloc.Owner == uar.OwnerID) {
 		return errors.New("update allocation changes nothing")
 	} else {
@@ -531,9 +594,28 @@ func (uar *updateAllocationRequest) validate(
 		return fmt.Errorf("FileOptions %d incorrect", uar.FileOptions)
 	}
 
+	if uar.AssuranceTier != nil {
+		if err := validateAssuranceTier(*uar.AssuranceTier); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (uar *updateAllocationRequest) assuranceTierChanged(alloc *StorageAllocation) bool {
+	return uar.AssuranceTier != nil && *uar.AssuranceTier != alloc.AssuranceTier
+}
+
+// newExpiration returns expiration of the extended allocation
+func (uar *updateAllocationRequest) newExpiration(alloc *StorageAllocation,
+	now common.Timestamp, timeUnit time.Duration) common.Timestamp {
+	if uar.extendBy > 0 {
+		return alloc.Expiration + toSeconds(uar.extendBy)
+	}
+	return common.Timestamp(common.ToTime(now).Add(timeUnit).Unix())
+}
+
 // calculate size difference for every blobber of the allocations
 func (uar *updateAllocationRequest) getBlobbersSizeDiff(
 	alloc *StorageAllocation) (diff int64) {
@@ -556,6 +638,23 @@ func getBlobbersByIDs(ids []string, balances chainstate.CommonStateContextI) ([]
 		balances)
 }
 
+// storageNodesWithStake converts the blobbers for the validation of the
+// allocation blobbers
+func storageNodesWithStake(blobbers []*StorageNode, spMap map[string]*stakePool) ([]*storageNodeResponse, error) {
+	sns := make([]*storageNodeResponse, 0, len(blobbers))
+	for _, b := range blobbers {
+		stake, err := spMap[b.ID].stake()
+		if err != nil {
+			return nil, fmt.Errorf("cannot total stake pool for blobber %s: %v", b.ID, err)
+		}
+		snr := StoragNodeToStorageNodeResponse(*b)
+		snr.TotalOffers = spMap[b.ID].TotalOffers
+		snr.TotalStake = stake
+		sns = append(sns, &snr)
+	}
+	return sns, nil
+}
+
 func getStakePoolsByIDs(ids []string, providerType spenum.Provider, balances chainstate.CommonStateContextI) (map[string]*stakePool, error) {
 	type stakePoolPID struct {
 		pid  string
@@ -814,7 +913,7 @@ func (sc *StorageSmartContract) extendAllocation(
 	)
 
 	if req.Extend {
-		alloc.Expiration = common.Timestamp(common.ToTime(txn.CreationDate).Add(conf.TimeUnit).Unix()) // new expiration
+		alloc.Expiration = req.newExpiration(alloc, txn.CreationDate, conf.TimeUnit) // new expiration
 	}
 
 	alloc.Size += req.Size // new size
@@ -844,7 +943,7 @@ func (sc *StorageSmartContract) extendAllocation(
 		b.Allocated += diff // new capacity used
 
 		// update terms using weighted average
-		details.Terms = b.Terms
+		details.Terms = alloc.assuranceTerms(b.Terms)
 		if err != nil {
 			return err
 		}
@@ -1002,6 +1101,11 @@ func (sc *StorageSmartContract) updateAllocationRequestInternal(
 		// update allocation transaction hash
 		alloc.Tx = t.Hash
 
+		tierChanged := request.assuranceTierChanged(alloc)
+		if tierChanged {
+			alloc.setAssuranceTier(*request.AssuranceTier, conf)
+		}
+
 		if len(request.AddBlobberId) > 0 {
 			blobbers, err = alloc.changeBlobbers(
 				conf, blobbers, request.AddBlobberId, request.RemoveBlobberId, t.CreationDate, balances, sc, t.ClientID,
@@ -1018,7 +1122,7 @@ func (sc *StorageSmartContract) updateAllocationRequestInternal(
 
 		// if size or expiration increased, then we use new terms
 		// otherwise, we use the same terms
-		if request.Size > 0 || request.Extend || len(request.AddBlobberId) > 0 {
+		if request.Size > 0 || request.Extend || len(request.AddBlobberId) > 0 || tierChanged {
 			err = sc.extendAllocation(t, conf, alloc, blobbers, &request, balances)
 			if err != nil {
 				return "", err
@@ -0,0 +1,272 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+//msgp:ignore allocationACLRequest
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+// maxAllocationACLEntries limits the number of per-client grants of an
+// allocation, the ACL is stored as part of the allocation in MPT.
+const maxAllocationACLEntries = 100
+
+// maxFileOptions is the FileOptions value with all operations enabled.
+const maxFileOptions = 63
+
+// ACLEntry grants a third-party client the file operations given by Ops,
+// the bits are the same as the ones of StorageAllocation.FileOptions.
+// Zero ExpiresAt means the grant never expires.
+type ACLEntry struct {
+	ClientID  string           `json:"client_id"`
+	Ops       uint16           `json:"ops"`
+	ExpiresAt common.Timestamp `json:"expires_at,omitempty"`
+}
+
+func (e *ACLEntry) isExpired(now common.Timestamp) bool {
+	return e.ExpiresAt != 0 && e.ExpiresAt <= now
+}
+
+func (e *ACLEntry) validate(alloc *StorageAllocation, now common.Timestamp) error {
+	if e.ClientID == "" {
+		return errors.New("missing client_id")
+	}
+	if e.ClientID == alloc.Owner {
+		return errors.New("owner can't be added to the allocation acl")
+	}
+	if e.Ops == 0 || e.Ops > maxFileOptions {
+		return fmt.Errorf("invalid ops %d for client %s", e.Ops, e.ClientID)
+	}
+	if e.isExpired(now) {
+		return fmt.Errorf("expiration %d of client %s is in the past", e.ExpiresAt, e.ClientID)
+	}
+	return nil
+}
+
+func (e *ACLEntry) toEvent(allocID string) event.AllocationACL {
+	return event.AllocationACL{
+		AllocationID: allocID,
+		ClientID:     e.ClientID,
+		Ops:          e.Ops,
+		ExpiresAt:    int64(e.ExpiresAt),
+	}
+}
+
+type allocationACLRequest struct {
+	AllocationID string      `json:"allocation_id"`
+	Entries      []*ACLEntry `json:"entries,omitempty"`
+	ClientIDs    []string    `json:"client_ids,omitempty"`
+}
+
+func (r *allocationACLRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.AllocationID == "" {
+		return errors.New("missing allocation_id")
+	}
+	// the event of the request is a single upsert of all the clients, it
+	// can't affect the same row twice
+	ids := make(map[string]struct{}, len(r.Entries)+len(r.ClientIDs))
+	for _, e := range r.Entries {
+		if _, ok := ids[e.ClientID]; ok {
+			return fmt.Errorf("duplicate client %s", e.ClientID)
+		}
+		ids[e.ClientID] = struct{}{}
+	}
+	for _, id := range r.ClientIDs {
+		if _, ok := ids[id]; ok {
+			return fmt.Errorf("duplicate client %s", id)
+		}
+		ids[id] = struct{}{}
+	}
+	return nil
+}
+
+// aclIndex returns index of the client entry in the allocation ACL or -1.
+func (sa *StorageAllocation) aclIndex(clientID string) int {
+	for i, e := range sa.ACL {
+		if e.ClientID == clientID {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasACLAccess checks whether the client is allowed to perform all given
+// operations, either by the allocation FileOptions or by its ACL entry.
+func (sa *StorageAllocation) HasACLAccess(clientID string, ops uint16, now common.Timestamp) bool {
+	if clientID == sa.Owner || sa.FileOptions&ops == ops {
+		return true
+	}
+	i := sa.aclIndex(clientID)
+	if i < 0 || sa.ACL[i].isExpired(now) {
+		return false
+	}
+	return (sa.FileOptions|sa.ACL[i].Ops)&ops == ops
+}
+
+func (sc *StorageSmartContract) getAllocationForACL(
+	t *transaction.Transaction,
+	req *allocationACLRequest,
+	balances cstate.StateContextI,
+) (*StorageAllocation, error) {
+	alloc, err := sc.getAllocation(req.AllocationID, balances)
+	if err != nil {
+		return nil, fmt.Errorf("can't get allocation: %v", err)
+	}
+	if alloc.Finalized || alloc.Canceled {
+		return nil, errors.New("allocation is finalized")
+	}
+	if alloc.Expiration < t.CreationDate {
+		return nil, errors.New("allocation is expired")
+	}
+	return alloc, nil
+}
+
+// addAllocationACL adds or overwrites per-client grants of an allocation,
+// only the allocation owner can do it.
+func (sc *StorageSmartContract) addAllocationACL(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req allocationACLRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("add_allocation_acl_failed", err.Error())
+	}
+	if len(req.Entries) == 0 {
+		return "", common.NewError("add_allocation_acl_failed", "empty acl entries")
+	}
+
+	alloc, err := sc.getAllocationForACL(t, &req, balances)
+	if err != nil {
+		return "", common.NewError("add_allocation_acl_failed", err.Error())
+	}
+	if alloc.Owner != t.ClientID {
+		return "", common.NewError("add_allocation_acl_failed",
+			"only owner can update the allocation acl")
+	}
+
+	added := make([]event.AllocationACL, 0, len(req.Entries))
+	for _, e := range req.Entries {
+		if err := e.validate(alloc, t.CreationDate); err != nil {
+			return "", common.NewError("add_allocation_acl_failed", err.Error())
+		}
+		if i := alloc.aclIndex(e.ClientID); i >= 0 {
+			alloc.ACL[i] = e
+		} else {
+			alloc.ACL = append(alloc.ACL, e)
+		}
+		added = append(added, e.toEvent(alloc.ID))
+	}
+
+	if len(alloc.ACL) > maxAllocationACLEntries {
+		return "", common.NewErrorf("add_allocation_acl_failed",
+			"too many acl entries %d, max allowed %d", len(alloc.ACL), maxAllocationACLEntries)
+	}
+
+	if _, err := balances.InsertTrieNode(alloc.GetKey(sc.ID), alloc); err != nil {
+		return "", common.NewError("add_allocation_acl_failed",
+			"saving allocation: "+err.Error())
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagAddOrOverwriteAllocationACL, alloc.ID, added)
+	return string(alloc.Encode()), nil
+}
+
+// removeAllocationACL removes the grants of given clients, only the
+// allocation owner can do it.
+func (sc *StorageSmartContract) removeAllocationACL(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req allocationACLRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("remove_allocation_acl_failed", err.Error())
+	}
+	if len(req.ClientIDs) == 0 {
+		return "", common.NewError("remove_allocation_acl_failed", "empty client_ids")
+	}
+
+	alloc, err := sc.getAllocationForACL(t, &req, balances)
+	if err != nil {
+		return "", common.NewError("remove_allocation_acl_failed", err.Error())
+	}
+	if alloc.Owner != t.ClientID {
+		return "", common.NewError("remove_allocation_acl_failed",
+			"only owner can update the allocation acl")
+	}
+
+	removed := make([]event.AllocationACL, 0, len(req.ClientIDs))
+	for _, id := range req.ClientIDs {
+		i := alloc.aclIndex(id)
+		if i < 0 {
+			return "", common.NewErrorf("remove_allocation_acl_failed",
+				"client %s is not in the allocation acl", id)
+		}
+		alloc.ACL = append(alloc.ACL[:i], alloc.ACL[i+1:]...)
+		removed = append(removed, event.AllocationACL{AllocationID: alloc.ID, ClientID: id})
+	}
+
+	if _, err := balances.InsertTrieNode(alloc.GetKey(sc.ID), alloc); err != nil {
+		return "", common.NewError("remove_allocation_acl_failed",
+			"saving allocation: "+err.Error())
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagDeleteAllocationACL, alloc.ID, removed)
+	return string(alloc.Encode()), nil
+}
+
+// expireAllocationACL removes all expired grants of an allocation. Anyone
+// can call it, since it only drops entries that are not valid anymore.
+func (sc *StorageSmartContract) expireAllocationACL(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req allocationACLRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("expire_allocation_acl_failed", err.Error())
+	}
+
+	alloc, err := sc.getAllocation(req.AllocationID, balances)
+	if err != nil {
+		return "", common.NewError("expire_allocation_acl_failed",
+			"can't get allocation: "+err.Error())
+	}
+
+	var (
+		active  = make([]*ACLEntry, 0, len(alloc.ACL))
+		expired []event.AllocationACL
+	)
+	for _, e := range alloc.ACL {
+		if e.isExpired(t.CreationDate) {
+			expired = append(expired, event.AllocationACL{AllocationID: alloc.ID, ClientID: e.ClientID})
+			continue
+		}
+		active = append(active, e)
+	}
+
+	if len(expired) == 0 {
+		return "", common.NewError("expire_allocation_acl_failed",
+			"no expired acl entries")
+	}
+	alloc.ACL = active
+
+	if _, err := balances.InsertTrieNode(alloc.GetKey(sc.ID), alloc); err != nil {
+		return "", common.NewError("expire_allocation_acl_failed",
+			"saving allocation: "+err.Error())
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagDeleteAllocationACL, alloc.ID, expired)
+	return string(alloc.Encode()), nil
+}
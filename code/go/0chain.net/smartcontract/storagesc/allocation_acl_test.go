@@ -0,0 +1,118 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"0chain.net/core/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocationACL(t *testing.T) {
+	const (
+		allocID = "alloc_acl_test"
+		owner   = "owner"
+		other   = "other"
+		now     = common.Timestamp(1000)
+	)
+
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+	)
+	_, err := balances.InsertTrieNode((&StorageAllocation{ID: allocID}).GetKey(ssc.ID), &StorageAllocation{
+		ID:          allocID,
+		Owner:       owner,
+		Expiration:  now + 1000,
+		FileOptions: 1,
+	})
+	require.NoError(t, err)
+
+	call := func(f func([]byte) (string, error), req allocationACLRequest) error {
+		input, err := json.Marshal(&req)
+		require.NoError(t, err)
+		_, err = f(input)
+		return err
+	}
+	add := func(clientID string, req allocationACLRequest, now common.Timestamp) error {
+		txn := newTransaction(clientID, ADDRESS, 0, int64(now))
+		return call(func(in []byte) (string, error) { return ssc.addAllocationACL(txn, in, balances) }, req)
+	}
+	remove := func(clientID string, req allocationACLRequest, now common.Timestamp) error {
+		txn := newTransaction(clientID, ADDRESS, 0, int64(now))
+		return call(func(in []byte) (string, error) { return ssc.removeAllocationACL(txn, in, balances) }, req)
+	}
+	expire := func(clientID string, req allocationACLRequest, now common.Timestamp) error {
+		txn := newTransaction(clientID, ADDRESS, 0, int64(now))
+		return call(func(in []byte) (string, error) { return ssc.expireAllocationACL(txn, in, balances) }, req)
+	}
+
+	entries := []*ACLEntry{
+		{ClientID: other, Ops: 2, ExpiresAt: now + 100},
+		{ClientID: "forever", Ops: 4},
+	}
+
+	t.Run("only owner", func(t *testing.T) {
+		err := add(other, allocationACLRequest{AllocationID: allocID, Entries: entries}, now)
+		require.EqualError(t, err, "add_allocation_acl_failed: only owner can update the allocation acl")
+	})
+
+	t.Run("invalid entries", func(t *testing.T) {
+		err := add(owner, allocationACLRequest{AllocationID: allocID, Entries: []*ACLEntry{{ClientID: other, Ops: 64}}}, now)
+		require.Error(t, err)
+		err = add(owner, allocationACLRequest{AllocationID: allocID, Entries: []*ACLEntry{{ClientID: owner, Ops: 1}}}, now)
+		require.Error(t, err)
+		err = add(owner, allocationACLRequest{AllocationID: allocID, Entries: []*ACLEntry{{ClientID: other, Ops: 1, ExpiresAt: now}}}, now)
+		require.Error(t, err)
+		err = add(owner, allocationACLRequest{AllocationID: allocID, Entries: []*ACLEntry{
+			{ClientID: other, Ops: 1},
+			{ClientID: other, Ops: 2},
+		}}, now)
+		require.EqualError(t, err, "add_allocation_acl_failed: duplicate client other")
+	})
+
+	t.Run("add and check access", func(t *testing.T) {
+		require.NoError(t, add(owner, allocationACLRequest{AllocationID: allocID, Entries: entries}, now))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Len(t, alloc.ACL, 2)
+
+		require.True(t, alloc.HasACLAccess(owner, maxFileOptions, now))
+		require.True(t, alloc.HasACLAccess("anyone", 1, now))
+		require.True(t, alloc.HasACLAccess(other, 3, now))
+		require.False(t, alloc.HasACLAccess(other, 4, now))
+		require.False(t, alloc.HasACLAccess(other, 2, now+100))
+		require.True(t, alloc.HasACLAccess("forever", 4, now+100))
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		err := add(owner, allocationACLRequest{AllocationID: allocID, Entries: []*ACLEntry{{ClientID: other, Ops: 8, ExpiresAt: now + 100}}}, now)
+		require.NoError(t, err)
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Len(t, alloc.ACL, 2)
+		require.False(t, alloc.HasACLAccess(other, 2, now))
+		require.True(t, alloc.HasACLAccess(other, 8, now))
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		err := expire(other, allocationACLRequest{AllocationID: allocID}, now)
+		require.EqualError(t, err, "expire_allocation_acl_failed: no expired acl entries")
+
+		require.NoError(t, expire(other, allocationACLRequest{AllocationID: allocID}, now+100))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Len(t, alloc.ACL, 1)
+		require.Equal(t, "forever", alloc.ACL[0].ClientID)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := remove(owner, allocationACLRequest{AllocationID: allocID, ClientIDs: []string{other}}, now)
+		require.EqualError(t, err, "remove_allocation_acl_failed: client other is not in the allocation acl")
+
+		require.NoError(t, remove(owner, allocationACLRequest{AllocationID: allocID, ClientIDs: []string{"forever"}}, now))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Empty(t, alloc.ACL)
+	})
+}
@@ -0,0 +1,604 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/logging"
+	"github.com/0chain/common/core/util"
+	"go.uber.org/zap"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/partitions"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+//msgp:ignore autoRenewRequest
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+// AutoRenewFundingWritePool funds renewals from the allocation write pool,
+// it's the only funding source for now.
+const AutoRenewFundingWritePool = "write_pool"
+
+const (
+	// autoRenewWindow is how long before the expiration an allocation is
+	// renewed.
+	autoRenewWindow = 1 * time.Hour
+	// maxAutoRenewals limits the number of renewals of a policy.
+	maxAutoRenewals = 100
+	// maxAutoRenewalsPerRound limits the renewals made by a single
+	// blobber_block_rewards transaction, the rest is renewed by next ones.
+	// It's the partition size of the renewal queues as well.
+	maxAutoRenewalsPerRound = 20
+	// autoRenewBucket is the time span of allocations renewals kept in the
+	// same renewal queue.
+	autoRenewBucket = 10 * time.Minute
+	// maxAutoRenewBucketsPerRound limits the renewal queues visited by a
+	// single blobber_block_rewards transaction.
+	maxAutoRenewBucketsPerRound = 50
+)
+
+// Statuses of allocation renewals stored in event DB.
+const (
+	RenewalRenewed = iota
+	RenewalFailed
+)
+
+var autoRenewCursorKey = ADDRESS + encryption.Hash("auto_renew_cursor")
+
+// AutoRenewPolicy extends the allocation by Duration before it expires,
+// at most MaxRenewals times. The policy is removed if a renewal fails.
+type AutoRenewPolicy struct {
+	Duration    time.Duration `json:"duration"`
+	MaxRenewals int           `json:"max_renewals"`
+	Renewals    int           `json:"renewals"`
+	Funding     string        `json:"funding"`
+}
+
+func (p *AutoRenewPolicy) exhausted() bool {
+	return p.Renewals >= p.MaxRenewals
+}
+
+// renewAt is the time the allocation with the given expiration is due for
+// the renewal, it's renewed once the bucket of the time is passed
+func renewAt(expiration common.Timestamp) common.Timestamp {
+	return expiration - toSeconds(autoRenewWindow)
+}
+
+type autoRenewRequest struct {
+	AllocationID string        `json:"allocation_id"`
+	Duration     time.Duration `json:"duration"`
+	MaxRenewals  int           `json:"max_renewals"`
+	Funding      string        `json:"funding"`
+}
+
+func (r *autoRenewRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.AllocationID == "" {
+		return errors.New("missing allocation_id")
+	}
+	if r.Funding == "" {
+		r.Funding = AutoRenewFundingWritePool
+	}
+	return nil
+}
+
+// isDisable is true for requests turning the auto-renewal off
+func (r *autoRenewRequest) isDisable() bool {
+	return r.Duration == 0 && r.MaxRenewals == 0
+}
+
+func (r *autoRenewRequest) validate() error {
+	switch {
+	case r.Duration <= autoRenewWindow:
+		return fmt.Errorf("duration must be longer than the renewal window %v", autoRenewWindow)
+	case r.MaxRenewals <= 0:
+		return errors.New("max_renewals must be positive")
+	case r.MaxRenewals > maxAutoRenewals:
+		return fmt.Errorf("max_renewals can't be greater than %d", maxAutoRenewals)
+	case r.Funding != AutoRenewFundingWritePool:
+		return fmt.Errorf("unsupported funding source %q", r.Funding)
+	}
+	return nil
+}
+
+//
+// renewal queues
+//
+
+// renewalItem is an allocation waiting for the renewal
+type renewalItem struct {
+	AllocationID string `json:"allocation_id"`
+}
+
+func (it *renewalItem) GetID() string {
+	return it.AllocationID
+}
+
+// renewalBucket is the renewal queue the allocation renewed at the given
+// time is added to
+func renewalBucket(at common.Timestamp) int64 {
+	return int64(at) / int64(toSeconds(autoRenewBucket))
+}
+
+// renewalQueueKey is the key of the partitions of allocations renewed in
+// the bucket
+func renewalQueueKey(bucket int64) datastore.Key {
+	return ADDRESS + encryption.Hash("auto_renew_queue:"+strconv.FormatInt(bucket, 10))
+}
+
+// renewalCursor is the next bucket of the renewal queues to renew
+// allocations from, buckets before it are empty.
+type renewalCursor struct {
+	Bucket int64 `json:"bucket"`
+}
+
+func getRenewalCursor(balances cstate.CommonStateContextI) (*renewalCursor, error) {
+	c := new(renewalCursor)
+	if err := balances.GetTrieNode(autoRenewCursorKey, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *renewalCursor) save(balances cstate.StateContextI) error {
+	_, err := balances.InsertTrieNode(autoRenewCursorKey, c)
+	return err
+}
+
+// queueRenewal adds the allocation to the renewal queue of its renewal
+// time, but not before the given bucket. It's no-op for the allocation
+// already in the queue.
+func queueRenewal(alloc *StorageAllocation, minBucket int64, balances cstate.StateContextI) error {
+	bucket := renewalBucket(renewAt(alloc.Expiration))
+	if bucket < minBucket {
+		bucket = minBucket
+	}
+	q, err := partitions.CreateIfNotExists(balances, renewalQueueKey(bucket), maxAutoRenewalsPerRound)
+	if err != nil {
+		return err
+	}
+	err = q.Add(balances, &renewalItem{AllocationID: alloc.ID})
+	switch {
+	case err == nil:
+	case partitions.ErrItemExist(err):
+		return nil
+	default:
+		return err
+	}
+	return q.Save(balances)
+}
+
+//
+// renewal
+//
+
+// checkRenewal checks the allocation extended to the given expiration by
+// current terms of its blobbers can be funded by the write pool, and the
+// stake pools and the challenge pool take the changes. Nothing is changed,
+// the check uses the same calculation as the extendAllocation does, so the
+// renewal passed the check doesn't fail half way.
+func (sc *StorageSmartContract) checkRenewal(
+	sa *StorageAllocation,
+	blobbers []*StorageNode,
+	expiration, now common.Timestamp,
+	conf *Config,
+	balances cstate.StateContextI,
+) error {
+	var (
+		renewed = *sa
+		oterms  = make([]Terms, 0, len(sa.BlobberAllocs))
+		gbSize  = sizeInGB(sa.BlobberAllocs[0].Size)
+	)
+	renewed.Expiration = expiration
+	renewed.BlobberAllocs = make([]*BlobberAllocation, 0, len(sa.BlobberAllocs))
+
+	rdtu, err := renewed.restDurationInTimeUnits(renewed.StartTime, conf.TimeUnit)
+	if err != nil {
+		return err
+	}
+
+	for i, ba := range sa.BlobberAllocs {
+		b := blobbers[i]
+		if b.ID != ba.BlobberID {
+			return fmt.Errorf("blobber %s and %s don't match", b.ID, ba.BlobberID)
+		}
+		if b.Capacity == 0 {
+			return fmt.Errorf("blobber %s no longer provides its service", b.ID)
+		}
+
+		details := *ba
+		oterms = append(oterms, ba.Terms)
+		details.Terms = sa.assuranceTerms(b.Terms)
+		mld, err := details.Terms.minLockDemand(gbSize, rdtu, sa.MinLockDemand)
+		if err != nil {
+			return err
+		}
+		if mld > details.MinLockDemand {
+			details.MinLockDemand = mld
+		}
+		if err := sc.checkOfferChange(ba, &details, balances); err != nil {
+			return err
+		}
+		renewed.BlobberAllocs = append(renewed.BlobberAllocs, &details)
+	}
+
+	changes, err := renewed.challengePoolChanges(sa.Expiration-now, expiration-now,
+		conf.TimeUnit, oterms)
+	if err != nil {
+		return err
+	}
+	cp, err := sc.getChallengePool(sa.ID, balances)
+	if err != nil {
+		return fmt.Errorf("can't get challenge pool: %v", err)
+	}
+	for _, ch := range changes {
+		if ch.isNegative {
+			if ch.Value > cp.Balance {
+				return fmt.Errorf("insufficient funds %v in challenge pool to move back %v",
+					cp.Balance, ch.Value)
+			}
+			cp.Balance -= ch.Value
+			renewed.WritePool += ch.Value
+			continue
+		}
+		if ch.Value > renewed.WritePool {
+			return fmt.Errorf("insufficient funds %v in write pool to pay %v",
+				renewed.WritePool, ch.Value)
+		}
+		cp.Balance += ch.Value
+		renewed.WritePool -= ch.Value
+	}
+
+	return renewed.checkFunding(conf.CancellationCharge)
+}
+
+// checkOfferChange checks the stake pool of the blobber takes the change of
+// its offer by the renewed terms, like the extendAllocation makes it
+func (sc *StorageSmartContract) checkOfferChange(
+	ba, renewed *BlobberAllocation,
+	balances cstate.StateContextI,
+) error {
+	oldOffer, newOffer := ba.Offer(), renewed.Offer()
+	if oldOffer == newOffer {
+		return nil
+	}
+	sp, err := sc.getStakePool(spenum.Blobber, ba.BlobberID, balances)
+	if err != nil {
+		return fmt.Errorf("can't get stake pool of %s: %v", ba.BlobberID, err)
+	}
+	if newOffer > oldOffer {
+		_, err = currency.AddCoin(sp.TotalOffers, newOffer-oldOffer)
+	} else {
+		_, err = currency.MinusCoin(sp.TotalOffers, oldOffer-newOffer)
+	}
+	if err != nil {
+		return fmt.Errorf("changing offer of %s: %v", ba.BlobberID, err)
+	}
+	return nil
+}
+
+func (sa *StorageAllocation) renewalEvent(t *transaction.Transaction, round int64,
+	oldExpiration common.Timestamp, status int, reason string) event.AllocationRenewal {
+	return event.AllocationRenewal{
+		AllocationID:  sa.ID,
+		TxnHash:       t.Hash,
+		Round:         round,
+		Renewal:       sa.AutoRenew.Renewals,
+		OldExpiration: int64(oldExpiration),
+		NewExpiration: int64(sa.Expiration),
+		WritePool:     sa.WritePool,
+		Status:        status,
+		Reason:        reason,
+	}
+}
+
+// renewAllocation extends the allocation by its auto-renew policy, it returns
+// true if the allocation should be renewed again. The renewal should be
+// checked by the checkRenewal, an error here leaves it partially saved.
+func (sc *StorageSmartContract) renewAllocation(
+	t *transaction.Transaction,
+	conf *Config,
+	alloc *StorageAllocation,
+	blobbers []*StorageNode,
+	balances cstate.StateContextI,
+) (bool, error) {
+	var (
+		policy        = alloc.AutoRenew
+		oldExpiration = alloc.Expiration
+		round         = balances.GetBlock().Round
+	)
+
+	req := &updateAllocationRequest{ID: alloc.ID, Extend: true, extendBy: policy.Duration}
+	if err := sc.extendAllocation(t, conf, alloc, blobbers, req, balances); err != nil {
+		return false, fmt.Errorf("extending allocation: %v", err)
+	}
+	policy.Renewals++
+
+	if err := alloc.saveUpdatedAllocation(blobbers, balances); err != nil {
+		return false, fmt.Errorf("saving allocation: %v", err)
+	}
+	emitAddOrOverwriteAllocationBlobberTerms(alloc, balances, t)
+	balances.EmitEvent(event.TypeStats, event.TagAddAllocationRenewal, alloc.ID,
+		[]event.AllocationRenewal{alloc.renewalEvent(t, round, oldExpiration, RenewalRenewed, "")})
+
+	return !policy.exhausted(), nil
+}
+
+// failRenewal removes the auto-renew policy of the allocation failed to
+// renew. The allocation is reloaded, since the failed check or queueing may
+// leave it changed in memory.
+func (sc *StorageSmartContract) failRenewal(
+	t *transaction.Transaction,
+	allocID string,
+	reason error,
+	balances cstate.StateContextI,
+) {
+	logging.Logger.Info("allocation auto-renewal failed",
+		zap.String("allocation", allocID),
+		zap.Error(reason))
+
+	alloc, err := sc.getAllocation(allocID, balances)
+	if err != nil {
+		logging.Logger.Error("auto-renewal: can't get allocation",
+			zap.String("allocation", allocID),
+			zap.Error(err))
+		return
+	}
+	if alloc.AutoRenew == nil {
+		return
+	}
+	renewal := alloc.renewalEvent(t, balances.GetBlock().Round, alloc.Expiration,
+		RenewalFailed, reason.Error())
+	alloc.AutoRenew = nil
+	if _, err := balances.InsertTrieNode(alloc.GetKey(sc.ID), alloc); err != nil {
+		logging.Logger.Error("auto-renewal: can't save allocation",
+			zap.String("allocation", allocID),
+			zap.Error(err))
+		return
+	}
+	balances.EmitEvent(event.TypeStats, event.TagUpdateAllocation, alloc.ID, alloc.buildDbUpdates())
+	balances.EmitEvent(event.TypeStats, event.TagAddAllocationRenewal, alloc.ID,
+		[]event.AllocationRenewal{renewal})
+}
+
+// renewQueued renews the allocation taken from the renewal queue of the
+// bucket. An allocation not due for the renewal in the bucket, e.g. extended
+// by its owner, is queued again by its expiration. The renewal failed the
+// check loses its policy, while an error returned means the renewal failed
+// half way and the transaction must fail.
+func (sc *StorageSmartContract) renewQueued(
+	t *transaction.Transaction,
+	conf *Config,
+	allocID string,
+	bucket int64,
+	balances cstate.StateContextI,
+) error {
+	alloc, err := sc.getAllocation(allocID, balances)
+	switch err {
+	case nil:
+	case util.ErrValueNotPresent:
+		return nil
+	default:
+		logging.Logger.Error("auto-renewal: can't get allocation",
+			zap.String("allocation", allocID),
+			zap.Error(err))
+		return nil
+	}
+
+	if alloc.AutoRenew == nil || alloc.AutoRenew.exhausted() ||
+		alloc.Finalized || alloc.Canceled || alloc.Expiration < t.CreationDate {
+		return nil
+	}
+
+	again := true
+	if renewalBucket(renewAt(alloc.Expiration)) <= bucket {
+		expiration := alloc.Expiration + toSeconds(alloc.AutoRenew.Duration)
+		blobbers, err := sc.getAllocationBlobbers(alloc, balances)
+		if err == nil {
+			err = sc.checkRenewal(alloc, blobbers, expiration, t.CreationDate, conf, balances)
+		}
+		if err != nil {
+			sc.failRenewal(t, allocID, err, balances)
+			return nil
+		}
+		if again, err = sc.renewAllocation(t, conf, alloc, blobbers, balances); err != nil {
+			return fmt.Errorf("renewing allocation %s: %v", allocID, err)
+		}
+	}
+	if again {
+		if err := queueRenewal(alloc, bucket+1, balances); err != nil {
+			sc.failRenewal(t, allocID, fmt.Errorf("queueing renewal: %v", err), balances)
+		}
+	}
+	return nil
+}
+
+// renewAllocations renews at most maxAutoRenewalsPerRound allocations due
+// for the auto-renewal, it's called by the blobber_block_rewards built-in
+// transaction. The renewal queues are drained in the order of the buckets,
+// a bucket is drained once the time of the bucket is passed. Failures are
+// logged and don't fail the transaction, but a renewal failed half way.
+func (sc *StorageSmartContract) renewAllocations(
+	t *transaction.Transaction,
+	balances cstate.StateContextI,
+) error {
+	cursor, err := getRenewalCursor(balances)
+	if err != nil {
+		if err != util.ErrValueNotPresent {
+			logging.Logger.Error("auto-renewal: can't get renewal cursor", zap.Error(err))
+		}
+		return nil
+	}
+
+	var (
+		conf    *Config
+		renewed int
+		current = renewalBucket(t.CreationDate)
+		start   = cursor.Bucket
+		r       = rand.New(rand.NewSource(balances.GetBlock().RoundRandomSeed))
+	)
+	for i := 0; i < maxAutoRenewBucketsPerRound && cursor.Bucket < current &&
+		renewed < maxAutoRenewalsPerRound; i++ {
+		q, err := partitions.GetPartitions(balances, renewalQueueKey(cursor.Bucket))
+		if err == util.ErrValueNotPresent {
+			cursor.Bucket++
+			continue
+		}
+		if err != nil {
+			logging.Logger.Error("auto-renewal: can't get renewal queue",
+				zap.Int64("bucket", cursor.Bucket),
+				zap.Error(err))
+			break
+		}
+
+		var items []renewalItem
+		if size, _ := q.Size(balances); size > 0 {
+			if err := q.GetRandomItems(balances, r, &items); err != nil {
+				logging.Logger.Error("auto-renewal: can't get renewal queue items",
+					zap.Int64("bucket", cursor.Bucket),
+					zap.Error(err))
+				break
+			}
+		}
+		if len(items) > maxAutoRenewalsPerRound-renewed {
+			items = items[:maxAutoRenewalsPerRound-renewed]
+		}
+
+		if len(items) > 0 && conf == nil {
+			if conf, err = sc.getConfig(balances, true); err != nil {
+				logging.Logger.Error("auto-renewal: can't get SC configurations", zap.Error(err))
+				return nil
+			}
+		}
+		for _, it := range items {
+			if err := q.Remove(balances, it.AllocationID); err != nil {
+				logging.Logger.Error("auto-renewal: can't remove allocation from renewal queue",
+					zap.String("allocation", it.AllocationID),
+					zap.Error(err))
+				return nil
+			}
+			if err := sc.renewQueued(t, conf, it.AllocationID, cursor.Bucket, balances); err != nil {
+				return err
+			}
+			renewed++
+		}
+
+		if size, _ := q.Size(balances); size > 0 {
+			if err := q.Save(balances); err != nil {
+				logging.Logger.Error("auto-renewal: saving renewal queue", zap.Error(err))
+				return nil
+			}
+			continue
+		}
+		if _, err := balances.DeleteTrieNode(q.Name); err != nil {
+			logging.Logger.Error("auto-renewal: deleting renewal queue", zap.Error(err))
+			return nil
+		}
+		cursor.Bucket++
+	}
+
+	if cursor.Bucket != start {
+		if err := cursor.save(balances); err != nil {
+			logging.Logger.Error("auto-renewal: saving renewal cursor", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+//
+// SC function
+//
+
+// setAllocationAutoRenew sets or removes the auto-renew policy of an
+// allocation, only the allocation owner can do it. Renewals are funded from
+// the write pool, the owner should keep it topped up.
+func (sc *StorageSmartContract) setAllocationAutoRenew(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req autoRenewRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("allocation_auto_renew_failed",
+			"invalid request: "+err.Error())
+	}
+	if !req.isDisable() {
+		if err := req.validate(); err != nil {
+			return "", common.NewError("allocation_auto_renew_failed",
+				"invalid request: "+err.Error())
+		}
+	}
+
+	alloc, err := sc.getAllocation(req.AllocationID, balances)
+	if err != nil {
+		return "", common.NewError("allocation_auto_renew_failed",
+			"can't get allocation: "+err.Error())
+	}
+	if alloc.Owner != t.ClientID {
+		return "", common.NewError("allocation_auto_renew_failed",
+			"only owner can set the allocation auto-renewal")
+	}
+	if alloc.Finalized || alloc.Canceled {
+		return "", common.NewError("allocation_auto_renew_failed",
+			"allocation is finalized")
+	}
+	if alloc.Expiration < t.CreationDate {
+		return "", common.NewError("allocation_auto_renew_failed",
+			"allocation is expired")
+	}
+
+	cursor, err := getRenewalCursor(balances)
+	switch err {
+	case nil:
+	case util.ErrValueNotPresent:
+		cursor = &renewalCursor{Bucket: renewalBucket(t.CreationDate)}
+	default:
+		return "", common.NewError("allocation_auto_renew_failed",
+			"can't get renewal cursor: "+err.Error())
+	}
+
+	// a disabled policy leaves the allocation in its renewal queue, it's
+	// skipped by the renewal
+	if req.isDisable() {
+		if alloc.AutoRenew == nil {
+			return "", common.NewError("allocation_auto_renew_failed",
+				"allocation has no auto-renewal")
+		}
+		alloc.AutoRenew = nil
+	} else {
+		alloc.AutoRenew = &AutoRenewPolicy{
+			Duration:    req.Duration,
+			MaxRenewals: req.MaxRenewals,
+			Funding:     req.Funding,
+		}
+		if err := queueRenewal(alloc, cursor.Bucket, balances); err != nil {
+			return "", common.NewError("allocation_auto_renew_failed",
+				"queueing renewal: "+err.Error())
+		}
+		if err := cursor.save(balances); err != nil {
+			return "", common.NewError("allocation_auto_renew_failed",
+				"saving renewal cursor: "+err.Error())
+		}
+	}
+
+	if _, err := balances.InsertTrieNode(alloc.GetKey(sc.ID), alloc); err != nil {
+		return "", common.NewError("allocation_auto_renew_failed",
+			"saving allocation: "+err.Error())
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagUpdateAllocation, alloc.ID, alloc.buildDbUpdates())
+	return string(alloc.Encode()), nil
+}
@@ -0,0 +1,243 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/partitions"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueRenewal(t *testing.T) {
+	var (
+		balances = newTestBalances(t, false)
+		alloc    = &StorageAllocation{ID: "alloc", Expiration: 10 * toSeconds(autoRenewBucket)}
+		bucket   = renewalBucket(renewAt(alloc.Expiration))
+	)
+	inQueue := func(bucket int64) bool {
+		q, err := partitions.GetPartitions(balances, renewalQueueKey(bucket))
+		if err == util.ErrValueNotPresent {
+			return false
+		}
+		require.NoError(t, err)
+		ok, err := q.Exist(balances, alloc.ID)
+		require.NoError(t, err)
+		return ok
+	}
+
+	require.NoError(t, queueRenewal(alloc, 0, balances))
+	require.True(t, inQueue(bucket))
+	// no duplicates
+	require.NoError(t, queueRenewal(alloc, 0, balances))
+	q, err := partitions.GetPartitions(balances, renewalQueueKey(bucket))
+	require.NoError(t, err)
+	size, err := q.Size(balances)
+	require.NoError(t, err)
+	require.Equal(t, 1, size)
+
+	// not before the given bucket
+	require.NoError(t, queueRenewal(alloc, bucket+2, balances))
+	require.True(t, inQueue(bucket+2))
+}
+
+func TestAllocationAutoRenew(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		client   = newClient(1000*x10, balances)
+		now      = int64(1000)
+	)
+	allocID, _ := addAllocation(t, ssc, client, now, 0, balances)
+
+	setAutoRenew := func(clientID string, req autoRenewRequest) error {
+		input, err := json.Marshal(&req)
+		require.NoError(t, err)
+		txn := newTransaction(clientID, ADDRESS, 0, now)
+		_, err = ssc.setAllocationAutoRenew(txn, input, balances)
+		return err
+	}
+	// renew drains the renewal queues till the time, the renewal due at the
+	// time is made in the next bucket
+	renew := func(at common.Timestamp) {
+		txn := newTransaction(ADDRESS, ADDRESS, 0, int64(at))
+		for i := 0; i < 1000; i++ {
+			require.NoError(t, ssc.renewAllocations(txn, balances))
+			cursor, err := getRenewalCursor(balances)
+			require.NoError(t, err)
+			if cursor.Bucket >= renewalBucket(at) {
+				return
+			}
+		}
+		require.Fail(t, "renewal queues are not drained")
+	}
+	due := func(expiration common.Timestamp) common.Timestamp {
+		return renewAt(expiration) + toSeconds(autoRenewBucket)
+	}
+	inQueue := func(alloc *StorageAllocation) bool {
+		q, err := partitions.GetPartitions(balances, renewalQueueKey(renewalBucket(renewAt(alloc.Expiration))))
+		if err == util.ErrValueNotPresent {
+			return false
+		}
+		require.NoError(t, err)
+		ok, err := q.Exist(balances, alloc.ID)
+		require.NoError(t, err)
+		return ok
+	}
+	policy := autoRenewRequest{
+		AllocationID: allocID,
+		Duration:     24 * time.Hour,
+		MaxRenewals:  2,
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		err := setAutoRenew("other", policy)
+		require.EqualError(t, err, "allocation_auto_renew_failed: only owner can set the allocation auto-renewal")
+
+		req := policy
+		req.Duration = autoRenewWindow
+		err = setAutoRenew(client.id, req)
+		require.EqualError(t, err, "allocation_auto_renew_failed: invalid request: duration must be longer than the renewal window 1h0m0s")
+
+		req = policy
+		req.MaxRenewals = maxAutoRenewals + 1
+		err = setAutoRenew(client.id, req)
+		require.EqualError(t, err, "allocation_auto_renew_failed: invalid request: max_renewals can't be greater than 100")
+
+		req = policy
+		req.Funding = "read_pool"
+		err = setAutoRenew(client.id, req)
+		require.EqualError(t, err, `allocation_auto_renew_failed: invalid request: unsupported funding source "read_pool"`)
+
+		err = setAutoRenew(client.id, autoRenewRequest{AllocationID: allocID})
+		require.EqualError(t, err, "allocation_auto_renew_failed: allocation has no auto-renewal")
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		require.NoError(t, setAutoRenew(client.id, policy))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, AutoRenewFundingWritePool, alloc.AutoRenew.Funding)
+		expiration := alloc.Expiration
+
+		require.True(t, inQueue(alloc))
+
+		// not due yet
+		renew(renewAt(expiration))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, expiration, alloc.Expiration)
+
+		renew(due(expiration))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, expiration+toSeconds(policy.Duration), alloc.Expiration)
+		require.Equal(t, 1, alloc.AutoRenew.Renewals)
+		require.True(t, inQueue(alloc))
+
+		// the last renewal doesn't queue the allocation again
+		expiration = alloc.Expiration
+		renew(due(expiration))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, 2, alloc.AutoRenew.Renewals)
+		require.False(t, inQueue(alloc))
+	})
+
+	t.Run("extended by owner", func(t *testing.T) {
+		require.NoError(t, setAutoRenew(client.id, policy))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		expiration := alloc.Expiration
+		alloc.Expiration += 10 * toSeconds(autoRenewBucket)
+		_, err = balances.InsertTrieNode(alloc.GetKey(ssc.ID), alloc)
+		require.NoError(t, err)
+
+		// queued again by the new expiration
+		renew(due(expiration))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, expiration+10*toSeconds(autoRenewBucket), alloc.Expiration)
+		require.Zero(t, alloc.AutoRenew.Renewals)
+		require.True(t, inQueue(alloc))
+
+		renew(due(alloc.Expiration))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, 1, alloc.AutoRenew.Renewals)
+	})
+
+	t.Run("insufficient write pool", func(t *testing.T) {
+		require.NoError(t, setAutoRenew(client.id, policy))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		alloc.WritePool = 0
+		_, err = balances.InsertTrieNode(alloc.GetKey(ssc.ID), alloc)
+		require.NoError(t, err)
+
+		// the failure doesn't fail the transaction and removes the policy
+		expiration := alloc.Expiration
+		renew(due(expiration))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, expiration, alloc.Expiration)
+		require.Nil(t, alloc.AutoRenew)
+		require.False(t, inQueue(alloc))
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		require.NoError(t, setAutoRenew(client.id, policy))
+		require.NoError(t, setAutoRenew(client.id, autoRenewRequest{AllocationID: allocID}))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Nil(t, alloc.AutoRenew)
+
+		// the allocation left in the queue is skipped
+		expiration := alloc.Expiration
+		renew(due(expiration))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, expiration, alloc.Expiration)
+		require.False(t, inQueue(alloc))
+	})
+
+	t.Run("stake pool missing", func(t *testing.T) {
+		require.NoError(t, setAutoRenew(client.id, policy))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		alloc.WritePool = 100 * x10
+		_, err = balances.InsertTrieNode(alloc.GetKey(ssc.ID), alloc)
+		require.NoError(t, err)
+
+		// new terms change the offers, the stake pool of the last blobber
+		// can't take it
+		blobbers, err := ssc.getAllocationBlobbers(alloc, balances)
+		require.NoError(t, err)
+		for _, b := range blobbers {
+			b.Terms.WritePrice += x10
+			_, err = balances.InsertTrieNode(b.GetKey(), b)
+			require.NoError(t, err)
+		}
+		first, err := ssc.getStakePool(spenum.Blobber, blobbers[0].ID, balances)
+		require.NoError(t, err)
+		last := blobbers[len(blobbers)-1].ID
+		_, err = balances.DeleteTrieNode(stakePoolKey(spenum.Blobber, last))
+		require.NoError(t, err)
+
+		// the renewal fails before any change, the allocation is queued
+		// after the drained buckets
+		expiration := alloc.Expiration
+		renew(due(expiration) + toSeconds(autoRenewBucket))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, expiration, alloc.Expiration)
+		require.Nil(t, alloc.AutoRenew)
+
+		sp, err := ssc.getStakePool(spenum.Blobber, blobbers[0].ID, balances)
+		require.NoError(t, err)
+		require.Equal(t, first.TotalOffers, sp.TotalOffers)
+	})
+}
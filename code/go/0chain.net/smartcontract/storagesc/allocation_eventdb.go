@@ -116,6 +116,16 @@ func allocationTableToStorageAllocationBlobbers(alloc *event.Allocation, eventDb
 		MovedToValidators: alloc.MovedToValidators,
 		TimeUnit:          time.Duration(alloc.TimeUnit),
 		MinLockDemand:     alloc.MinLockDemand,
+		AssuranceTier:     alloc.AssuranceTier,
+		AssurancePrice:    alloc.AssurancePrice,
+	}
+	if alloc.AutoRenewDuration > 0 {
+		sa.AutoRenew = &AutoRenewPolicy{
+			Duration:    time.Duration(alloc.AutoRenewDuration),
+			MaxRenewals: alloc.AutoRenewMaxRenewals,
+			Renewals:    alloc.AutoRenewals,
+			Funding:     AutoRenewFundingWritePool,
+		}
 	}
 
 	return &StorageAllocationBlobbers{
@@ -151,6 +161,8 @@ func storageAllocationToAllocationTable(sa *StorageAllocation) *event.Allocation
 		ThirdPartyExtendable: sa.ThirdPartyExtendable,
 		FileOptions:          sa.FileOptions,
 		MinLockDemand:        sa.MinLockDemand,
+		AssuranceTier:        sa.AssuranceTier,
+		AssurancePrice:       sa.AssurancePrice,
 	}
 
 	if sa.Stats != nil {
@@ -162,10 +174,22 @@ func storageAllocationToAllocationTable(sa *StorageAllocation) *event.Allocation
 		alloc.FailedChallenges = sa.Stats.FailedChallenges
 		alloc.LatestClosedChallengeTxn = sa.Stats.LastestClosedChallengeTxn
 	}
+	sa.setEventAutoRenew(alloc)
 
 	return alloc
 }
 
+// setEventAutoRenew sets the auto-renew policy columns of the allocation
+// table, they are zeroed if the allocation has no policy
+func (sa *StorageAllocation) setEventAutoRenew(alloc *event.Allocation) {
+	if sa.AutoRenew == nil {
+		return
+	}
+	alloc.AutoRenewDuration = int64(sa.AutoRenew.Duration)
+	alloc.AutoRenewMaxRenewals = sa.AutoRenew.MaxRenewals
+	alloc.AutoRenewals = sa.AutoRenew.Renewals
+}
+
 func (sa *StorageAllocation) buildEventBlobberTerms() []event.AllocationBlobberTerm {
 	bTerms := make([]event.AllocationBlobberTerm, 0, len(sa.BlobberAllocs))
 	for i, b := range sa.BlobberAllocs {
@@ -206,6 +230,8 @@ func (sa *StorageAllocation) buildDbUpdates() event.Allocation {
 		WritePool:            sa.WritePool,
 		ThirdPartyExtendable: sa.ThirdPartyExtendable,
 		FileOptions:          sa.FileOptions,
+		AssuranceTier:        sa.AssuranceTier,
+		AssurancePrice:       sa.AssurancePrice,
 	}
 
 	if sa.Stats != nil {
@@ -217,6 +243,7 @@ func (sa *StorageAllocation) buildDbUpdates() event.Allocation {
 		eAlloc.FailedChallenges = sa.Stats.FailedChallenges
 		eAlloc.LatestClosedChallengeTxn = sa.Stats.LastestClosedChallengeTxn
 	}
+	sa.setEventAutoRenew(&eAlloc)
 
 	return eAlloc
 }
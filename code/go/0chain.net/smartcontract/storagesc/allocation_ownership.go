@@ -0,0 +1,233 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+//msgp:ignore ownershipOfferRequest ownershipAcceptRequest
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+// OwnershipOffer is made by the allocation owner to hand the allocation to
+// another client. Zero ExpiresAt means the offer never expires.
+type OwnershipOffer struct {
+	NewOwnerID string           `json:"new_owner_id"`
+	ExpiresAt  common.Timestamp `json:"expires_at,omitempty"`
+}
+
+func (o *OwnershipOffer) isExpired(now common.Timestamp) bool {
+	return o.ExpiresAt != 0 && o.ExpiresAt <= now
+}
+
+// ownershipMessage is signed by the new owner accepting the allocation
+func ownershipMessage(allocID, from, to string) string {
+	return fmt.Sprintf("%s:owner:%s:%s", allocID, from, to)
+}
+
+// ownershipOfferRequest offers the allocation to the new owner, empty
+// NewOwnerID withdraws the pending offer
+type ownershipOfferRequest struct {
+	AllocationID string           `json:"allocation_id"`
+	NewOwnerID   string           `json:"new_owner_id"`
+	ExpiresAt    common.Timestamp `json:"expires_at,omitempty"`
+}
+
+func (r *ownershipOfferRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.AllocationID == "" {
+		return errors.New("missing allocation_id")
+	}
+	return nil
+}
+
+type ownershipAcceptRequest struct {
+	AllocationID string `json:"allocation_id"`
+	PublicKey    string `json:"public_key"` // of the new owner
+	Signature    string `json:"signature"`  // of the ownership message hash
+}
+
+func (r *ownershipAcceptRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.AllocationID == "" {
+		return errors.New("missing allocation_id")
+	}
+	return nil
+}
+
+// verify the request is signed by the client with the public key
+func (r *ownershipAcceptRequest) verify(clientID, message string,
+	balances cstate.StateContextI) error {
+
+	id, err := encryption.GetClientIDFromPublicKey(r.PublicKey)
+	if err != nil || id != clientID {
+		return errors.New("public key doesn't match the client id")
+	}
+
+	scheme := balances.GetSignatureScheme()
+	if err := scheme.SetPublicKey(r.PublicKey); err != nil {
+		return fmt.Errorf("invalid public key: %v", err)
+	}
+	ok, err := scheme.Verify(r.Signature, encryption.Hash(message))
+	if err != nil || !ok {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// offerAllocationOwnership offers the allocation to another client or
+// withdraws the pending offer, only the allocation owner can do it.
+func (sc *StorageSmartContract) offerAllocationOwnership(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req ownershipOfferRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("offer_allocation_ownership_failed",
+			"invalid request: "+err.Error())
+	}
+
+	alloc, err := sc.getAllocation(req.AllocationID, balances)
+	if err != nil {
+		return "", common.NewError("offer_allocation_ownership_failed",
+			"can't get allocation: "+err.Error())
+	}
+	if alloc.Owner != t.ClientID {
+		return "", common.NewError("offer_allocation_ownership_failed",
+			"only owner can offer the allocation")
+	}
+
+	switch {
+	case req.NewOwnerID == "":
+		if alloc.OwnershipOffer == nil {
+			return "", common.NewError("offer_allocation_ownership_failed",
+				"no ownership offer to withdraw")
+		}
+		alloc.OwnershipOffer = nil
+	case alloc.Finalized || alloc.Canceled:
+		return "", common.NewError("offer_allocation_ownership_failed",
+			"allocation is finalized")
+	case alloc.Expiration < t.CreationDate:
+		return "", common.NewError("offer_allocation_ownership_failed",
+			"allocation is expired")
+	case req.NewOwnerID == alloc.Owner:
+		return "", common.NewError("offer_allocation_ownership_failed",
+			"the client already owns the allocation")
+	case req.ExpiresAt != 0 && req.ExpiresAt <= t.CreationDate:
+		return "", common.NewError("offer_allocation_ownership_failed",
+			"offer expiration is in the past")
+	default:
+		alloc.OwnershipOffer = &OwnershipOffer{
+			NewOwnerID: req.NewOwnerID,
+			ExpiresAt:  req.ExpiresAt,
+		}
+	}
+
+	if _, err := balances.InsertTrieNode(alloc.GetKey(sc.ID), alloc); err != nil {
+		return "", common.NewError("offer_allocation_ownership_failed",
+			"saving allocation: "+err.Error())
+	}
+	return string(alloc.Encode()), nil
+}
+
+// acceptAllocationOwnership completes the pending transfer, the new owner
+// signs it by the key blobbers are going to verify its requests with. The
+// write pool goes to the new owner with the allocation.
+func (sc *StorageSmartContract) acceptAllocationOwnership(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req ownershipAcceptRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("accept_allocation_ownership_failed",
+			"invalid request: "+err.Error())
+	}
+
+	alloc, err := sc.getAllocation(req.AllocationID, balances)
+	if err != nil {
+		return "", common.NewError("accept_allocation_ownership_failed",
+			"can't get allocation: "+err.Error())
+	}
+
+	offer := alloc.OwnershipOffer
+	switch {
+	case offer == nil || offer.NewOwnerID != t.ClientID:
+		return "", common.NewError("accept_allocation_ownership_failed",
+			"the allocation is not offered to the client")
+	case offer.isExpired(t.CreationDate):
+		return "", common.NewError("accept_allocation_ownership_failed",
+			"ownership offer is expired")
+	case alloc.Finalized || alloc.Canceled:
+		return "", common.NewError("accept_allocation_ownership_failed",
+			"allocation is finalized")
+	case alloc.Expiration < t.CreationDate:
+		return "", common.NewError("accept_allocation_ownership_failed",
+			"allocation is expired")
+	}
+
+	from := alloc.Owner
+	err = req.verify(t.ClientID, ownershipMessage(alloc.ID, from, t.ClientID), balances)
+	if err != nil {
+		return "", common.NewError("accept_allocation_ownership_failed", err.Error())
+	}
+
+	alloc.Owner = t.ClientID
+	alloc.OwnerPublicKey = req.PublicKey
+	alloc.OwnershipOffer = nil
+
+	// the owner has all the access, drop its grant
+	var removedACL []event.AllocationACL
+	if i := alloc.aclIndex(t.ClientID); i >= 0 {
+		alloc.ACL = append(alloc.ACL[:i], alloc.ACL[i+1:]...)
+		removedACL = append(removedACL, event.AllocationACL{AllocationID: alloc.ID, ClientID: t.ClientID})
+	}
+
+	if _, err := balances.InsertTrieNode(alloc.GetKey(sc.ID), alloc); err != nil {
+		return "", common.NewError("accept_allocation_ownership_failed",
+			"saving allocation: "+err.Error())
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagUpdateAllocation, alloc.ID, alloc.buildDbUpdates())
+	if len(removedACL) > 0 {
+		balances.EmitEvent(event.TypeStats, event.TagDeleteAllocationACL, alloc.ID, removedACL)
+	}
+	if alloc.WritePool > 0 {
+		i, err := alloc.WritePool.Int64()
+		if err != nil {
+			return "", common.NewError("accept_allocation_ownership_failed", err.Error())
+		}
+		balances.EmitEvent(event.TypeStats, event.TagUnlockWritePool, alloc.ID, event.WritePoolLock{
+			Client:       from,
+			AllocationId: alloc.ID,
+			Amount:       i,
+		})
+		balances.EmitEvent(event.TypeStats, event.TagLockWritePool, alloc.ID, event.WritePoolLock{
+			Client:       t.ClientID,
+			AllocationId: alloc.ID,
+			Amount:       i,
+		})
+	}
+	balances.EmitEvent(event.TypeStats, event.TagTransferAllocationOwnership, alloc.ID,
+		[]event.AllocationOwnershipTransfer{{
+			AllocationID:   alloc.ID,
+			TxnHash:        t.Hash,
+			Round:          balances.GetBlock().Round,
+			FromClientID:   from,
+			ToClientID:     t.ClientID,
+			OwnerPublicKey: req.PublicKey,
+		}})
+
+	return string(alloc.Encode()), nil
+}
@@ -0,0 +1,105 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocationOwnershipTransfer(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		owner    = newClient(1000*x10, balances)
+		newOwner = newClient(0, balances)
+		now      = int64(1000)
+	)
+	allocID, _ := addAllocation(t, ssc, owner, now, 0, balances)
+
+	offer := func(clientID string, req ownershipOfferRequest) error {
+		input, err := json.Marshal(&req)
+		require.NoError(t, err)
+		txn := newTransaction(clientID, ADDRESS, 0, now)
+		_, err = ssc.offerAllocationOwnership(txn, input, balances)
+		return err
+	}
+	accept := func(c *Client, from string, at int64) error {
+		sig, err := c.scheme.Sign(encryption.Hash(ownershipMessage(allocID, from, c.id)))
+		require.NoError(t, err)
+		input, err := json.Marshal(&ownershipAcceptRequest{
+			AllocationID: allocID,
+			PublicKey:    c.pk,
+			Signature:    sig,
+		})
+		require.NoError(t, err)
+		txn := newTransaction(c.id, ADDRESS, 0, at)
+		_, err = ssc.acceptAllocationOwnership(txn, input, balances)
+		return err
+	}
+
+	t.Run("offer", func(t *testing.T) {
+		err := offer(newOwner.id, ownershipOfferRequest{AllocationID: allocID, NewOwnerID: newOwner.id})
+		require.EqualError(t, err, "offer_allocation_ownership_failed: only owner can offer the allocation")
+
+		err = offer(owner.id, ownershipOfferRequest{AllocationID: allocID})
+		require.EqualError(t, err, "offer_allocation_ownership_failed: no ownership offer to withdraw")
+
+		err = offer(owner.id, ownershipOfferRequest{AllocationID: allocID, NewOwnerID: owner.id})
+		require.EqualError(t, err, "offer_allocation_ownership_failed: the client already owns the allocation")
+
+		err = offer(owner.id, ownershipOfferRequest{AllocationID: allocID, NewOwnerID: newOwner.id, ExpiresAt: common.Timestamp(now)})
+		require.EqualError(t, err, "offer_allocation_ownership_failed: offer expiration is in the past")
+	})
+
+	t.Run("accept not offered", func(t *testing.T) {
+		err := accept(newOwner, owner.id, now)
+		require.EqualError(t, err, "accept_allocation_ownership_failed: the allocation is not offered to the client")
+	})
+
+	t.Run("expired offer", func(t *testing.T) {
+		require.NoError(t, offer(owner.id, ownershipOfferRequest{
+			AllocationID: allocID,
+			NewOwnerID:   newOwner.id,
+			ExpiresAt:    common.Timestamp(now + 10),
+		}))
+		err := accept(newOwner, owner.id, now+10)
+		require.EqualError(t, err, "accept_allocation_ownership_failed: ownership offer is expired")
+	})
+
+	t.Run("withdraw", func(t *testing.T) {
+		require.NoError(t, offer(owner.id, ownershipOfferRequest{AllocationID: allocID}))
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Nil(t, alloc.OwnershipOffer)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		require.NoError(t, offer(owner.id, ownershipOfferRequest{AllocationID: allocID, NewOwnerID: newOwner.id}))
+		// signed with a wrong previous owner
+		err := accept(newOwner, newOwner.id, now)
+		require.EqualError(t, err, "accept_allocation_ownership_failed: invalid signature")
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		alloc, err := ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		alloc.ACL = []*ACLEntry{{ClientID: newOwner.id, Ops: 1}, {ClientID: "other", Ops: 1}}
+		_, err = balances.InsertTrieNode(alloc.GetKey(ssc.ID), alloc)
+		require.NoError(t, err)
+
+		require.NoError(t, accept(newOwner, owner.id, now))
+		alloc, err = ssc.getAllocation(allocID, balances)
+		require.NoError(t, err)
+		require.Equal(t, newOwner.id, alloc.Owner)
+		require.Equal(t, newOwner.pk, alloc.OwnerPublicKey)
+		require.Nil(t, alloc.OwnershipOffer)
+		require.Equal(t, []*ACLEntry{{ClientID: "other", Ops: 1}}, alloc.ACL)
+
+		// the previous owner lost the allocation
+		err = offer(owner.id, ownershipOfferRequest{AllocationID: allocID, NewOwnerID: owner.id})
+		require.EqualError(t, err, "offer_allocation_ownership_failed: only owner can offer the allocation")
+	})
+}
@@ -0,0 +1,172 @@
+package storagesc
+
+import (
+	"errors"
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	sci "0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/stakepool/spenum"
+
+	common2 "0chain.net/smartcontract/common"
+	"github.com/0chain/common/core/currency"
+)
+
+// blobberQuote is the price of an allocation blobber
+type blobberQuote struct {
+	BlobberID     string        `json:"blobber_id"`
+	BaseURL       string        `json:"url"`
+	Size          int64         `json:"size"` // allocated on the blobber
+	ReadPrice     currency.Coin `json:"read_price"`
+	WritePrice    currency.Coin `json:"write_price"`
+	MinLockDemand currency.Coin `json:"min_lock_demand"`
+	WriteCost     currency.Coin `json:"write_cost"`
+	ChallengePool currency.Coin `json:"challenge_pool"`
+}
+
+// allocationQuote is the price breakdown of a new allocation request
+// swagger:model allocationQuote
+type allocationQuote struct {
+	Blobbers           []blobberQuote   `json:"blobbers"`
+	Size               int64            `json:"size"`
+	Expiration         common.Timestamp `json:"expiration_date"`
+	ReadPrice          currency.Coin    `json:"read_price"` // of a GB read from all the blobbers
+	WriteCost          currency.Coin    `json:"write_cost"`
+	MinLockDemand      currency.Coin    `json:"min_lock_demand"`
+	CancellationCharge currency.Coin    `json:"cancellation_charge"`
+	// ChallengePool is expected to be locked over the full duration of
+	// the allocation if all its size is written.
+	ChallengePool currency.Coin `json:"challenge_pool"`
+	// TotalCost is the least write pool the allocation can be created with.
+	TotalCost currency.Coin `json:"total_cost"`
+	// Deposit of the reservations goes to the write pool, only the rest
+	// of the total cost is to be locked.
+	Deposit currency.Coin `json:"deposit"`
+}
+
+// quoteAllocation runs the new allocation path without saving anything.
+// The blobbers are selected the way alloc_blobbers does if the request has
+// no blobbers. The reservations of the request are of its owner, the
+// blobbers keep their locked terms.
+func quoteAllocation(
+	request newAllocationRequest,
+	conf *Config,
+	balances cstate.TimedQueryStateContextI,
+) (*allocationQuote, error) {
+	if len(request.Blobbers) == 0 {
+		edb := balances.GetEventDB()
+		if edb == nil {
+			return nil, errors.New("no db connection")
+		}
+		ids, err := getBlobbersForRequest(allocationBlobbersRequest{
+			DataShards:      request.DataShards,
+			ParityShards:    request.ParityShards,
+			ReadPriceRange:  request.ReadPriceRange,
+			WritePriceRange: request.WritePriceRange,
+			Size:            request.Size,
+			GeoConstraints:  request.GeoConstraints,
+			MinReputation:   request.MinReputation,
+		}, edb, balances, common2.Pagination{Limit: common2.DefaultQueryLimit}, conf.HealthCheckPeriod)
+		if err != nil {
+			return nil, err
+		}
+		request.Blobbers = ids
+	}
+	if err := request.validate(conf); err != nil {
+		return nil, err
+	}
+
+	blobbers, err := getBlobbersByIDs(request.Blobbers, balances)
+	if err != nil {
+		return nil, fmt.Errorf("get blobbers failed: %v", err)
+	}
+	spMap, err := getStakePoolsByIDs(request.Blobbers, spenum.Blobber, balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting stake pools: %v", err)
+	}
+	if len(spMap) != len(blobbers) {
+		return nil, errors.New("missing blobber's stake pool")
+	}
+	now := balances.Now()
+	var reservations *allocationReservations
+	if len(request.Reservations) > 0 {
+		sc := &StorageSmartContract{SmartContract: sci.NewSC(ADDRESS)}
+		reservations, err = sc.useReservations(request.Owner, now, request.Reservations,
+			blobbers, spMap, balances)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sns, err := storageNodesWithStake(blobbers, spMap)
+	if err != nil {
+		return nil, err
+	}
+
+	sa, nodes, err := setupNewAllocation(request, sns, Timings{}, now, conf, "")
+	if err != nil {
+		return nil, err
+	}
+	rdtu, err := sa.restDurationInTimeUnits(now, conf.TimeUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &allocationQuote{
+		Blobbers:   make([]blobberQuote, 0, len(nodes)),
+		Size:       sa.Size,
+		Expiration: sa.Expiration,
+	}
+	for i, ba := range sa.BlobberAllocs {
+		bq := blobberQuote{
+			BlobberID:     ba.BlobberID,
+			BaseURL:       nodes[i].BaseURL,
+			Size:          ba.Size,
+			ReadPrice:     ba.Terms.ReadPrice,
+			WritePrice:    ba.Terms.WritePrice,
+			MinLockDemand: ba.MinLockDemand,
+		}
+		if bq.WriteCost, err = currency.MultFloat64(ba.Terms.WritePrice, sizeInGB(ba.Size)); err != nil {
+			return nil, err
+		}
+		if bq.ChallengePool, err = currency.MultFloat64(bq.WriteCost, rdtu); err != nil {
+			return nil, err
+		}
+		if err := quote.add(bq); err != nil {
+			return nil, err
+		}
+	}
+
+	if quote.CancellationCharge, err = sa.cancellationCharge(conf.CancellationCharge); err != nil {
+		return nil, err
+	}
+	// see the funding checks of the new allocation
+	quote.TotalCost, err = currency.AddCoin(quote.MinLockDemand, quote.CancellationCharge)
+	if err != nil {
+		return nil, err
+	}
+	if quote.TotalCost < quote.WriteCost {
+		quote.TotalCost = quote.WriteCost
+	}
+	if reservations != nil {
+		if quote.Deposit, err = reservations.deposit(sa); err != nil {
+			return nil, err
+		}
+	}
+	return quote, nil
+}
+
+func (q *allocationQuote) add(bq blobberQuote) (err error) {
+	q.Blobbers = append(q.Blobbers, bq)
+	if q.ReadPrice, err = currency.AddCoin(q.ReadPrice, bq.ReadPrice); err != nil {
+		return
+	}
+	if q.WriteCost, err = currency.AddCoin(q.WriteCost, bq.WriteCost); err != nil {
+		return
+	}
+	if q.MinLockDemand, err = currency.AddCoin(q.MinLockDemand, bq.MinLockDemand); err != nil {
+		return
+	}
+	q.ChallengePool, err = currency.AddCoin(q.ChallengePool, bq.ChallengePool)
+	return
+}
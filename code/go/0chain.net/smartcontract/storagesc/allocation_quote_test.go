@@ -0,0 +1,109 @@
+package storagesc
+
+import (
+	"testing"
+
+	"0chain.net/core/common"
+	"github.com/stretchr/testify/require"
+)
+
+type timedTestBalances struct {
+	*testBalances
+	now common.Timestamp
+}
+
+func (tb timedTestBalances) Now() common.Timestamp { return tb.now }
+
+func TestQuoteAllocation(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		client   = newClient(1000*x10, balances)
+		now      = int64(1000)
+		conf     = setConfig(t, balances)
+	)
+
+	nar := &newAllocationRequest{
+		DataShards:      2,
+		ParityShards:    2,
+		Owner:           client.id,
+		OwnerPublicKey:  client.pk,
+		ReadPriceRange:  PriceRange{1 * x10, 10 * x10},
+		WritePriceRange: PriceRange{2 * x10, 20 * x10},
+		Size:            2 * GB,
+	}
+	for i := 0; i < 5; i++ {
+		b := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+		nar.Blobbers = append(nar.Blobbers, b.id)
+	}
+
+	quote, err := quoteAllocation(*nar, conf, timedTestBalances{balances, common.Timestamp(now)})
+	require.NoError(t, err)
+	require.Len(t, quote.Blobbers, 4)
+	for i, bq := range quote.Blobbers {
+		require.Equal(t, nar.Blobbers[i], bq.BlobberID)
+		require.Equal(t, int64(GB), bq.Size)
+		require.Equal(t, avgTerms.WritePrice, bq.WritePrice)
+		require.Equal(t, avgTerms.WritePrice, bq.WriteCost)
+		require.Equal(t, bq.WriteCost, bq.ChallengePool)
+	}
+	require.Equal(t, 4*avgTerms.ReadPrice, quote.ReadPrice)
+	require.Equal(t, 4*avgTerms.WritePrice, quote.WriteCost)
+	require.Less(t, quote.MinLockDemand+quote.CancellationCharge, quote.WriteCost)
+	require.Equal(t, quote.WriteCost, quote.TotalCost)
+
+	// the quote doesn't change the state
+	b, err := ssc.getBlobber(nar.Blobbers[0], balances)
+	require.NoError(t, err)
+	require.Zero(t, b.Allocated)
+
+	// the allocation can't be created for less than the total cost
+	_, err = nar.callNewAllocReq(t, client.id, quote.TotalCost-1, ssc, now, balances)
+	require.Error(t, err)
+	_, err = nar.callNewAllocReq(t, client.id, quote.TotalCost, ssc, now, balances)
+	require.NoError(t, err)
+
+	t.Run("reservation", func(t *testing.T) {
+		nar := *nar
+		nar.Blobbers = nil
+		for i := 0; i < 4; i++ {
+			b := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+			nar.Blobbers = append(nar.Blobbers, b.id)
+		}
+		start := now + 100
+		tx := newTransaction(client.id, ssc.ID, x10, now)
+		balances.setTransaction(t, tx)
+		_, err := ssc.reserveCapacity(tx, mustEncode(t, &reserveCapacityRequest{
+			BlobberID: nar.Blobbers[0],
+			Size:      GB,
+			StartTime: common.Timestamp(start),
+			EndTime:   common.Timestamp(start + 100),
+		}), balances)
+		require.NoError(t, err)
+		nar.Reservations = []string{tx.Hash}
+
+		// the blobber raises the prices after the reservation
+		b, err := ssc.getBlobber(nar.Blobbers[0], balances)
+		require.NoError(t, err)
+		b.Terms.WritePrice *= 2
+		_, err = balances.InsertTrieNode(b.GetKey(), b)
+		require.NoError(t, err)
+
+		quote, err := quoteAllocation(nar, conf, timedTestBalances{balances, common.Timestamp(start)})
+		require.NoError(t, err)
+		require.Equal(t, nar.Blobbers[0], quote.Blobbers[0].BlobberID)
+		require.Equal(t, avgTerms.WritePrice, quote.Blobbers[0].WritePrice)
+		require.Equal(t, 4*avgTerms.WritePrice, quote.WriteCost)
+		require.EqualValues(t, x10, quote.Deposit)
+
+		// the reservation is of the owner only
+		nar.Owner = newClient(0, balances).id
+		_, err = quoteAllocation(nar, conf, timedTestBalances{balances, common.Timestamp(start)})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not of the client")
+	})
+
+	nar.MinReputation = 2
+	_, err = quoteAllocation(*nar, conf, timedTestBalances{balances, common.Timestamp(now)})
+	require.EqualError(t, err, "min_reputation must be between 0 and 1")
+}
@@ -0,0 +1,51 @@
+package storagesc
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/0chain/common/core/currency"
+)
+
+// maxAssuranceTier of an allocation, the tier 0 is the standard assurance
+const maxAssuranceTier = 3
+
+func validateAssuranceTier(tier int) error {
+	if tier < 0 || tier > maxAssuranceTier {
+		return fmt.Errorf("assurance_tier must be between 0 and %d", maxAssuranceTier)
+	}
+	return nil
+}
+
+// setAssuranceTier locks the current price of the tier
+func (sa *StorageAllocation) setAssuranceTier(tier int, conf *Config) {
+	sa.AssuranceTier = tier
+	sa.AssurancePrice = float64(tier) * conf.Assurance.Price
+}
+
+// assuranceTerms are the blobber terms the allocation pays, the write price
+// is increased by the assurance price. The challenge pool, the blobber
+// rewards and the penalties are based on the write price, and they are
+// scaled by the tier the same way.
+func (sa *StorageAllocation) assuranceTerms(terms Terms) Terms {
+	if sa.AssurancePrice > 0 {
+		terms.WritePrice = currency.Coin(float64(terms.WritePrice) * (1 + sa.AssurancePrice))
+	}
+	return terms
+}
+
+// assuranceWeight of the tier to be challenged
+func (conf *Config) assuranceWeight(tier int) float64 {
+	return 1 + float64(tier)*conf.Assurance.ChallengeWeight
+}
+
+// acceptChallenge of the allocation with the chance of its weight relative
+// to the weight of the max tier, so the higher tiers are challenged more
+// often. The random source isn't used if the tiers have the same weight.
+func (conf *Config) acceptChallenge(alloc *StorageAllocation, r *rand.Rand) bool {
+	maxWeight := conf.assuranceWeight(maxAssuranceTier)
+	if maxWeight <= 1 {
+		return true
+	}
+	return r.Float64()*maxWeight < conf.assuranceWeight(alloc.AssuranceTier)
+}
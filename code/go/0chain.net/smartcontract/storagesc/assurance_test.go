@@ -0,0 +1,101 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocationAssuranceTier(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		client   = newClient(1000*x10, balances)
+		now      = int64(1000)
+		conf     = setConfig(t, balances)
+	)
+	conf.Assurance = assuranceConfig{Price: 0.5, ChallengeWeight: 1}
+	mustSave(t, scConfigKey(ADDRESS), conf, balances)
+
+	nar := &newAllocationRequest{
+		DataShards:      2,
+		ParityShards:    2,
+		Owner:           client.id,
+		OwnerPublicKey:  client.pk,
+		ReadPriceRange:  PriceRange{1 * x10, 10 * x10},
+		WritePriceRange: PriceRange{2 * x10, 20 * x10},
+		Size:            2 * GB,
+		AssuranceTier:   maxAssuranceTier + 1,
+	}
+	for i := 0; i < 4; i++ {
+		b := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+		nar.Blobbers = append(nar.Blobbers, b.id)
+	}
+
+	_, err := nar.callNewAllocReq(t, client.id, 100*x10, ssc, now, balances)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "assurance_tier must be between 0 and 3")
+
+	nar.AssuranceTier = 2
+	resp, err := nar.callNewAllocReq(t, client.id, 100*x10, ssc, now, balances)
+	require.NoError(t, err)
+	var out NewAllocationTxnOutput
+	require.NoError(t, json.Unmarshal([]byte(resp), &out))
+	alloc, err := ssc.getAllocation(out.ID, balances)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, alloc.AssuranceTier)
+	require.Equal(t, 1.0, alloc.AssurancePrice)
+	for _, ba := range alloc.BlobberAllocs {
+		require.Equal(t, avgTerms.ReadPrice, ba.Terms.ReadPrice)
+		require.Equal(t, 2*avgTerms.WritePrice, ba.Terms.WritePrice)
+	}
+
+	// the standard tier pays the blobbers terms
+	standard := 0
+	uar := &updateAllocationRequest{
+		ID:            alloc.ID,
+		OwnerID:       client.id,
+		AssuranceTier: &standard,
+	}
+	_, err = uar.callUpdateAllocReq(t, client.id, 0, now+1, ssc, balances)
+	require.NoError(t, err)
+	alloc, err = ssc.getAllocation(out.ID, balances)
+	require.NoError(t, err)
+	require.Zero(t, alloc.AssuranceTier)
+	require.Zero(t, alloc.AssurancePrice)
+	for _, ba := range alloc.BlobberAllocs {
+		require.Equal(t, avgTerms, ba.Terms)
+	}
+
+	_, err = uar.callUpdateAllocReq(t, client.id, 0, now+2, ssc, balances)
+	require.EqualError(t, err, "allocation_updating_failed: update allocation changes nothing")
+}
+
+func TestAcceptChallenge(t *testing.T) {
+	var (
+		conf = &Config{Assurance: assuranceConfig{ChallengeWeight: 1}}
+		r    = rand.New(rand.NewSource(1))
+	)
+
+	accepted := func(tier int) int {
+		var n int
+		for i := 0; i < 10000; i++ {
+			if conf.acceptChallenge(&StorageAllocation{AssuranceTier: tier}, r) {
+				n++
+			}
+		}
+		return n
+	}
+
+	// the weights are 1, 2, 3 and 4 of 4
+	require.InDelta(t, 2500, accepted(0), 200)
+	require.InDelta(t, 5000, accepted(1), 200)
+	require.Equal(t, 10000, accepted(maxAssuranceTier))
+
+	// the tiers are challenged alike with no weight
+	conf.Assurance.ChallengeWeight = 0
+	require.Equal(t, 10000, accepted(0))
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"0chain.net/smartcontract/dbs/benchmark"
+	"0chain.net/smartcontract/governance"
 	"0chain.net/smartcontract/stakepool/spenum"
 
 	"github.com/0chain/common/core/currency"
@@ -74,6 +75,18 @@ func BenchmarkRestTests(
 				},
 				Endpoint: srh.getTransactionByFilter,
 			},
+			{
+				FuncName: "transactions-export",
+				Params: map[string]string{
+					"client_id":     data.Clients[1],
+					"function_name": "mock function",
+					"start":         "7",
+					"end":           "15",
+					"cursor":        "7:0",
+					"format":        "csv",
+				},
+				Endpoint: srh.getTransactionHistory,
+			},
 			{
 				FuncName: "errors",
 				Params: map[string]string{
@@ -120,6 +133,69 @@ func BenchmarkRestTests(
 				},
 				Endpoint: srh.getAllocation,
 			},
+			{
+				FuncName: "allocation-acl",
+				Params: map[string]string{
+					"allocation_id": getMockAllocationId(0),
+				},
+				Endpoint: srh.getAllocationACL,
+			},
+			{
+				FuncName: "allocation-renewals",
+				Params: map[string]string{
+					"allocation_id": getMockAllocationId(0),
+				},
+				Endpoint: srh.getAllocationRenewals,
+			},
+			{
+				FuncName: "allocation-ownership-transfers",
+				Params: map[string]string{
+					"allocation_id": getMockAllocationId(0),
+				},
+				Endpoint: srh.getAllocationOwnershipTransfers,
+			},
+			{
+				FuncName: "blobber-capacity-reservations",
+				Params: map[string]string{
+					"blobber_id": getMockBlobberId(0),
+				},
+				Endpoint: srh.getBlobberCapacityReservations,
+			},
+			{
+				FuncName: "client-capacity-reservations",
+				Params: map[string]string{
+					"client_id": data.Clients[0],
+				},
+				Endpoint: srh.getClientCapacityReservations,
+			},
+			{
+				FuncName: "allocation-audit",
+				Params: map[string]string{
+					"allocation_id": getMockAllocationId(0),
+				},
+				Endpoint: srh.getAllocationAudit,
+			},
+			{
+				FuncName: "blobber-migrations",
+				Params: map[string]string{
+					"allocation_id": getMockAllocationId(0),
+				},
+				Endpoint: srh.getBlobberMigrations,
+			},
+			{
+				FuncName: "governance-proposals",
+				Params: map[string]string{
+					"status": "open",
+				},
+				Endpoint: srh.getGovernanceProposals,
+			},
+			{
+				FuncName: "governance-proposal",
+				Params: map[string]string{
+					"proposal_id": governance.GetMockProposalId(ADDRESS, 1),
+				},
+				Endpoint: srh.getGovernanceProposal,
+			},
 			{
 				FuncName: "allocations",
 				Params: map[string]string{
@@ -150,6 +226,27 @@ func BenchmarkRestTests(
 				},
 				Endpoint: srh.getAllocationMinLock,
 			},
+			{
+				FuncName: "allocation-quote",
+				Params: map[string]string{
+					"allocation_data": func() string {
+						var blobbers []string
+						for i := 0; i < viper.GetInt(bk.NumBlobbersPerAllocation); i++ {
+							blobbers = append(blobbers, getMockBlobberId(i))
+						}
+						nar, _ := (&newAllocationRequest{
+							DataShards:      len(blobbers) / 2,
+							ParityShards:    len(blobbers) / 2,
+							Size:            10 * viper.GetInt64(bk.StorageMinAllocSize),
+							Blobbers:        blobbers,
+							ReadPriceRange:  PriceRange{0, currency.Coin(viper.GetInt64(bk.StorageMaxReadPrice) * 1e10)},
+							WritePriceRange: PriceRange{0, currency.Coin(viper.GetInt64(bk.StorageMaxWritePrice) * 1e10)},
+						}).encode()
+						return string(nar)
+					}(),
+				},
+				Endpoint: srh.getAllocationQuote,
+			},
 			{
 				FuncName: "allocation-update-min-lock",
 				Params: map[string]string{
@@ -242,6 +339,13 @@ func BenchmarkRestTests(
 				},
 				Endpoint: srh.getUserStakePoolStat,
 			},
+			{
+				FuncName: "getUserUnbondingPools",
+				Params: map[string]string{
+					"client_id": getMockBlobberStakePoolId(0, mockUnbondingDelegate, data.Clients),
+				},
+				Endpoint: srh.getUserUnbondingPools,
+			},
 			{
 				FuncName: "getChallengePoolStat",
 				Params: map[string]string{
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"0chain.net/core/config"
 	"0chain.net/core/datastore"
 	"0chain.net/smartcontract/dbs/benchmark"
 	"0chain.net/smartcontract/provider"
@@ -18,6 +19,7 @@ import (
 	"0chain.net/smartcontract/partitions"
 
 	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/governance"
 
 	sci "0chain.net/chaincore/smartcontractinterface"
 	"0chain.net/core/encryption"
@@ -32,6 +34,12 @@ const (
 	mockMinLockDemand            = 1
 	mockFinalizedAllocationIndex = 2
 	mockAllocationMinLockDemand  = 0.1
+	// allocations with an index below are renewed automatically
+	mockAutoRenewAllocations = 10
+	// blobbers with an index below have a capacity reservation to forfeit
+	mockCapacityReservations = 10
+	// delegate of the first blobber whose pool is unbonding
+	mockUnbondingDelegate = 1
 )
 
 func AddMockAllocations(
@@ -50,6 +58,149 @@ func AddMockAllocations(
 			balances,
 		)
 	}
+	addMockRenewalQueue(balances)
+	addMockCapacityReservations(clients, eventDb, balances)
+	addMockBlobberMigrations(eventDb, balances)
+	addMockGovernanceProposals(clients, eventDb, balances)
+}
+
+// addMockCapacityReservations adds the reservations not converted in time
+func addMockCapacityReservations(clients []string, eventDb *event.EventDb, balances cstate.StateContextI) {
+	var (
+		sscID = StorageSmartContract{SmartContract: sci.NewSC(ADDRESS)}.ID
+		end   = balances.GetTransaction().CreationDate - toSeconds(reservationGracePeriod) - 1
+	)
+	for i := 0; i < mockCapacityReservations && i < viper.GetInt(sc.NumBlobbers); i++ {
+		cr := &CapacityReservation{
+			ID:        getMockReservationId(i),
+			ClientID:  clients[i%len(clients)],
+			BlobberID: getMockBlobberId(i),
+			Size:      GB,
+			StartTime: end - toSeconds(viper.GetDuration(sc.TimeUnit)),
+			EndTime:   end,
+			Terms:     getMockBlobberTerms(),
+			Deposit:   1e10,
+			Status:    ReservationActive,
+		}
+		if err := cr.save(sscID, balances); err != nil {
+			log.Fatal(err)
+		}
+		if viper.GetBool(sc.EventDbEnabled) {
+			crDb := event.CapacityReservation{
+				ReservationID: cr.ID,
+				ClientID:      cr.ClientID,
+				BlobberID:     cr.BlobberID,
+				Size:          cr.Size,
+				StartTime:     int64(cr.StartTime),
+				EndTime:       int64(cr.EndTime),
+				ReadPrice:     cr.Terms.ReadPrice,
+				WritePrice:    cr.Terms.WritePrice,
+				Deposit:       cr.Deposit,
+				Status:        cr.Status,
+			}
+			if err := eventDb.Store.Get().Create(&crDb).Error; err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+}
+
+// addMockBlobberMigrations adds the migration of the first blobber of the
+// first allocation to complete, the expired one of the second allocation and
+// the migration cursor of the first blobber
+func addMockBlobberMigrations(eventDb *event.EventDb, balances cstate.StateContextI) {
+	var (
+		sscID = StorageSmartContract{SmartContract: sci.NewSC(ADDRESS)}.ID
+		now   = balances.GetTransaction().CreationDate
+	)
+	for i := 0; i < 2 && i < viper.GetInt(sc.NumAllocations); i++ {
+		from := i // the first blobber of the allocation
+		m := &BlobberMigration{
+			ID:             getMockBlobberMigrationId(i),
+			AllocationID:   getMockAllocationId(i),
+			FromBlobberID:  getMockBlobberId(from),
+			ToBlobberID:    getMockBlobberId(from + viper.GetInt(sc.NumBlobbersPerAllocation)),
+			Size:           viper.GetInt64(sc.StorageMinAllocSize),
+			AllocationRoot: encryption.Hash("allocation root"),
+			Terms:          getMockBlobberTerms(),
+			Created:        now - toSeconds(blobberMigrationPeriod),
+			Deadline:       now + toSeconds(blobberMigrationPeriod),
+			Status:         MigrationPending,
+		}
+		if i > 0 {
+			m.Deadline = now - 1
+		}
+		if err := m.save(sscID, balances); err != nil {
+			log.Fatal(err)
+		}
+		if viper.GetBool(sc.EventDbEnabled) {
+			mDb := event.BlobberMigration{
+				MigrationID:    m.ID,
+				AllocationID:   m.AllocationID,
+				FromBlobberID:  m.FromBlobberID,
+				ToBlobberID:    m.ToBlobberID,
+				Size:           m.Size,
+				AllocationRoot: m.AllocationRoot,
+				Created:        int64(m.Created),
+				Deadline:       int64(m.Deadline),
+				Status:         m.Status,
+			}
+			if err := eventDb.Store.Get().Create(&mDb).Error; err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+
+	// the allocations of the first blobber left to migrate
+	cursor := &blobberMigrationCursor{BlobberID: getMockBlobberId(0)}
+	if err := cursor.save(sscID, balances); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// addMockGovernanceProposals adds the open proposal to vote on and the one
+// voted by the first blobber delegate to execute
+func addMockGovernanceProposals(
+	clients []string,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	governance.AddMockProposals(ADDRESS, clients[0], getMockBlobberStakePoolId(0, 0, clients),
+		currency.Coin(viper.GetInt64(sc.StorageMaxStake)*1e10/1000),
+		[]governance.StakeRef{{ProviderType: spenum.Blobber, ProviderID: getMockBlobberId(0)}},
+		config.StringMap{Fields: map[string]string{"max_read_price": "100"}},
+		eventDb, balances)
+}
+
+// addMockRenewalQueue adds the auto-renew allocations to the renewal queue
+// of their expiration
+func addMockRenewalQueue(balances cstate.StateContextI) {
+	now := balances.GetTransaction().CreationDate
+	bucket := renewalBucket(renewAt(benchAllocationExpire(now)))
+	q, err := partitions.CreateIfNotExists(balances, renewalQueueKey(bucket), maxAutoRenewalsPerRound)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < mockAutoRenewAllocations && i < viper.GetInt(sc.NumAllocations); i++ {
+		if i == mockFinalizedAllocationIndex {
+			continue
+		}
+		if err := q.Add(balances, &renewalItem{AllocationID: getMockAllocationId(i)}); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := q.Save(balances); err != nil {
+		log.Fatal(err)
+	}
+	cursor := &renewalCursor{Bucket: bucket}
+	if err := cursor.save(balances); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// benchRenewalTime is the time the mocked auto-renew allocations are renewed
+func benchRenewalTime(now common.Timestamp) common.Timestamp {
+	return renewAt(benchAllocationExpire(now)) + toSeconds(autoRenewBucket)
 }
 
 func benchAllocationExpire(now common.Timestamp) common.Timestamp {
@@ -90,6 +241,27 @@ func addMockAllocation(
 		TimeUnit:      1 * time.Hour,
 		Finalized:     i == mockFinalizedAllocationIndex,
 		WritePool:     2e10,
+		ACL: []*ACLEntry{
+			{
+				ClientID: getMockACLClient(cIndex, len(clients), false, clients),
+				Ops:      1,
+			},
+			{
+				ClientID:  getMockACLClient(cIndex, len(clients), true, clients),
+				Ops:       1,
+				ExpiresAt: 1,
+			},
+		},
+		OwnershipOffer: &OwnershipOffer{
+			NewOwnerID: clients[getMockNewOwnerIndex(cIndex, len(clients))],
+		},
+	}
+	if i < mockAutoRenewAllocations && i != mockFinalizedAllocationIndex {
+		sa.AutoRenew = &AutoRenewPolicy{
+			Duration:    viper.GetDuration(sc.TimeUnit),
+			MaxRenewals: maxAutoRenewals,
+			Funding:     AutoRenewFundingWritePool,
+		}
 	}
 
 	startBlobbers := getMockBlobberBlockFromAllocationIndex(i)
@@ -152,9 +324,33 @@ func addMockAllocation(
 			LatestClosedChallengeTxn: sa.Stats.LastestClosedChallengeTxn,
 			Terms:                    allocationTerms,
 		}
+		sa.setEventAutoRenew(&allocationDb)
 		if err := eventDb.Store.Get().Create(&allocationDb).Error; err != nil {
 			log.Fatal(err)
 		}
+
+		if sa.AutoRenew != nil {
+			renewal := event.AllocationRenewal{
+				AllocationID:  sa.ID,
+				TxnHash:       encryption.Hash("mock renewal transaction"),
+				Round:         1,
+				OldExpiration: int64(sa.Expiration),
+				NewExpiration: int64(sa.Expiration) + int64(toSeconds(sa.AutoRenew.Duration)),
+				WritePool:     sa.WritePool,
+				Status:        RenewalRenewed,
+			}
+			if err := eventDb.Store.Get().Create(&renewal).Error; err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		acl := make([]event.AllocationACL, 0, len(sa.ACL))
+		for _, e := range sa.ACL {
+			acl = append(acl, e.toEvent(sa.ID))
+		}
+		if err := eventDb.Store.Get().Create(&acl).Error; err != nil {
+			log.Fatal(err)
+		}
 	}
 }
 
@@ -337,6 +533,24 @@ func setupMockChallenge(
 		if err = eventDb.Store.Get().Create(&challengeRow).Error; err != nil {
 			log.Fatal(err)
 		}
+		audit := event.ChallengeAudit{
+			ChallengeID:  challenge.ID,
+			AllocationID: challenge.AllocationID,
+			BlobberID:    challenge.BlobberID,
+			TxnHash:      challenge.ID,
+			Round:        int64(index),
+			Passed:       true,
+		}
+		for _, id := range challenge.ValidatorIDs {
+			audit.Tickets = append(audit.Tickets, event.ChallengeValidationTicket{
+				ChallengeID: challenge.ID,
+				ValidatorID: id,
+				Result:      true,
+			})
+		}
+		if err = eventDb.Store.Get().Create(&audit).Error; err != nil {
+			log.Fatal(err)
+		}
 	}
 
 	return challenges
@@ -388,6 +602,10 @@ func AddMockBlobbers(
 			PublicKey:         "",
 			StakePoolSettings: getMockStakePoolSettings(id),
 			NotAvailable:      false,
+			Reputation: BlobberReputation{
+				ChallengesPassed: int64(i),
+				ChallengesFailed: 1,
+			},
 		}
 		blobbers.Nodes.add(blobber)
 		rtvBlobbers = append(rtvBlobbers, blobber)
@@ -419,6 +637,7 @@ func AddMockBlobbers(
 				ChallengesPassed:    uint64(i),
 				ChallengesCompleted: uint64(i + 1),
 				RankMetric:          float64(i) / (float64(i) + 1),
+				Reputation:          blobber.ReputationScore(),
 				NotAvailable:        blobber.NotAvailable,
 			}
 			blobberDb.TotalStake, err = currency.ParseZCN(viper.GetFloat64(sc.StorageMaxStake) / 2)
@@ -643,6 +862,10 @@ func GetMockBlobberStakePools(
 			bal := currency.Coin(viper.GetInt64(sc.StorageMaxStake) * 1e10 / 1000)
 			sp.Pools[id].Balance = bal
 			sp.Pools[id].DelegateID = clients[clientIndex]
+			if i == 0 && j == mockUnbondingDelegate {
+				sp.Pools[id].Status = spenum.Unbonding
+				sp.Pools[id].ReleaseRound = 1
+			}
 
 			if viper.GetBool(sc.EventDbEnabled) {
 				dp := event.DelegatePool{
@@ -654,9 +877,10 @@ func GetMockBlobberStakePools(
 					Reward:       10,
 					TotalReward:  10,
 					TotalPenalty: 0,
-					Status:       spenum.Active,
+					Status:       sp.Pools[id].Status,
 					RoundCreated: 1,
 					StakedAt:     sp.Pools[id].StakedAt,
+					ReleaseRound: sp.Pools[id].ReleaseRound,
 				}
 				if err := eventDb.Store.Get().Create(&dp).Error; err != nil {
 					log.Fatal(err)
@@ -873,6 +1097,10 @@ func getMockValidatorUrl(id string) string {
 	return id + ".com"
 }
 
+func getMockReservationId(blobber int) string {
+	return encryption.Hash("mock capacity reservation" + strconv.Itoa(blobber))
+}
+
 func getMockAllocationId(allocation int) string {
 	return encryption.Hash("mock allocation id" + strconv.Itoa(allocation))
 }
@@ -881,6 +1109,27 @@ func getMockOwnerFromAllocationIndex(allocation, numClinets int) int {
 	return allocation % (numClinets - 1 - viper.GetInt(sc.NumAllocationPayerPools))
 }
 
+// getMockACLClient returns a client granted access to the allocation of
+// the given owner, either with an active or with an expired grant.
+func getMockACLClient(ownerIndex, numClients int, expired bool, clients []string) string {
+	if expired {
+		return clients[(ownerIndex+2)%numClients]
+	}
+	return clients[(ownerIndex+1)%numClients]
+}
+
+// getMockNewOwnerIndex returns the client the allocation of the given owner
+// is offered to
+func getMockNewOwnerIndex(ownerIndex, numClients int) int {
+	return (ownerIndex + 3) % numClients
+}
+
+// getMockBlobberMigrationId of the first blobber of the allocation, it's
+// the blobber of the same index for the first allocations
+func getMockBlobberMigrationId(i int) string {
+	return blobberMigrationID(getMockAllocationId(i), getMockBlobberId(i))
+}
+
 func getMockBlobberBlockFromAllocationIndex(i int) int {
 	return i % (viper.GetInt(sc.NumBlobbers) - viper.GetInt(sc.NumBlobbersPerAllocation))
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sc "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"0chain.net/smartcontract/provider"
 
 	"github.com/0chain/common/core/currency"
@@ -145,6 +146,36 @@ func BenchmarkTests(
 				}).Encode()
 			}(),
 		},
+		{
+			name:     "storage.read_redeem_batch",
+			endpoint: ssc.commitBlobberReadBatch,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				var req readBatchRequest
+				_ = sigScheme.SetPublicKey(data.PublicKeys[0])
+				sigScheme.SetPrivateKey(data.PrivateKeys[0])
+				// the blobbers of the first allocation
+				for i := 0; i < viper.GetInt(bk.NumBlobbersPerAllocation); i++ {
+					rm := ReadMarker{
+						ClientID:        data.Clients[0],
+						ClientPublicKey: data.PublicKeys[0],
+						BlobberID:       getMockBlobberId(i),
+						AllocationID:    getMockAllocationId(0),
+						OwnerID:         data.Clients[0],
+						Timestamp:       creationTime,
+						ReadCounter:     viper.GetInt64(bk.NumWriteRedeemAllocation) + 1,
+					}
+					rm.Signature, _ = sigScheme.Sign(encryption.Hash(rm.GetHashData()))
+					req.ReadMarkers = append(req.ReadMarkers, &rm)
+				}
+				bytes, _ := json.Marshal(&req)
+				return bytes
+			}(),
+		},
 		{
 			name:     "storage.commit_connection",
 			endpoint: ssc.commitBlobberConnection,
@@ -270,6 +301,302 @@ func BenchmarkTests(
 				return bytes
 			}(),
 		},
+		// allocation access control list
+		{
+			name:     "storage.add_allocation_acl",
+			endpoint: ssc.addAllocationACL,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				CreationDate: creationTime,
+				ClientID:     data.Clients[getMockOwnerFromAllocationIndex(0, viper.GetInt(bk.NumActiveClients))],
+				ToClientID:   ADDRESS,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&allocationACLRequest{
+					AllocationID: getMockAllocationId(0),
+					Entries: []*ACLEntry{
+						{
+							ClientID:  data.Clients[len(data.Clients)-1],
+							Ops:       maxFileOptions,
+							ExpiresAt: benchAllocationExpire(creationTime),
+						},
+					},
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.remove_allocation_acl",
+			endpoint: ssc.removeAllocationACL,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				CreationDate: creationTime,
+				ClientID:     data.Clients[getMockOwnerFromAllocationIndex(0, viper.GetInt(bk.NumActiveClients))],
+				ToClientID:   ADDRESS,
+			},
+			input: func() []byte {
+				ownerIndex := getMockOwnerFromAllocationIndex(0, viper.GetInt(bk.NumActiveClients))
+				bytes, _ := json.Marshal(&allocationACLRequest{
+					AllocationID: getMockAllocationId(0),
+					ClientIDs:    []string{getMockACLClient(ownerIndex, len(data.Clients), false, data.Clients)},
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.expire_allocation_acl",
+			endpoint: ssc.expireAllocationACL,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				CreationDate: creationTime,
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&allocationACLRequest{
+					AllocationID: getMockAllocationId(0),
+				})
+				return bytes
+			}(),
+		},
+		// allocation auto-renewal
+		{
+			name:     "storage.allocation_auto_renew",
+			endpoint: ssc.setAllocationAutoRenew,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				CreationDate: creationTime,
+				ClientID:     data.Clients[getMockOwnerFromAllocationIndex(0, viper.GetInt(bk.NumActiveClients))],
+				ToClientID:   ADDRESS,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&autoRenewRequest{
+					AllocationID: getMockAllocationId(0),
+					Duration:     viper.GetDuration(bk.TimeUnit),
+					MaxRenewals:  maxAutoRenewals,
+				})
+				return bytes
+			}(),
+		},
+		// allocation ownership transfer
+		{
+			name:     "storage.offer_allocation_ownership",
+			endpoint: ssc.offerAllocationOwnership,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				CreationDate: creationTime,
+				ClientID:     data.Clients[getMockOwnerFromAllocationIndex(0, viper.GetInt(bk.NumActiveClients))],
+				ToClientID:   ADDRESS,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&ownershipOfferRequest{
+					AllocationID: getMockAllocationId(0),
+					NewOwnerID:   data.Clients[len(data.Clients)-1],
+					ExpiresAt:    benchAllocationExpire(creationTime),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.accept_allocation_ownership",
+			endpoint: ssc.acceptAllocationOwnership,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				CreationDate: creationTime,
+				ClientID: data.Clients[getMockNewOwnerIndex(
+					getMockOwnerFromAllocationIndex(0, viper.GetInt(bk.NumActiveClients)), len(data.Clients))],
+				ToClientID: ADDRESS,
+			},
+			input: func() []byte {
+				var (
+					ownerIndex    = getMockOwnerFromAllocationIndex(0, viper.GetInt(bk.NumActiveClients))
+					newOwnerIndex = getMockNewOwnerIndex(ownerIndex, len(data.Clients))
+				)
+				_ = sigScheme.SetPublicKey(data.PublicKeys[newOwnerIndex])
+				sigScheme.SetPrivateKey(data.PrivateKeys[newOwnerIndex])
+				signature, _ := sigScheme.Sign(encryption.Hash(ownershipMessage(getMockAllocationId(0),
+					data.Clients[ownerIndex], data.Clients[newOwnerIndex])))
+				bytes, _ := json.Marshal(&ownershipAcceptRequest{
+					AllocationID: getMockAllocationId(0),
+					PublicKey:    data.PublicKeys[newOwnerIndex],
+					Signature:    signature,
+				})
+				return bytes
+			}(),
+		},
+		// capacity reservations
+		{
+			name:     "storage.reserve_capacity",
+			endpoint: ssc.reserveCapacity,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+				Value:        10e10,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&reserveCapacityRequest{
+					BlobberID: getMockBlobberId(0),
+					Size:      GB,
+					StartTime: creationTime + 1,
+					EndTime:   creationTime + 1 + toSeconds(24*time.Hour),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.forfeit_capacity_reservation",
+			endpoint: ssc.forfeitCapacityReservation,
+			txn: &transaction.Transaction{
+				ClientID:     getMockBlobberId(0),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&forfeitReservationRequest{
+					ReservationID: getMockReservationId(0),
+				})
+				return bytes
+			}(),
+		},
+		// blobber migrations
+		{
+			name:     "storage.complete_blobber_migration",
+			endpoint: ssc.completeBlobberMigration,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				ClientID:     getMockBlobberId(viper.GetInt(bk.NumBlobbersPerAllocation)),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				wm := WriteMarker{
+					AllocationRoot: encryption.Hash("allocation root"),
+					AllocationID:   getMockAllocationId(0),
+					BlobberID:      getMockBlobberId(viper.GetInt(bk.NumBlobbersPerAllocation)),
+					Timestamp:      creationTime,
+					ClientID:       data.Clients[0],
+				}
+				_ = sigScheme.SetPublicKey(data.PublicKeys[0])
+				sigScheme.SetPrivateKey(data.PrivateKeys[0])
+				wm.Signature, _ = sigScheme.Sign(encryption.Hash(wm.GetHashData()))
+				bytes, _ := json.Marshal(&completeBlobberMigrationRequest{
+					MigrationID: getMockBlobberMigrationId(0),
+					WriteMarker: &wm,
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.expire_blobber_migration",
+			endpoint: ssc.expireBlobberMigration,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&expireBlobberMigrationRequest{
+					MigrationID: getMockBlobberMigrationId(1),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.migrate_blobber_allocations",
+			endpoint: ssc.migrateBlobberAllocationsNext,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&migrateBlobberAllocationsRequest{
+					BlobberID: getMockBlobberId(0),
+				})
+				return bytes
+			}(),
+		},
+		// governance
+		{
+			name:     "storage.governance_propose",
+			endpoint: ssc.governancePropose,
+			txn: &transaction.Transaction{
+				HashIDField: datastore.HashIDField{
+					Hash: encryption.Hash("mock transaction hash"),
+				},
+				ClientID:     getMockBlobberStakePoolId(0, 0, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&governance.ProposeRequest{
+					Changes: sc.StringMap{
+						Fields: map[string]string{"max_write_price": "100"},
+					},
+					Stakes: []governance.StakeRef{{
+						ProviderType: spenum.Blobber,
+						ProviderID:   getMockBlobberId(0),
+					}},
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.governance_vote",
+			endpoint: ssc.governanceVote,
+			txn: &transaction.Transaction{
+				ClientID:     getMockBlobberStakePoolId(0, 0, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&governance.VoteRequest{
+					ProposalID: governance.GetMockProposalId(ADDRESS, 0),
+					Approve:    true,
+					Stakes: []governance.StakeRef{{
+						ProviderType: spenum.Blobber,
+						ProviderID:   getMockBlobberId(0),
+					}},
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.governance_execute",
+			endpoint: ssc.governanceExecute,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&governance.ExecuteRequest{
+					ProposalID: governance.GetMockProposalId(ADDRESS, 1),
+				})
+				return bytes
+			}(),
+		},
 		// free data.Allocations
 		{
 			name:     "storage.add_free_storage_assigner",
@@ -613,6 +940,51 @@ func BenchmarkTests(
 				return bytes
 			}(),
 		},
+		{
+			name:     "storage.stake_pool_claim_unbonded",
+			endpoint: ssc.stakePoolClaimUnbonded,
+			txn: &transaction.Transaction{
+				ClientID:     getMockBlobberStakePoolId(0, mockUnbondingDelegate, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&stakePoolRequest{
+					ProviderType: spenum.Blobber,
+					ProviderID:   getMockBlobberId(0),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "storage.stake_pool_redelegate",
+			endpoint: ssc.stakePoolRedelegate,
+			txn: &transaction.Transaction{
+				ClientID:     getMockBlobberStakePoolId(0, 0, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&stakepool.RedelegateRequest{
+				ProviderType:   spenum.Blobber,
+				ProviderID:     getMockBlobberId(0),
+				ToProviderType: spenum.Blobber,
+				ToProviderID:   getMockBlobberId(1),
+			}).Encode(),
+		},
+		{
+			name:     "storage.stake_pool_auto_compound",
+			endpoint: ssc.stakePoolAutoCompound,
+			txn: &transaction.Transaction{
+				ClientID:     getMockBlobberStakePoolId(0, 0, data.Clients),
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&stakepool.AutoCompoundRequest{
+				ProviderType: spenum.Blobber,
+				ProviderID:   getMockBlobberId(0),
+				AutoCompound: true,
+			}).Encode(),
+		},
 		{
 			name:     "storage.collect_reward",
 			endpoint: ssc.collectReward,
@@ -642,13 +1014,16 @@ func BenchmarkTests(
 					return "", err2
 				}
 				err := ssc.blobberBlockRewards(txn, marshal, balances)
+				if err == nil {
+					err = ssc.renewAllocations(txn, balances)
+				}
 				if err != nil {
 					return "", err
 				} else {
 					return "blobber block rewarded", nil
 				}
 			},
-			txn: &transaction.Transaction{CreationDate: creationTime},
+			txn: &transaction.Transaction{CreationDate: benchRenewalTime(creationTime)},
 		},
 		{
 			name:     "storage.challenge_response",
@@ -421,9 +421,11 @@ func (sc *StorageSmartContract) blobberHealthCheck(t *transaction.Transaction,
 			"cannot get config: %v", err)
 	}
 	downtime = common.Downtime(blobber.LastHealthCheck, t.CreationDate, conf.HealthCheckPeriod)
+	blobber.Reputation.addHealthCheck(blobber.LastHealthCheck, t.CreationDate, conf.HealthCheckPeriod)
 	blobber.LastHealthCheck = t.CreationDate
 
 	emitBlobberHealthCheck(blobber, downtime, balances)
+	emitUpdateBlobberReputation(blobber, balances)
 
 	_, err = balances.InsertTrieNode(blobber.GetKey(),
 		blobber)
@@ -565,41 +567,9 @@ func (sc *StorageSmartContract) commitBlobberRead(t *transaction.Transaction,
 	}
 	details.Spent = spent
 
-	rewardRound := GetCurrentRewardRound(balances.GetBlock().Round, conf.BlockReward.TriggerPeriod)
-
-	if blobber.LastRewardDataReadRound >= rewardRound {
-		blobber.DataReadLastRewardRound += sizeRead
-	} else {
-		blobber.DataReadLastRewardRound = sizeRead
-	}
-	blobber.LastRewardDataReadRound = balances.GetBlock().Round
-
-	if blobber.RewardRound.StartRound >= rewardRound && blobber.RewardRound.Timestamp > 0 {
-		parts, err := getOngoingPassedBlobberRewardsPartitions(balances, conf.BlockReward.TriggerPeriod)
-		if err != nil {
-			return "", common.NewErrorf("commit_blobber_read",
-				"cannot fetch ongoing partition: %v", err)
-		}
-
-		var brn BlobberRewardNode
-		if err := parts.Get(balances, blobber.ID, &brn); err != nil {
-			return "", common.NewErrorf("commit_blobber_read",
-				"cannot fetch blobber node item from partition: %v", err)
-		}
-
-		brn.DataRead = blobber.DataReadLastRewardRound
-
-		err = parts.UpdateItem(balances, &brn)
-		if err != nil {
-			return "", common.NewErrorf("commit_blobber_read",
-				"error updating blobber reward item: %v", err)
-		}
-
-		err = parts.Save(balances)
-		if err != nil {
-			return "", common.NewErrorf("commit_blobber_read",
-				"error saving ongoing blobber reward partition: %v", err)
-		}
+	blobber.addDataRead(sizeRead, conf, balances)
+	if err := updateBlobberRewardDataRead(blobber, conf, balances); err != nil {
+		return "", common.NewError("commit_blobber_read", err.Error())
 	}
 
 	// Save pools
@@ -649,6 +619,49 @@ func (sc *StorageSmartContract) commitBlobberRead(t *transaction.Transaction,
 	return // ok, the response and nil
 }
 
+// addDataRead adds the size read in GB to the data read of the blobber in
+// the current reward round
+func (sn *StorageNode) addDataRead(sizeRead float64, conf *Config, balances cstate.StateContextI) {
+	rewardRound := GetCurrentRewardRound(balances.GetBlock().Round, conf.BlockReward.TriggerPeriod)
+
+	if sn.LastRewardDataReadRound >= rewardRound {
+		sn.DataReadLastRewardRound += sizeRead
+	} else {
+		sn.DataReadLastRewardRound = sizeRead
+	}
+	sn.LastRewardDataReadRound = balances.GetBlock().Round
+}
+
+// updateBlobberRewardDataRead updates the data read of the blobber in the
+// ongoing blobber rewards partitions, if the blobber is there
+func updateBlobberRewardDataRead(blobber *StorageNode, conf *Config, balances cstate.StateContextI) error {
+	rewardRound := GetCurrentRewardRound(balances.GetBlock().Round, conf.BlockReward.TriggerPeriod)
+	if blobber.RewardRound.StartRound < rewardRound || blobber.RewardRound.Timestamp <= 0 {
+		return nil
+	}
+
+	parts, err := getOngoingPassedBlobberRewardsPartitions(balances, conf.BlockReward.TriggerPeriod)
+	if err != nil {
+		return fmt.Errorf("cannot fetch ongoing partition: %v", err)
+	}
+
+	var brn BlobberRewardNode
+	if err := parts.Get(balances, blobber.ID, &brn); err != nil {
+		return fmt.Errorf("cannot fetch blobber node item from partition: %v", err)
+	}
+
+	brn.DataRead = blobber.DataReadLastRewardRound
+
+	if err := parts.UpdateItem(balances, &brn); err != nil {
+		return fmt.Errorf("error updating blobber reward item: %v", err)
+	}
+
+	if err := parts.Save(balances); err != nil {
+		return fmt.Errorf("error saving ongoing blobber reward partition: %v", err)
+	}
+	return nil
+}
+
 // commitMoveTokens moves tokens on connection commit (on write marker),
 // if data written (size > 0) -- from write pool to challenge pool, otherwise
 // (delete write marker) from challenge back to write pool
@@ -68,6 +68,7 @@ func emitAddBlobber(sn *StorageNode, sp *stakePool, balances cstate.StateContext
 		},
 
 		OffersTotal: sp.TotalOffers,
+		Reputation:  sn.ReputationScore(),
 
 		CreationRound: balances.GetBlock().Round,
 	}
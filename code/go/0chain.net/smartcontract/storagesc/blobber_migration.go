@@ -0,0 +1,771 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/partitions"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/logging"
+	"github.com/0chain/common/core/util"
+	"go.uber.org/zap"
+)
+
+//msgp:ignore completeBlobberMigrationRequest expireBlobberMigrationRequest migrateBlobberAllocationsRequest migrationReplacements
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+const (
+	MigrationPending = iota
+	MigrationCompleted
+	MigrationExpired
+)
+
+const (
+	// blobberMigrationPeriod the replacement blobber has to complete the
+	// migration in
+	blobberMigrationPeriod = 7 * 24 * time.Hour
+	// blobberMigrationPenalty is the share of the shut down blobber offer
+	// slashed from its stake and paid to the replacement blobber
+	blobberMigrationPenalty = 0.1
+)
+
+// BlobberMigration of the allocation data from a shut down blobber to the
+// replacement selected by the smart contract. The capacity and the offer of
+// the replacement are reserved until the migration is completed with a
+// write marker of the allocation owner having the allocation root of the
+// shut down blobber, or until it expires after the deadline.
+type BlobberMigration struct {
+	ID            string `json:"id"`
+	AllocationID  string `json:"allocation_id"`
+	FromBlobberID string `json:"from_blobber_id"`
+	ToBlobberID   string `json:"to_blobber_id"`
+	// Size of the blobber allocation and UsedSize of it at the shutdown.
+	Size     int64 `json:"size"`
+	UsedSize int64 `json:"used_size"`
+	// AllocationRoot of the shut down blobber at the shutdown.
+	AllocationRoot string `json:"allocation_root"`
+	// Terms of the replacement blobber, locked.
+	Terms Terms `json:"terms"`
+	// Offer reserved on the replacement blobber stake pool.
+	Offer    currency.Coin    `json:"offer"`
+	Created  common.Timestamp `json:"created"`
+	Deadline common.Timestamp `json:"deadline"`
+	Status   int              `json:"status"`
+	// Penalty of the shut down blobber paid to the replacement.
+	Penalty currency.Coin `json:"penalty"`
+}
+
+func blobberMigrationID(allocationID, blobberID string) string {
+	return encryption.Hash(allocationID + ":" + blobberID)
+}
+
+func blobberMigrationKey(globalKey, id string) datastore.Key {
+	return datastore.Key(globalKey + ":blobbermigration:" + id)
+}
+
+func (m *BlobberMigration) GetKey(globalKey string) datastore.Key {
+	return blobberMigrationKey(globalKey, m.ID)
+}
+
+func (m *BlobberMigration) isExpired(now common.Timestamp) bool {
+	return m.Status == MigrationPending && m.Deadline < now
+}
+
+func (m *BlobberMigration) save(sscKey string, balances cstate.StateContextI) error {
+	_, err := balances.InsertTrieNode(m.GetKey(sscKey), m)
+	return err
+}
+
+func (m *BlobberMigration) emit(balances cstate.StateContextI) {
+	balances.EmitEvent(event.TypeStats, event.TagAddOrOverwriteBlobberMigration, m.ID,
+		[]event.BlobberMigration{{
+			MigrationID:    m.ID,
+			AllocationID:   m.AllocationID,
+			FromBlobberID:  m.FromBlobberID,
+			ToBlobberID:    m.ToBlobberID,
+			Size:           m.Size,
+			UsedSize:       m.UsedSize,
+			AllocationRoot: m.AllocationRoot,
+			Created:        int64(m.Created),
+			Deadline:       int64(m.Deadline),
+			Status:         m.Status,
+			Penalty:        m.Penalty,
+		}})
+}
+
+func (sc *StorageSmartContract) getBlobberMigration(id string,
+	balances cstate.CommonStateContextI) (*BlobberMigration, error) {
+
+	m := new(BlobberMigration)
+	if err := balances.GetTrieNode(blobberMigrationKey(sc.ID, id), m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// releaseReplacement gives the reserved capacity back to the replacement
+func (m *BlobberMigration) releaseReplacement(blobber *StorageNode, sp *stakePool) error {
+	blobber.Allocated -= m.Size
+	if blobber.Allocated < 0 {
+		blobber.Allocated = 0
+	}
+	offer := m.Offer
+	if offer > sp.TotalOffers {
+		offer = sp.TotalOffers
+	}
+	return sp.reduceOffer(offer)
+}
+
+// migrationReplacements are the blobbers and the stake pools changed by the
+// replacements selected on a shutdown, in the order of the selection
+type migrationReplacements struct {
+	blobbers map[string]*StorageNode
+	pools    map[string]*stakePool
+	order    []string
+}
+
+func (mr *migrationReplacements) get(id string,
+	balances cstate.StateContextI) (*StorageNode, *stakePool, error) {
+
+	if b, ok := mr.blobbers[id]; ok {
+		return b, mr.pools[id], nil
+	}
+	b, err := getBlobber(id, balances)
+	if err != nil {
+		return nil, nil, err
+	}
+	sp, err := getStakePool(spenum.Blobber, id, balances)
+	if err != nil {
+		return nil, nil, err
+	}
+	mr.blobbers[id] = b
+	mr.pools[id] = sp
+	return b, sp, nil
+}
+
+func (mr *migrationReplacements) reserve(b *StorageNode, sp *stakePool, ba *BlobberAllocation) error {
+	if err := sp.addOffer(ba.Offer()); err != nil {
+		return err
+	}
+	b.Allocated += ba.Size
+	for _, id := range mr.order {
+		if id == b.ID {
+			return nil
+		}
+	}
+	mr.order = append(mr.order, b.ID)
+	return nil
+}
+
+func (mr *migrationReplacements) save(balances cstate.StateContextI) error {
+	for _, id := range mr.order {
+		if err := mr.pools[id].Save(spenum.Blobber, id, balances); err != nil {
+			return fmt.Errorf("saving stake pool of %s: %v", id, err)
+		}
+		b := mr.blobbers[id]
+		if _, err := balances.InsertTrieNode(b.GetKey(), b); err != nil {
+			return fmt.Errorf("saving blobber %s: %v", id, err)
+		}
+		emitUpdateBlobberAllocatedSavedHealth(b, balances)
+	}
+	return nil
+}
+
+// selectReplacement of the blobber of the allocation among the challenge
+// ready blobbers, the replacement has to match the allocation requirements
+// and its price ranges. It returns nil if there is no such blobber.
+func (mr *migrationReplacements) selectReplacement(
+	alloc *StorageAllocation,
+	blobberID string,
+	ready *partitions.Partitions,
+	r *rand.Rand,
+	conf *Config,
+	now common.Timestamp,
+	balances cstate.StateContextI,
+) (*StorageNode, *BlobberAllocation, error) {
+	var candidates []ChallengeReadyBlobber
+	if err := ready.GetRandomItems(balances, r, &candidates); err != nil {
+		return nil, nil, nil // no challenge ready blobbers
+	}
+
+	for _, i := range r.Perm(len(candidates)) {
+		id := candidates[i].BlobberID
+		if _, ok := alloc.BlobberAllocsMap[id]; ok || id == blobberID {
+			continue
+		}
+		b, sp, err := mr.get(id, balances)
+		if err != nil {
+			return nil, nil, err
+		}
+		staked, err := sp.stake()
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := alloc.isActive(b, staked, sp.TotalOffers, conf, now); err != nil {
+			continue
+		}
+		if alloc.GeoConstraints != nil {
+			blobbers := make([]*StorageNode, 0, len(alloc.BlobberAllocs))
+			for _, ba := range alloc.BlobberAllocs {
+				if ba.BlobberID == blobberID {
+					blobbers = append(blobbers, b)
+					continue
+				}
+				ab, _, err := mr.get(ba.BlobberID, balances)
+				if err != nil {
+					return nil, nil, err
+				}
+				blobbers = append(blobbers, ab)
+			}
+			if err := alloc.GeoConstraints.check(blobbers); err != nil {
+				continue
+			}
+		}
+
+		ba, err := newBlobberAllocation(alloc.bSize(), alloc, b, now, conf.TimeUnit)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := mr.reserve(b, sp, ba); err != nil {
+			return nil, nil, err
+		}
+		return b, ba, nil
+	}
+	return nil, nil, nil
+}
+
+// blobberMigrationCursor is the partition of the allocations of the shut
+// down blobber to migrate next. The partitions are migrated from the last
+// one, so an allocation moved to a lower partition by a removal is never
+// skipped, at worst it's visited twice.
+type blobberMigrationCursor struct {
+	BlobberID string `json:"blobber_id"`
+	Partition int    `json:"partition"`
+}
+
+func blobberMigrationCursorKey(globalKey, blobberID string) datastore.Key {
+	return datastore.Key(globalKey + ":blobbermigrationcursor:" + blobberID)
+}
+
+func (c *blobberMigrationCursor) done() bool {
+	return c.Partition < 0
+}
+
+func (c *blobberMigrationCursor) save(sscKey string, balances cstate.StateContextI) error {
+	_, err := balances.InsertTrieNode(blobberMigrationCursorKey(sscKey, c.BlobberID), c)
+	return err
+}
+
+func (sc *StorageSmartContract) getBlobberMigrationCursor(blobberID string,
+	balances cstate.CommonStateContextI) (*blobberMigrationCursor, error) {
+
+	c := new(blobberMigrationCursor)
+	if err := balances.GetTrieNode(blobberMigrationCursorKey(sc.ID, blobberID), c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// migrateBlobberAllocations records the migrations of the allocations in
+// the partition of the cursor and moves the cursor to the previous
+// partition. The allocations with no replacement matching them keep the
+// blobber until the owner replaces it, the allocations failed to migrate
+// are logged and skipped.
+func (sc *StorageSmartContract) migrateBlobberAllocations(
+	t *transaction.Transaction,
+	blobber *StorageNode,
+	cursor *blobberMigrationCursor,
+	balances cstate.StateContextI,
+) error {
+	conf, err := sc.getConfig(balances, true)
+	if err != nil {
+		return fmt.Errorf("can't get config: %v", err)
+	}
+
+	parts, err := partitionsBlobberAllocations(blobber.ID, balances)
+	if err != nil {
+		return fmt.Errorf("can't get blobber allocations: %v", err)
+	}
+	if size, err := parts.Size(balances); err != nil || size == 0 {
+		cursor.Partition = -1
+		return err
+	}
+	if cursor.Partition >= parts.NumPartitions() {
+		cursor.Partition = parts.NumPartitions() - 1
+	}
+	var nodes []BlobberAllocationNode
+	if err := parts.GetPartitionItems(balances, cursor.Partition, &nodes); err != nil {
+		return fmt.Errorf("can't get blobber allocations: %v", err)
+	}
+	cursor.Partition--
+
+	ready, err := partitionsChallengeReadyBlobbers(balances)
+	if err != nil {
+		return fmt.Errorf("can't get challenge ready blobbers: %v", err)
+	}
+	hashString := encryption.Hash(t.Hash + balances.GetBlock().PrevHash)
+	seed, err := strconv.ParseInt(hashString[0:15], 16, 64)
+	if err != nil {
+		return fmt.Errorf("error in creating seed: %v", err)
+	}
+
+	var (
+		r  = rand.New(rand.NewSource(seed))
+		mr = &migrationReplacements{
+			blobbers: make(map[string]*StorageNode),
+			pools:    make(map[string]*stakePool),
+		}
+		migrations []*BlobberMigration
+	)
+	for _, node := range nodes {
+		m, err := sc.migrateBlobberAllocation(t, node.ID, blobber, mr, ready, r, conf, balances)
+		if err != nil {
+			logging.Logger.Error("migrating blobber allocation",
+				zap.String("blobber", blobber.ID),
+				zap.String("allocation", node.ID),
+				zap.Error(err))
+			continue
+		}
+		if m != nil {
+			migrations = append(migrations, m)
+		}
+	}
+
+	if err := mr.save(balances); err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if err := m.save(sc.ID, balances); err != nil {
+			return fmt.Errorf("saving migration: %v", err)
+		}
+		m.emit(balances)
+	}
+	return nil
+}
+
+// migrateBlobberAllocation selects the replacement of the shut down blobber
+// of the allocation, it returns nil if the allocation is not migrated.
+func (sc *StorageSmartContract) migrateBlobberAllocation(
+	t *transaction.Transaction,
+	allocID string,
+	blobber *StorageNode,
+	mr *migrationReplacements,
+	ready *partitions.Partitions,
+	r *rand.Rand,
+	conf *Config,
+	balances cstate.StateContextI,
+) (*BlobberMigration, error) {
+	alloc, err := sc.getAllocation(allocID, balances)
+	if err != nil {
+		return nil, fmt.Errorf("can't get allocation: %v", err)
+	}
+	if alloc.Finalized || alloc.Canceled || alloc.Expiration < t.CreationDate {
+		return nil, nil
+	}
+	from, ok := alloc.BlobberAllocsMap[blobber.ID]
+	if !ok {
+		return nil, nil
+	}
+	id := blobberMigrationID(alloc.ID, blobber.ID)
+	switch _, err := sc.getBlobberMigration(id, balances); err {
+	case nil:
+		return nil, nil // visited already
+	case util.ErrValueNotPresent:
+	default:
+		return nil, fmt.Errorf("can't get migration: %v", err)
+	}
+
+	to, ba, err := mr.selectReplacement(alloc, blobber.ID, ready, r, conf, t.CreationDate, balances)
+	if err != nil {
+		return nil, fmt.Errorf("selecting replacement: %v", err)
+	}
+	if to == nil {
+		logging.Logger.Info("shutdown_blobber: no replacement",
+			zap.String("blobber", blobber.ID),
+			zap.String("allocation", alloc.ID))
+		return nil, nil
+	}
+
+	return &BlobberMigration{
+		ID:             id,
+		AllocationID:   alloc.ID,
+		FromBlobberID:  blobber.ID,
+		ToBlobberID:    to.ID,
+		Size:           ba.Size,
+		UsedSize:       from.Stats.UsedSize,
+		AllocationRoot: from.AllocationRoot,
+		Terms:          to.Terms,
+		Offer:          ba.Offer(),
+		Created:        t.CreationDate,
+		Deadline:       t.CreationDate + toSeconds(blobberMigrationPeriod),
+		Status:         MigrationPending,
+	}, nil
+}
+
+type migrateBlobberAllocationsRequest struct {
+	BlobberID string `json:"blobber_id"`
+}
+
+func (r *migrateBlobberAllocationsRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.BlobberID == "" {
+		return errors.New("missing blobber_id")
+	}
+	return nil
+}
+
+// migrateBlobberAllocationsNext migrates the next partition of the
+// allocations of the shut down blobber, the first one is migrated by the
+// shutdown. Anyone can do it.
+func (sc *StorageSmartContract) migrateBlobberAllocationsNext(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req migrateBlobberAllocationsRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("migrate_blobber_allocations_failed",
+			"invalid request: "+err.Error())
+	}
+
+	cursor, err := sc.getBlobberMigrationCursor(req.BlobberID, balances)
+	switch err {
+	case nil:
+	case util.ErrValueNotPresent:
+		return "", common.NewError("migrate_blobber_allocations_failed",
+			"no allocations to migrate")
+	default:
+		return "", common.NewError("migrate_blobber_allocations_failed",
+			"can't get migration cursor: "+err.Error())
+	}
+
+	blobber, err := sc.getBlobber(req.BlobberID, balances)
+	if err != nil {
+		return "", common.NewError("migrate_blobber_allocations_failed",
+			"can't get blobber: "+err.Error())
+	}
+	if err := sc.migrateBlobberAllocations(t, blobber, cursor, balances); err != nil {
+		return "", common.NewError("migrate_blobber_allocations_failed", err.Error())
+	}
+
+	if cursor.done() {
+		if _, err := balances.DeleteTrieNode(blobberMigrationCursorKey(sc.ID, cursor.BlobberID)); err != nil {
+			return "", common.NewError("migrate_blobber_allocations_failed",
+				"deleting migration cursor: "+err.Error())
+		}
+	} else if err := cursor.save(sc.ID, balances); err != nil {
+		return "", common.NewError("migrate_blobber_allocations_failed",
+			"saving migration cursor: "+err.Error())
+	}
+	return toJson(cursor), nil
+}
+
+type completeBlobberMigrationRequest struct {
+	MigrationID string       `json:"migration_id"`
+	WriteMarker *WriteMarker `json:"write_marker"`
+}
+
+func (r *completeBlobberMigrationRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	switch {
+	case r.MigrationID == "":
+		return errors.New("missing migration_id")
+	case r.WriteMarker == nil:
+		return errors.New("missing write_marker")
+	}
+	return nil
+}
+
+type expireBlobberMigrationRequest struct {
+	MigrationID string `json:"migration_id"`
+}
+
+func (r *expireBlobberMigrationRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.MigrationID == "" {
+		return errors.New("missing migration_id")
+	}
+	return nil
+}
+
+// completeBlobberMigration replaces the shut down blobber of the allocation
+// with the replacement blobber which claims the migration with the write
+// marker of the migrated data. The shut down blobber stake is slashed by the
+// penalty which is paid to the replacement.
+func (sc *StorageSmartContract) completeBlobberMigration(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	conf, err := sc.getConfig(balances, true)
+	if err != nil {
+		return "", common.NewErrorf("complete_blobber_migration_failed",
+			"can't get config: %v", err)
+	}
+
+	var req completeBlobberMigrationRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"invalid request: "+err.Error())
+	}
+
+	m, err := sc.getBlobberMigration(req.MigrationID, balances)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"can't get migration: "+err.Error())
+	}
+	switch {
+	case m.ToBlobberID != t.ClientID:
+		return "", common.NewError("complete_blobber_migration_failed",
+			"only the replacement blobber can complete the migration")
+	case m.Status != MigrationPending:
+		return "", common.NewError("complete_blobber_migration_failed",
+			"migration is not pending")
+	case m.Deadline < t.CreationDate:
+		return "", common.NewError("complete_blobber_migration_failed",
+			"migration is expired")
+	}
+
+	alloc, err := sc.getAllocation(m.AllocationID, balances)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"can't get allocation: "+err.Error())
+	}
+	if alloc.Finalized || alloc.Canceled {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"allocation is finalized")
+	}
+	from, ok := alloc.BlobberAllocsMap[m.FromBlobberID]
+	if !ok {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"shut down blobber is not in the allocation")
+	}
+	if _, ok := alloc.BlobberAllocsMap[m.ToBlobberID]; ok {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"allocation already has the replacement blobber")
+	}
+
+	wm := req.WriteMarker
+	switch {
+	case !wm.Verify():
+		return "", common.NewError("complete_blobber_migration_failed",
+			"malformed write marker")
+	case wm.AllocationID != alloc.ID || wm.BlobberID != m.ToBlobberID:
+		return "", common.NewError("complete_blobber_migration_failed",
+			"write marker is not for the migration")
+	case wm.ClientID != alloc.Owner:
+		return "", common.NewError("complete_blobber_migration_failed",
+			"write marker is not of the allocation owner")
+	case wm.AllocationRoot != from.AllocationRoot:
+		return "", common.NewError("complete_blobber_migration_failed",
+			"write marker allocation root doesn't match the shut down blobber")
+	case !wm.VerifySignature(alloc.OwnerPublicKey, balances):
+		return "", common.NewError("complete_blobber_migration_failed",
+			"invalid write marker signature")
+	}
+
+	fromBlobber, err := sc.getBlobber(m.FromBlobberID, balances)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"can't get shut down blobber: "+err.Error())
+	}
+	fromSP, err := sc.getStakePool(spenum.Blobber, m.FromBlobberID, balances)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"can't get shut down blobber's stake pool: "+err.Error())
+	}
+	toBlobber, err := sc.getBlobber(m.ToBlobberID, balances)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"can't get replacement blobber: "+err.Error())
+	}
+	toSP, err := sc.getStakePool(spenum.Blobber, m.ToBlobberID, balances)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"can't get replacement blobber's stake pool: "+err.Error())
+	}
+
+	// the replacement allocation at the terms locked on the shutdown
+	terms := toBlobber.Terms
+	toBlobber.Terms = m.Terms
+	ba, err := newBlobberAllocation(m.Size, alloc, toBlobber, t.CreationDate, conf.TimeUnit)
+	toBlobber.Terms = terms
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"can't allocate blobber: "+err.Error())
+	}
+	ba.AllocationRoot = wm.AllocationRoot
+	ba.LastWriteMarker = wm
+	ba.Stats.UsedSize = from.Stats.UsedSize
+	ba.ChallengePoolIntegralValue = from.ChallengePoolIntegralValue
+
+	if err := m.releaseReplacement(toBlobber, toSP); err != nil {
+		return "", common.NewError("complete_blobber_migration_failed", err.Error())
+	}
+	toBlobber.Allocated += ba.Size
+	toBlobber.SavedData += ba.Stats.UsedSize
+	if err := toSP.addOffer(ba.Offer()); err != nil {
+		return "", common.NewError("complete_blobber_migration_failed", err.Error())
+	}
+
+	slash, err := currency.MultFloat64(from.Offer(), blobberMigrationPenalty)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed", err.Error())
+	}
+	m.Penalty, err = fromSP.slash(m.FromBlobberID, from.Offer(), slash, balances, alloc.ID,
+		spenum.BlobberMigrationPenalty)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"slashing shut down blobber: "+err.Error())
+	}
+	if m.Penalty > 0 {
+		err = toSP.DistributeRewards(m.Penalty, m.ToBlobberID, spenum.Blobber,
+			spenum.BlobberMigrationReward, balances, alloc.ID)
+		if err != nil {
+			return "", common.NewError("complete_blobber_migration_failed",
+				"paying the replacement blobber: "+err.Error())
+		}
+	}
+
+	fromBlobber.Allocated -= from.Size
+	if fromBlobber.Allocated < 0 {
+		fromBlobber.Allocated = 0
+	}
+	fromBlobber.SavedData -= from.Stats.UsedSize
+	if fromBlobber.SavedData < 0 {
+		fromBlobber.SavedData = 0
+	}
+	offer := from.Offer()
+	if offer > fromSP.TotalOffers {
+		offer = fromSP.TotalOffers
+	}
+	if err := fromSP.reduceOffer(offer); err != nil {
+		return "", common.NewError("complete_blobber_migration_failed", err.Error())
+	}
+
+	for i, d := range alloc.BlobberAllocs {
+		if d.BlobberID == m.FromBlobberID {
+			alloc.BlobberAllocs[i] = ba
+			break
+		}
+	}
+	delete(alloc.BlobberAllocsMap, m.FromBlobberID)
+	alloc.BlobberAllocsMap[m.ToBlobberID] = ba
+
+	if err := fromSP.Save(spenum.Blobber, m.FromBlobberID, balances); err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"saving shut down blobber's stake pool: "+err.Error())
+	}
+	if err := toSP.Save(spenum.Blobber, m.ToBlobberID, balances); err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"saving replacement blobber's stake pool: "+err.Error())
+	}
+
+	if ba.Stats.UsedSize > 0 {
+		if err := removeAllocationFromBlobberPartitions(balances, m.FromBlobberID, alloc.ID); err != nil {
+			return "", common.NewError("complete_blobber_migration_failed", err.Error())
+		}
+		if err := partitionsBlobberAllocationsAdd(balances, m.ToBlobberID, alloc.ID); err != nil {
+			return "", common.NewError("complete_blobber_migration_failed", err.Error())
+		}
+		if err := sc.updateBlobberChallengeReady(balances, ba, uint64(toBlobber.SavedData)); err != nil {
+			return "", common.NewError("complete_blobber_migration_failed", err.Error())
+		}
+	}
+
+	err = alloc.saveUpdatedAllocation([]*StorageNode{fromBlobber, toBlobber}, balances)
+	if err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"saving allocation: "+err.Error())
+	}
+
+	m.Status = MigrationCompleted
+	if err := m.save(sc.ID, balances); err != nil {
+		return "", common.NewError("complete_blobber_migration_failed",
+			"saving migration: "+err.Error())
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagDeleteAllocationBlobberTerm, t.Hash, []event.AllocationBlobberTerm{
+		{
+			AllocationIdHash: alloc.ID,
+			BlobberID:        m.FromBlobberID,
+		},
+	})
+	emitAddOrOverwriteAllocationBlobberTerms(alloc, balances, t)
+	m.emit(balances)
+	return toJson(m), nil
+}
+
+// expireBlobberMigration releases the capacity reserved on the replacement
+// blobber which didn't complete the migration in time, anyone can do it.
+// The shut down blobber stays in the allocation until the owner replaces it.
+func (sc *StorageSmartContract) expireBlobberMigration(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req expireBlobberMigrationRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("expire_blobber_migration_failed",
+			"invalid request: "+err.Error())
+	}
+
+	m, err := sc.getBlobberMigration(req.MigrationID, balances)
+	if err != nil {
+		return "", common.NewError("expire_blobber_migration_failed",
+			"can't get migration: "+err.Error())
+	}
+	if !m.isExpired(t.CreationDate) {
+		return "", common.NewError("expire_blobber_migration_failed",
+			"migration is not expired")
+	}
+
+	blobber, err := sc.getBlobber(m.ToBlobberID, balances)
+	if err != nil {
+		return "", common.NewError("expire_blobber_migration_failed",
+			"can't get replacement blobber: "+err.Error())
+	}
+	sp, err := sc.getStakePool(spenum.Blobber, m.ToBlobberID, balances)
+	if err != nil {
+		return "", common.NewError("expire_blobber_migration_failed",
+			"can't get replacement blobber's stake pool: "+err.Error())
+	}
+	if err := m.releaseReplacement(blobber, sp); err != nil {
+		return "", common.NewError("expire_blobber_migration_failed", err.Error())
+	}
+	if err := sp.Save(spenum.Blobber, m.ToBlobberID, balances); err != nil {
+		return "", common.NewError("expire_blobber_migration_failed",
+			"saving replacement blobber's stake pool: "+err.Error())
+	}
+	if _, err := balances.InsertTrieNode(blobber.GetKey(), blobber); err != nil {
+		return "", common.NewError("expire_blobber_migration_failed",
+			"saving replacement blobber: "+err.Error())
+	}
+
+	m.Status = MigrationExpired
+	if err := m.save(sc.ID, balances); err != nil {
+		return "", common.NewError("expire_blobber_migration_failed",
+			"saving migration: "+err.Error())
+	}
+
+	emitUpdateBlobberAllocatedSavedHealth(blobber, balances)
+	m.emit(balances)
+	return toJson(m), nil
+}
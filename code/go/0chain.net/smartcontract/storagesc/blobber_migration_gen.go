@@ -0,0 +1,248 @@
+package storagesc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *BlobberMigration) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 13
+	// string "ID"
+	o = append(o, 0x8d, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "AllocationID"
+	o = append(o, 0xac, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44)
+	o = msgp.AppendString(o, z.AllocationID)
+	// string "FromBlobberID"
+	o = append(o, 0xad, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x62, 0x62, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.FromBlobberID)
+	// string "ToBlobberID"
+	o = append(o, 0xab, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x62, 0x62, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ToBlobberID)
+	// string "Size"
+	o = append(o, 0xa4, 0x53, 0x69, 0x7a, 0x65)
+	o = msgp.AppendInt64(o, z.Size)
+	// string "UsedSize"
+	o = append(o, 0xa8, 0x55, 0x73, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65)
+	o = msgp.AppendInt64(o, z.UsedSize)
+	// string "AllocationRoot"
+	o = append(o, 0xae, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74)
+	o = msgp.AppendString(o, z.AllocationRoot)
+	// string "Terms"
+	o = append(o, 0xa5, 0x54, 0x65, 0x72, 0x6d, 0x73)
+	o, err = z.Terms.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Terms")
+		return
+	}
+	// string "Offer"
+	o = append(o, 0xa5, 0x4f, 0x66, 0x66, 0x65, 0x72)
+	o, err = z.Offer.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Offer")
+		return
+	}
+	// string "Created"
+	o = append(o, 0xa7, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64)
+	o, err = z.Created.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Created")
+		return
+	}
+	// string "Deadline"
+	o = append(o, 0xa8, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65)
+	o, err = z.Deadline.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Deadline")
+		return
+	}
+	// string "Status"
+	o = append(o, 0xa6, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73)
+	o = msgp.AppendInt(o, z.Status)
+	// string "Penalty"
+	o = append(o, 0xa7, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79)
+	o, err = z.Penalty.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Penalty")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *BlobberMigration) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "AllocationID":
+			z.AllocationID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AllocationID")
+				return
+			}
+		case "FromBlobberID":
+			z.FromBlobberID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FromBlobberID")
+				return
+			}
+		case "ToBlobberID":
+			z.ToBlobberID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ToBlobberID")
+				return
+			}
+		case "Size":
+			z.Size, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Size")
+				return
+			}
+		case "UsedSize":
+			z.UsedSize, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "UsedSize")
+				return
+			}
+		case "AllocationRoot":
+			z.AllocationRoot, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AllocationRoot")
+				return
+			}
+		case "Terms":
+			bts, err = z.Terms.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Terms")
+				return
+			}
+		case "Offer":
+			bts, err = z.Offer.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Offer")
+				return
+			}
+		case "Created":
+			bts, err = z.Created.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Created")
+				return
+			}
+		case "Deadline":
+			bts, err = z.Deadline.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Deadline")
+				return
+			}
+		case "Status":
+			z.Status, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		case "Penalty":
+			bts, err = z.Penalty.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Penalty")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *BlobberMigration) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 13 + msgp.StringPrefixSize + len(z.AllocationID) + 14 + msgp.StringPrefixSize + len(z.FromBlobberID) + 12 + msgp.StringPrefixSize + len(z.ToBlobberID) + 5 + msgp.Int64Size + 9 + msgp.Int64Size + 15 + msgp.StringPrefixSize + len(z.AllocationRoot) + 6 + z.Terms.Msgsize() + 6 + z.Offer.Msgsize() + 8 + z.Created.Msgsize() + 9 + z.Deadline.Msgsize() + 7 + msgp.IntSize + 8 + z.Penalty.Msgsize()
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z blobberMigrationCursor) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "BlobberID"
+	o = append(o, 0x82, 0xa9, 0x42, 0x6c, 0x6f, 0x62, 0x62, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.BlobberID)
+	// string "Partition"
+	o = append(o, 0xa9, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendInt(o, z.Partition)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *blobberMigrationCursor) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "BlobberID":
+			z.BlobberID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BlobberID")
+				return
+			}
+		case "Partition":
+			z.Partition, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Partition")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z blobberMigrationCursor) Msgsize() (s int) {
+	s = 1 + 10 + msgp.StringPrefixSize + len(z.BlobberID) + 10 + msgp.IntSize
+	return
+}
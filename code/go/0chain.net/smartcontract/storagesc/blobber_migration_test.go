@@ -0,0 +1,151 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/provider"
+	"github.com/0chain/common/core/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobberMigration(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		client   = newClient(1000*x10, balances)
+		now      = int64(1000)
+		blobbers []*Client
+	)
+	setConfig(t, balances)
+
+	for i := 0; i < 6; i++ {
+		blobbers = append(blobbers, addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances))
+	}
+	nar := &newAllocationRequest{
+		DataShards:      2,
+		ParityShards:    2,
+		Owner:           client.id,
+		OwnerPublicKey:  client.pk,
+		ReadPriceRange:  PriceRange{1 * x10, 10 * x10},
+		WritePriceRange: PriceRange{2 * x10, 20 * x10},
+		Size:            2 * GB,
+	}
+	for _, b := range blobbers[:4] {
+		nar.Blobbers = append(nar.Blobbers, b.id)
+	}
+	resp, err := nar.callNewAllocReq(t, client.id, 100*x10, ssc, now, balances)
+	require.NoError(t, err)
+	var out NewAllocationTxnOutput
+	require.NoError(t, json.Unmarshal([]byte(resp), &out))
+
+	// the data written to the blobber to shut down
+	from := blobbers[0]
+	alloc, err := ssc.getAllocation(out.ID, balances)
+	require.NoError(t, err)
+	ba := alloc.BlobberAllocsMap[from.id]
+	ba.AllocationRoot = encryption.Hash("allocation root")
+	ba.Stats.UsedSize = GB
+	require.NoError(t, alloc.save(balances, ssc.ID))
+
+	// missing allocations fill the first partition of the blobber allocations
+	// and follow the allocation in the second one, they fail to migrate and
+	// don't fail the shutdown
+	addMissing := func(i int) {
+		missing := encryption.Hash(fmt.Sprintf("missing allocation %d", i))
+		require.NoError(t, partitionsBlobberAllocationsAdd(balances, from.id, missing))
+	}
+	for i := 0; i < blobberAllocationPartitionSize; i++ {
+		addMissing(i)
+	}
+	require.NoError(t, partitionsBlobberAllocationsAdd(balances, from.id, alloc.ID))
+	addMissing(blobberAllocationPartitionSize)
+	for _, b := range []*Client{from, blobbers[4], blobbers[5]} {
+		require.NoError(t, partitionsChallengeReadyBlobberAddOrUpdate(balances, b.id, 1))
+	}
+
+	tx := newTransaction(from.id, ADDRESS, 0, now+1)
+	balances.setTransaction(t, tx)
+	_, err = ssc.shutdownBlobber(tx, (&provider.ProviderRequest{ID: from.id}).Encode(), balances)
+	require.NoError(t, err)
+
+	m, err := ssc.getBlobberMigration(blobberMigrationID(alloc.ID, from.id), balances)
+	require.NoError(t, err)
+	require.Equal(t, MigrationPending, m.Status)
+	require.Equal(t, ba.AllocationRoot, m.AllocationRoot)
+	require.Contains(t, []string{blobbers[4].id, blobbers[5].id}, m.ToBlobberID)
+	to, err := ssc.getBlobber(m.ToBlobberID, balances)
+	require.NoError(t, err)
+	require.Equal(t, alloc.bSize(), to.Allocated)
+
+	// the last partition is migrated on the shutdown, the rest is left to
+	// the next transactions
+	cursor, err := ssc.getBlobberMigrationCursor(from.id, balances)
+	require.NoError(t, err)
+	require.Equal(t, 0, cursor.Partition)
+	migrateNext := func() error {
+		tx := newTransaction(client.id, ADDRESS, 0, now+1)
+		balances.setTransaction(t, tx)
+		_, err := ssc.migrateBlobberAllocationsNext(tx, mustEncode(t, &migrateBlobberAllocationsRequest{
+			BlobberID: from.id,
+		}), balances)
+		return err
+	}
+	require.NoError(t, migrateNext())
+	_, err = ssc.getBlobberMigrationCursor(from.id, balances)
+	require.Equal(t, util.ErrValueNotPresent, err)
+	require.EqualError(t, migrateNext(), "migrate_blobber_allocations_failed: no allocations to migrate")
+
+	complete := func(root string, signer *Client) error {
+		wm := &WriteMarker{
+			AllocationRoot: root,
+			AllocationID:   alloc.ID,
+			BlobberID:      m.ToBlobberID,
+			Timestamp:      tx.CreationDate,
+			ClientID:       client.id,
+		}
+		wm.Signature, err = signer.scheme.Sign(encryption.Hash(wm.GetHashData()))
+		require.NoError(t, err)
+		tx := newTransaction(m.ToBlobberID, ADDRESS, 0, now+2)
+		balances.setTransaction(t, tx)
+		_, err := ssc.completeBlobberMigration(tx, mustEncode(t, &completeBlobberMigrationRequest{
+			MigrationID: m.ID,
+			WriteMarker: wm,
+		}), balances)
+		return err
+	}
+
+	err = complete(encryption.Hash("other root"), client)
+	require.EqualError(t, err, "complete_blobber_migration_failed: "+
+		"write marker allocation root doesn't match the shut down blobber")
+	err = complete(ba.AllocationRoot, from)
+	require.EqualError(t, err, "complete_blobber_migration_failed: invalid write marker signature")
+	require.NoError(t, complete(ba.AllocationRoot, client))
+
+	alloc, err = ssc.getAllocation(out.ID, balances)
+	require.NoError(t, err)
+	require.NotContains(t, alloc.BlobberAllocsMap, from.id)
+	migrated := alloc.BlobberAllocsMap[m.ToBlobberID]
+	require.NotNil(t, migrated)
+	require.Equal(t, ba.AllocationRoot, migrated.AllocationRoot)
+	require.EqualValues(t, GB, migrated.Stats.UsedSize)
+
+	m, err = ssc.getBlobberMigration(m.ID, balances)
+	require.NoError(t, err)
+	require.Equal(t, MigrationCompleted, m.Status)
+	require.NotZero(t, m.Penalty)
+	to, err = ssc.getBlobber(m.ToBlobberID, balances)
+	require.NoError(t, err)
+	require.Equal(t, migrated.Size, to.Allocated)
+	require.EqualValues(t, GB, to.SavedData)
+
+	require.EqualError(t, complete(ba.AllocationRoot, client),
+		"complete_blobber_migration_failed: migration is not pending")
+	expire := newTransaction(client.id, ADDRESS, 0, now+3)
+	_, err = ssc.expireBlobberMigration(expire, mustEncode(t, &expireBlobberMigrationRequest{
+		MigrationID: m.ID,
+	}), balances)
+	require.EqualError(t, err, "expire_blobber_migration_failed: migration is not expired")
+}
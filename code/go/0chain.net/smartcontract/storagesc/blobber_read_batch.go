@@ -0,0 +1,328 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+)
+
+// maxReadBatchSize is the max number of read markers redeemed by a
+// transaction
+const maxReadBatchSize = 100
+
+type readBatchRequest struct {
+	ReadMarkers []*ReadMarker `json:"read_markers"`
+}
+
+func (r *readBatchRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	switch {
+	case len(r.ReadMarkers) == 0:
+		return errors.New("no read markers")
+	case len(r.ReadMarkers) > maxReadBatchSize:
+		return fmt.Errorf("too many read markers, max %d", maxReadBatchSize)
+	}
+	for i, rm := range r.ReadMarkers {
+		if rm == nil {
+			return fmt.Errorf("read marker %d is missing", i)
+		}
+	}
+	return nil
+}
+
+type readBatchResponse struct {
+	Redeemed int                      `json:"redeemed"`
+	Redeems  map[string]currency.Coin `json:"redeems"` // by blobber
+}
+
+// verifyReadMarkersSignatures verifies the signatures of the read markers
+// at once using the BLS aggregate signature
+func verifyReadMarkersSignatures(rms []*ReadMarker, balances cstate.StateContextI) error {
+	aggScheme := encryption.GetAggregateSignatureScheme(encryption.SignatureSchemeBls0chain,
+		len(rms), len(rms))
+	for i, rm := range rms {
+		if err := rm.VerifyClientID(); err != nil {
+			return fmt.Errorf("read marker %d: %v", i, err)
+		}
+		scheme := balances.GetSignatureScheme()
+		if err := scheme.SetPublicKey(rm.ClientPublicKey); err != nil {
+			return fmt.Errorf("read marker %d: %v", i, err)
+		}
+		err := aggScheme.Aggregate(scheme, i, rm.Signature, encryption.Hash(rm.GetHashData()))
+		if err != nil {
+			return fmt.Errorf("read marker %d: %v", i, err)
+		}
+	}
+	if _, err := aggScheme.Verify(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// readBatch keeps the objects touched by the redeemed read markers to save
+// each of them once, the ids keep the order of the first use
+type readBatch struct {
+	allocs     map[string]*StorageAllocation
+	allocIDs   []string
+	blobbers   map[string]*StorageNode
+	blobberIDs []string
+	stakePools map[string]*stakePool
+	readPools  map[string]*readPool
+	clientIDs  []string
+	readSizes  map[string]float64 // in GB, by blobber
+	redeems    map[string]currency.Coin
+}
+
+func newReadBatch() *readBatch {
+	return &readBatch{
+		allocs:     make(map[string]*StorageAllocation),
+		blobbers:   make(map[string]*StorageNode),
+		stakePools: make(map[string]*stakePool),
+		readPools:  make(map[string]*readPool),
+		readSizes:  make(map[string]float64),
+		redeems:    make(map[string]currency.Coin),
+	}
+}
+
+func (rb *readBatch) getAllocation(sc *StorageSmartContract, id string,
+	balances cstate.StateContextI) (*StorageAllocation, error) {
+
+	if alloc, ok := rb.allocs[id]; ok {
+		return alloc, nil
+	}
+	alloc, err := sc.getAllocation(id, balances)
+	if err != nil {
+		return nil, err
+	}
+	rb.allocs[id] = alloc
+	rb.allocIDs = append(rb.allocIDs, id)
+	return alloc, nil
+}
+
+func (rb *readBatch) getBlobber(sc *StorageSmartContract, id string,
+	balances cstate.StateContextI) (*StorageNode, *stakePool, error) {
+
+	if blobber, ok := rb.blobbers[id]; ok {
+		return blobber, rb.stakePools[id], nil
+	}
+	blobber, err := sc.getBlobber(id, balances)
+	if err != nil {
+		return nil, nil, err
+	}
+	sp, err := sc.getStakePool(spenum.Blobber, id, balances)
+	if err != nil {
+		return nil, nil, err
+	}
+	rb.blobbers[id] = blobber
+	rb.stakePools[id] = sp
+	rb.blobberIDs = append(rb.blobberIDs, id)
+	return blobber, sp, nil
+}
+
+func (rb *readBatch) getReadPool(sc *StorageSmartContract, clientID string,
+	balances cstate.StateContextI) (*readPool, error) {
+
+	if rp, ok := rb.readPools[clientID]; ok {
+		return rp, nil
+	}
+	rp, err := sc.getReadPool(clientID, balances)
+	switch err {
+	case nil:
+	case util.ErrValueNotPresent:
+		rp = new(readPool)
+	default:
+		return nil, err
+	}
+	rb.readPools[clientID] = rp
+	rb.clientIDs = append(rb.clientIDs, clientID)
+	return rp, nil
+}
+
+// readBatchMarkerID is the transaction id of the read marker in the events
+// db, it's unique per read marker
+func readBatchMarkerID(txnHash string, i int) string {
+	return fmt.Sprintf("%s:%d", txnHash, i)
+}
+
+// redeem the i-th read marker, the signature is verified already
+func (rb *readBatch) redeem(
+	sc *StorageSmartContract,
+	conf *Config,
+	i int,
+	rm *ReadMarker,
+	t *transaction.Transaction,
+	balances cstate.StateContextI,
+) error {
+	var (
+		commitRead      = &ReadConnection{ReadMarker: rm}
+		lastCommittedRM = &ReadConnection{}
+		lastKnownCtr    int64
+	)
+	err := balances.GetTrieNode(commitRead.GetKey(sc.ID), lastCommittedRM)
+	switch err {
+	case nil:
+		lastKnownCtr = lastCommittedRM.ReadMarker.ReadCounter
+	case util.ErrValueNotPresent:
+	default:
+		return fmt.Errorf("can't get latest blobber client read: %v", err)
+	}
+
+	if err := rm.validate(lastCommittedRM.ReadMarker); err != nil {
+		return fmt.Errorf("can't verify read marker: %v", err)
+	}
+
+	alloc, err := rb.getAllocation(sc, rm.AllocationID, balances)
+	if err != nil {
+		return fmt.Errorf("can't get related allocation: %v", err)
+	}
+	if rm.Timestamp < alloc.StartTime {
+		return errors.New("early reading, allocation not started yet")
+	} else if rm.Timestamp > alloc.Until(conf.MaxChallengeCompletionTime) {
+		return errors.New("late reading, allocation expired")
+	}
+
+	details, ok := alloc.BlobberAllocsMap[rm.BlobberID]
+	if !ok {
+		return errors.New("blobber doesn't belong to allocation")
+	}
+
+	blobber, sp, err := rb.getBlobber(sc, rm.BlobberID, balances)
+	if err != nil {
+		return fmt.Errorf("can't get blobber: %v", err)
+	}
+
+	rp, err := rb.getReadPool(sc, rm.ClientID, balances)
+	if err != nil {
+		return fmt.Errorf("can't get related read pool: %v", err)
+	}
+
+	var (
+		numReads = rm.ReadCounter - lastKnownCtr
+		sizeRead = sizeInGB(numReads * CHUNK_SIZE)
+		value    = currency.Coin(float64(details.Terms.ReadPrice) * sizeRead)
+	)
+	rm.ReadSize = sizeRead
+
+	details.Stats.NumReads++
+	alloc.Stats.NumReads++
+
+	if _, err := rp.moveToBlobber(rm.AllocationID, rm.BlobberID, sp, value, balances); err != nil {
+		return fmt.Errorf("can't transfer tokens from read pool to stake pool: %v", err)
+	}
+	if details.ReadReward, err = currency.AddCoin(details.ReadReward, value); err != nil {
+		return err
+	}
+	if details.Spent, err = currency.AddCoin(details.Spent, value); err != nil {
+		return err
+	}
+	if rb.redeems[rm.BlobberID], err = currency.AddCoin(rb.redeems[rm.BlobberID], value); err != nil {
+		return err
+	}
+
+	blobber.addDataRead(sizeRead, conf, balances)
+	rb.readSizes[rm.BlobberID] += sizeRead
+
+	// the next marker of the same client, blobber and allocation is
+	// verified against this one
+	if _, err := balances.InsertTrieNode(commitRead.GetKey(sc.ID), commitRead); err != nil {
+		return fmt.Errorf("saving read marker: %v", err)
+	}
+	id := readBatchMarkerID(t.Hash, i)
+	balances.EmitEvent(event.TypeStats, event.TagAddReadMarker, id, readMarkerToReadMarkerTable(rm, id))
+	return nil
+}
+
+// save the touched objects, a read stat event per blobber
+func (rb *readBatch) save(sc *StorageSmartContract, conf *Config, balances cstate.StateContextI) error {
+	for _, id := range rb.blobberIDs {
+		blobber := rb.blobbers[id]
+		if err := updateBlobberRewardDataRead(blobber, conf, balances); err != nil {
+			return err
+		}
+		if err := rb.stakePools[id].Save(spenum.Blobber, id, balances); err != nil {
+			return fmt.Errorf("can't save stake pool: %v", err)
+		}
+		if _, err := balances.InsertTrieNode(blobber.GetKey(), blobber); err != nil {
+			return fmt.Errorf("can't save blobber: %v", err)
+		}
+		readData, _ := big.NewFloat(rb.readSizes[id]).Int64()
+		balances.EmitEvent(event.TypeStats, event.TagUpdateBlobberStat, id, event.Blobber{
+			Provider: event.Provider{ID: id},
+			ReadData: readData,
+		})
+	}
+
+	for _, clientID := range rb.clientIDs {
+		rp := rb.readPools[clientID]
+		if err := rp.save(sc.ID, clientID, balances); err != nil {
+			return fmt.Errorf("can't save read pool: %v", err)
+		}
+		balances.EmitEvent(event.TypeStats, event.TagUpdateReadpool, clientID, event.ReadPool{
+			UserID:  clientID,
+			Balance: rp.Balance,
+		})
+	}
+
+	for _, id := range rb.allocIDs {
+		alloc := rb.allocs[id]
+		if err := alloc.save(balances, sc.ID); err != nil {
+			return fmt.Errorf("can't save allocation: %v", err)
+		}
+		balances.EmitEvent(event.TypeStats, event.TagUpdateAllocation, alloc.ID, alloc.buildDbUpdates())
+	}
+	return nil
+}
+
+// commitBlobberReadBatch redeems many read markers, of any allocations, in
+// one transaction. The signatures are verified at once and each read pool,
+// blobber and allocation is saved once.
+func (sc *StorageSmartContract) commitBlobberReadBatch(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	conf, err := sc.getConfig(balances, true)
+	if err != nil {
+		return "", common.NewErrorf("commit_blobber_read_batch",
+			"cannot get config: %v", err)
+	}
+
+	var req readBatchRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("commit_blobber_read_batch",
+			"invalid request: "+err.Error())
+	}
+
+	if err := verifyReadMarkersSignatures(req.ReadMarkers, balances); err != nil {
+		return "", common.NewError("commit_blobber_read_batch",
+			"invalid read marker signatures: "+err.Error())
+	}
+
+	rb := newReadBatch()
+	for i, rm := range req.ReadMarkers {
+		if err := rb.redeem(sc, conf, i, rm, t, balances); err != nil {
+			return "", common.NewErrorf("commit_blobber_read_batch",
+				"read marker %d: %v", i, err)
+		}
+	}
+	if err := rb.save(sc, conf, balances); err != nil {
+		return "", common.NewError("commit_blobber_read_batch", err.Error())
+	}
+
+	return toJson(&readBatchResponse{
+		Redeemed: len(req.ReadMarkers),
+		Redeems:  rb.redeems,
+	}), nil
+}
@@ -0,0 +1,131 @@
+package storagesc
+
+import (
+	"testing"
+
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitBlobberReadBatch(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		client   = newClient(2010*x10, balances)
+		tp       = int64(0)
+		err      error
+	)
+
+	setConfig(t, balances)
+
+	tp += 100
+	allocID1, _ := addAllocation(t, ssc, client, tp, 0, balances)
+	tp += 100
+	allocID2, _ := addAllocation(t, ssc, client, tp, 0, balances)
+
+	alloc1, err := ssc.getAllocation(allocID1, balances)
+	require.NoError(t, err)
+	alloc2, err := ssc.getAllocation(allocID2, balances)
+	require.NoError(t, err)
+
+	// read pool lock
+	tp += 100
+	readPoolFund, err := currency.ParseZCN(4)
+	require.NoError(t, err)
+	tx := newTransaction(client.id, ssc.ID, readPoolFund, tp)
+	balances.setTransaction(t, tx)
+	_, err = ssc.readPoolLock(tx, mustEncode(t, &readPoolLockRequest{
+		TargetId: client.id,
+	}), balances)
+	require.NoError(t, err)
+
+	tp += 100
+	newReadMarker := func(alloc *StorageAllocation, blobberID string) *ReadMarker {
+		rm := &ReadMarker{
+			ClientID:        client.id,
+			ClientPublicKey: client.pk,
+			BlobberID:       blobberID,
+			AllocationID:    alloc.ID,
+			OwnerID:         client.id,
+			Timestamp:       common.Timestamp(tp),
+			ReadCounter:     1 * GB / (64 * KB),
+		}
+		rm.Signature, err = client.scheme.Sign(encryption.Hash(rm.GetHashData()))
+		require.NoError(t, err)
+		return rm
+	}
+	rms := []*ReadMarker{
+		newReadMarker(alloc1, alloc1.BlobberAllocs[0].BlobberID),
+		newReadMarker(alloc1, alloc1.BlobberAllocs[1].BlobberID),
+		newReadMarker(alloc2, alloc2.BlobberAllocs[0].BlobberID),
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		tx := newTransaction(client.id, ssc.ID, 0, tp)
+		_, err := ssc.commitBlobberReadBatch(tx, mustEncode(t, &readBatchRequest{}), balances)
+		require.EqualError(t, err, "commit_blobber_read_batch: invalid request: no read markers")
+
+		tooMany := make([]*ReadMarker, maxReadBatchSize+1)
+		for i := range tooMany {
+			tooMany[i] = rms[0]
+		}
+		_, err = ssc.commitBlobberReadBatch(tx, mustEncode(t, &readBatchRequest{ReadMarkers: tooMany}), balances)
+		require.EqualError(t, err, "commit_blobber_read_batch: invalid request: too many read markers, max 100")
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		forged := *rms[2]
+		forged.ReadCounter *= 2
+		tx := newTransaction(client.id, ssc.ID, 0, tp)
+		balances.setTransaction(t, tx)
+		_, err := ssc.commitBlobberReadBatch(tx, mustEncode(t, &readBatchRequest{
+			ReadMarkers: []*ReadMarker{rms[0], rms[1], &forged},
+		}), balances)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid read marker signatures")
+
+		rp, err := ssc.getReadPool(client.id, balances)
+		require.NoError(t, err)
+		require.EqualValues(t, readPoolFund, rp.Balance)
+	})
+
+	t.Run("redeem", func(t *testing.T) {
+		tx := newTransaction(client.id, ssc.ID, 0, tp)
+		balances.setTransaction(t, tx)
+		resp, err := ssc.commitBlobberReadBatch(tx, mustEncode(t, &readBatchRequest{
+			ReadMarkers: rms,
+		}), balances)
+		require.NoError(t, err)
+		require.Contains(t, resp, `"redeemed":3`)
+
+		rp, err := ssc.getReadPool(client.id, balances)
+		require.NoError(t, err)
+		require.EqualValues(t, readPoolFund-3e10, rp.Balance)
+
+		for _, rm := range rms {
+			var rc ReadConnection
+			err := balances.GetTrieNode((&ReadConnection{ReadMarker: rm}).GetKey(ssc.ID), &rc)
+			require.NoError(t, err)
+			require.Equal(t, rm.ReadCounter, rc.ReadMarker.ReadCounter)
+
+			b, err := ssc.getBlobber(rm.BlobberID, balances)
+			require.NoError(t, err)
+			require.EqualValues(t, 1, b.DataReadLastRewardRound) // GB
+		}
+
+		alloc, err := ssc.getAllocation(allocID1, balances)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, alloc.Stats.NumReads)
+
+		// the same markers are not paid twice
+		_, err = ssc.commitBlobberReadBatch(tx, mustEncode(t, &readBatchRequest{
+			ReadMarkers: rms,
+		}), balances)
+		require.NoError(t, err)
+		rp, err = ssc.getReadPool(client.id, balances)
+		require.NoError(t, err)
+		require.EqualValues(t, readPoolFund-3e10, rp.Balance)
+	})
+}
@@ -0,0 +1,96 @@
+package storagesc
+
+import (
+	"time"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/dbs/event"
+	"github.com/0chain/common/core/util"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+// reputationPrior is the number of passed and failed challenges a new
+// blobber starts with, so a single challenge doesn't make or break it
+const reputationPrior = 1
+
+// BlobberReputation is the blobber track record its reputation score is
+// computed from. Uptime and Downtime are in seconds.
+type BlobberReputation struct {
+	ChallengesPassed int64 `json:"challenges_passed"`
+	ChallengesFailed int64 `json:"challenges_failed"`
+	Uptime           int64 `json:"uptime"`
+	Downtime         int64 `json:"downtime"`
+}
+
+// challengeScore is the share of passed challenges
+func (r *BlobberReputation) challengeScore() float64 {
+	return float64(r.ChallengesPassed+reputationPrior) /
+		float64(r.ChallengesPassed+r.ChallengesFailed+2*reputationPrior)
+}
+
+// uptimeScore is the share of time the blobber was sending health checks
+func (r *BlobberReputation) uptimeScore() float64 {
+	if r.Uptime+r.Downtime <= 0 {
+		return 1
+	}
+	return float64(r.Uptime) / float64(r.Uptime+r.Downtime)
+}
+
+// addHealthCheck accounts the time since the previous health check, all of
+// it above the health check period is a downtime
+func (r *BlobberReputation) addHealthCheck(last, now common.Timestamp, period time.Duration) {
+	if last <= 0 || now <= last {
+		return
+	}
+	var (
+		elapsed  = int64(now - last)
+		downtime int64
+	)
+	if p := int64(toSeconds(period)); elapsed > p {
+		downtime = elapsed - p
+	}
+	r.Uptime += elapsed - downtime
+	r.Downtime += downtime
+}
+
+// ReputationScore of the blobber from 0 to 1, killed and shut down blobbers
+// have no reputation.
+func (sn *StorageNode) ReputationScore() float64 {
+	if sn.IsKilled() || sn.IsShutDown() {
+		return 0
+	}
+	return sn.Reputation.challengeScore() * sn.Reputation.uptimeScore()
+}
+
+// updateBlobberReputation applies the update to the blobber reputation and
+// saves the blobber, removed blobbers are skipped.
+func (sc *StorageSmartContract) updateBlobberReputation(
+	blobberID string,
+	update func(*BlobberReputation),
+	balances cstate.StateContextI,
+) error {
+	blobber, err := sc.getBlobber(blobberID, balances)
+	switch err {
+	case nil:
+	case util.ErrValueNotPresent:
+		return nil
+	default:
+		return err
+	}
+
+	update(&blobber.Reputation)
+	if _, err := balances.InsertTrieNode(blobber.GetKey(), blobber); err != nil {
+		return err
+	}
+	emitUpdateBlobberReputation(blobber, balances)
+	return nil
+}
+
+func emitUpdateBlobberReputation(sn *StorageNode, balances cstate.StateContextI) {
+	balances.EmitEvent(event.TypeStats, event.TagUpdateBlobberReputation, sn.ID, event.Blobber{
+		Provider:   event.Provider{ID: sn.ID},
+		Reputation: sn.ReputationScore(),
+	})
+}
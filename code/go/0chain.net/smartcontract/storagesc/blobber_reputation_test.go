@@ -0,0 +1,54 @@
+package storagesc
+
+import (
+	"testing"
+	"time"
+
+	"0chain.net/smartcontract/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobberReputationScore(t *testing.T) {
+	sn := &StorageNode{}
+	require.Equal(t, 0.5, sn.ReputationScore())
+
+	sn.Reputation = BlobberReputation{ChallengesPassed: 7, ChallengesFailed: 1}
+	require.Equal(t, 0.8, sn.ReputationScore())
+
+	sn.Reputation.Uptime = 300
+	sn.Reputation.Downtime = 100
+	require.InDelta(t, 0.6, sn.ReputationScore(), 1e-9)
+
+	sn.Provider = provider.Provider{HasBeenShutDown: true}
+	require.Zero(t, sn.ReputationScore())
+}
+
+func TestBlobberReputationHealthCheck(t *testing.T) {
+	var r BlobberReputation
+	// the first health check
+	r.addHealthCheck(0, 100, time.Minute)
+	require.Equal(t, BlobberReputation{}, r)
+
+	r.addHealthCheck(100, 150, time.Minute)
+	require.Equal(t, BlobberReputation{Uptime: 50}, r)
+
+	r.addHealthCheck(150, 400, time.Minute)
+	require.Equal(t, BlobberReputation{Uptime: 110, Downtime: 190}, r)
+}
+
+func TestBlobberMinReputation(t *testing.T) {
+	var (
+		conf = setConfig(t, newTestBalances(t, false))
+		sa   = &StorageAllocation{DataShards: 1, Size: 1, MinReputation: 0.7}
+		sn   = &StorageNode{
+			Provider: provider.Provider{ID: "blobber", LastHealthCheck: 100},
+			Capacity: 10 * GB,
+		}
+	)
+
+	err := sa.isActive(sn, 0, 0, conf, 100)
+	require.EqualError(t, err, "blobber blobber reputation 0.5 is below the required 0.7")
+
+	sn.Reputation.ChallengesPassed = 8
+	require.NoError(t, sa.isActive(sn, 0, 0, conf, 100))
+}
@@ -0,0 +1,483 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+)
+
+//msgp:ignore reserveCapacityRequest forfeitReservationRequest allocationReservations
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+const (
+	ReservationActive = iota
+	ReservationConverted
+	ReservationForfeited
+)
+
+const (
+	// reservationGracePeriod after the end of the reservation the deposit
+	// can't be forfeited yet
+	reservationGracePeriod = 24 * time.Hour
+	// maxReservationAdvance is how far in the future a reservation can start
+	maxReservationAdvance = 365 * 24 * time.Hour
+	// maxReservationWindow is the longest reservation
+	maxReservationWindow = 30 * 24 * time.Hour
+	// reservationMinDeposit is the least deposit, a share of the reserved
+	// capacity write price
+	reservationMinDeposit = 0.1
+)
+
+// CapacityReservation holds the capacity of the blobber for the client at
+// the terms of the reservation time. The reservation is converted into an
+// allocation from StartTime to EndTime, the deposit goes to the allocation
+// write pool then. The deposit is forfeited to the blobber after EndTime and
+// the grace period. The reserved capacity counts as allocated on the blobber
+// and its write price as an offer of the blobber stake pool.
+type CapacityReservation struct {
+	ID        string           `json:"id"`
+	ClientID  string           `json:"client_id"`
+	BlobberID string           `json:"blobber_id"`
+	Size      int64            `json:"size"`
+	StartTime common.Timestamp `json:"start_time"`
+	EndTime   common.Timestamp `json:"end_time"`
+	Terms     Terms            `json:"terms"` // locked
+	Deposit   currency.Coin    `json:"deposit"`
+	Status    int              `json:"status"`
+	// AllocationID the reservation is converted into.
+	AllocationID string `json:"allocation_id,omitempty"`
+}
+
+func capacityReservationKey(globalKey, id string) datastore.Key {
+	return datastore.Key(globalKey + ":capacityreservation:" + id)
+}
+
+func (cr *CapacityReservation) GetKey(globalKey string) datastore.Key {
+	return capacityReservationKey(globalKey, cr.ID)
+}
+
+// offer of the blobber stake pool for the reserved capacity
+func (cr *CapacityReservation) offer() currency.Coin {
+	return currency.Coin(sizeInGB(cr.Size) * float64(cr.Terms.WritePrice))
+}
+
+func (cr *CapacityReservation) isConvertible(now common.Timestamp) bool {
+	return cr.Status == ReservationActive && cr.StartTime <= now && now <= cr.EndTime
+}
+
+func (cr *CapacityReservation) isForfeitable(now common.Timestamp) bool {
+	return cr.Status == ReservationActive && cr.EndTime+toSeconds(reservationGracePeriod) < now
+}
+
+func (cr *CapacityReservation) save(sscKey string, balances cstate.StateContextI) error {
+	_, err := balances.InsertTrieNode(cr.GetKey(sscKey), cr)
+	return err
+}
+
+func (cr *CapacityReservation) emit(balances cstate.StateContextI) {
+	balances.EmitEvent(event.TypeStats, event.TagAddOrOverwriteCapacityReservation, cr.ID,
+		[]event.CapacityReservation{{
+			ReservationID: cr.ID,
+			ClientID:      cr.ClientID,
+			BlobberID:     cr.BlobberID,
+			Size:          cr.Size,
+			StartTime:     int64(cr.StartTime),
+			EndTime:       int64(cr.EndTime),
+			ReadPrice:     cr.Terms.ReadPrice,
+			WritePrice:    cr.Terms.WritePrice,
+			Deposit:       cr.Deposit,
+			Status:        cr.Status,
+			AllocationID:  cr.AllocationID,
+		}})
+}
+
+func (sc *StorageSmartContract) getCapacityReservation(id string,
+	balances cstate.CommonStateContextI) (*CapacityReservation, error) {
+
+	cr := new(CapacityReservation)
+	if err := balances.GetTrieNode(capacityReservationKey(sc.ID, id), cr); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+type reserveCapacityRequest struct {
+	BlobberID string           `json:"blobber_id"`
+	Size      int64            `json:"size"`
+	StartTime common.Timestamp `json:"start_time"`
+	EndTime   common.Timestamp `json:"end_time"`
+}
+
+func (r *reserveCapacityRequest) decode(input []byte) error {
+	return json.Unmarshal(input, r)
+}
+
+func (r *reserveCapacityRequest) validate(now common.Timestamp) error {
+	switch {
+	case r.BlobberID == "":
+		return errors.New("missing blobber_id")
+	case r.Size <= 0:
+		return errors.New("invalid size")
+	case r.StartTime < now:
+		return errors.New("start_time is in the past")
+	case r.StartTime > now+toSeconds(maxReservationAdvance):
+		return fmt.Errorf("start_time is more than %v ahead", maxReservationAdvance)
+	case r.EndTime < r.StartTime:
+		return errors.New("end_time is before start_time")
+	case r.EndTime > r.StartTime+toSeconds(maxReservationWindow):
+		return fmt.Errorf("reservation is longer than %v", maxReservationWindow)
+	}
+	return nil
+}
+
+type forfeitReservationRequest struct {
+	ReservationID string `json:"reservation_id"`
+}
+
+func (r *forfeitReservationRequest) decode(input []byte) error {
+	if err := json.Unmarshal(input, r); err != nil {
+		return err
+	}
+	if r.ReservationID == "" {
+		return errors.New("missing reservation_id")
+	}
+	return nil
+}
+
+// reserveCapacity reserves the capacity of the blobber for a future
+// allocation at the current blobber terms, the transaction value is the
+// deposit.
+func (sc *StorageSmartContract) reserveCapacity(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	conf, err := sc.getConfig(balances, true)
+	if err != nil {
+		return "", common.NewErrorf("reserve_capacity_failed",
+			"can't get config: %v", err)
+	}
+
+	var req reserveCapacityRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("reserve_capacity_failed",
+			"malformed request: "+err.Error())
+	}
+	if err := req.validate(t.CreationDate); err != nil {
+		return "", common.NewError("reserve_capacity_failed",
+			"invalid request: "+err.Error())
+	}
+
+	blobber, err := sc.getBlobber(req.BlobberID, balances)
+	if err != nil {
+		return "", common.NewError("reserve_capacity_failed",
+			"can't get blobber: "+err.Error())
+	}
+	if active, reason := blobber.Provider.IsActive(t.CreationDate, conf.HealthCheckPeriod); !active {
+		return "", common.NewErrorf("reserve_capacity_failed",
+			"blobber is not active, %s", reason)
+	}
+	if blobber.NotAvailable {
+		return "", common.NewError("reserve_capacity_failed",
+			"blobber is not available for new allocations")
+	}
+	if blobber.Capacity-blobber.Allocated < req.Size {
+		return "", common.NewErrorf("reserve_capacity_failed",
+			"blobber free capacity %v insufficient, wanted %v",
+			blobber.Capacity-blobber.Allocated, req.Size)
+	}
+
+	sp, err := sc.getStakePool(spenum.Blobber, blobber.ID, balances)
+	if err != nil {
+		return "", common.NewError("reserve_capacity_failed",
+			"can't get blobber's stake pool: "+err.Error())
+	}
+	if blobber.Terms.WritePrice > 0 {
+		stake, err := sp.stake()
+		if err != nil {
+			return "", common.NewError("reserve_capacity_failed", err.Error())
+		}
+		free, err := unallocatedCapacity(blobber.Terms.WritePrice, stake, sp.TotalOffers)
+		if err != nil {
+			return "", common.NewError("reserve_capacity_failed", err.Error())
+		}
+		if free < req.Size {
+			return "", common.NewErrorf("reserve_capacity_failed",
+				"blobber staked capacity %v insufficient, wanted %v", free, req.Size)
+		}
+	}
+
+	cr := &CapacityReservation{
+		ID:        t.Hash,
+		ClientID:  t.ClientID,
+		BlobberID: blobber.ID,
+		Size:      req.Size,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Terms:     blobber.Terms,
+		Status:    ReservationActive,
+	}
+	minDeposit, err := currency.MultFloat64(cr.offer(), reservationMinDeposit)
+	if err != nil {
+		return "", common.NewError("reserve_capacity_failed", err.Error())
+	}
+	if t.Value < minDeposit {
+		return "", common.NewErrorf("reserve_capacity_failed",
+			"not enough deposit (%d < %d)", t.Value, minDeposit)
+	}
+	if cr.Deposit, err = WithTokenTransfer(t.Value, t.ClientID, t.ToClientID)(balances); err != nil {
+		return "", common.NewError("reserve_capacity_failed", err.Error())
+	}
+
+	blobber.Allocated += cr.Size
+	if err := sp.addOffer(cr.offer()); err != nil {
+		return "", common.NewError("reserve_capacity_failed", err.Error())
+	}
+	if err := sp.Save(spenum.Blobber, blobber.ID, balances); err != nil {
+		return "", common.NewError("reserve_capacity_failed",
+			"saving blobber's stake pool: "+err.Error())
+	}
+	if _, err := balances.InsertTrieNode(blobber.GetKey(), blobber); err != nil {
+		return "", common.NewError("reserve_capacity_failed",
+			"saving blobber: "+err.Error())
+	}
+	if err := cr.save(sc.ID, balances); err != nil {
+		return "", common.NewError("reserve_capacity_failed",
+			"saving reservation: "+err.Error())
+	}
+
+	emitUpdateBlobberAllocatedSavedHealth(blobber, balances)
+	cr.emit(balances)
+	return toJson(cr), nil
+}
+
+// forfeitCapacityReservation releases the capacity of the reservation which
+// is not converted in time and pays the deposit to the blobber, anyone can
+// do it. The deposit is refunded to the client if the blobber's stake pool
+// takes no rewards.
+func (sc *StorageSmartContract) forfeitCapacityReservation(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	var req forfeitReservationRequest
+	if err := req.decode(input); err != nil {
+		return "", common.NewError("forfeit_capacity_reservation_failed",
+			"invalid request: "+err.Error())
+	}
+
+	cr, err := sc.getCapacityReservation(req.ReservationID, balances)
+	if err != nil {
+		return "", common.NewError("forfeit_capacity_reservation_failed",
+			"can't get reservation: "+err.Error())
+	}
+	if !cr.isForfeitable(t.CreationDate) {
+		return "", common.NewError("forfeit_capacity_reservation_failed",
+			"reservation is not forfeitable")
+	}
+
+	blobber, err := sc.getBlobber(cr.BlobberID, balances)
+	if err != nil {
+		return "", common.NewError("forfeit_capacity_reservation_failed",
+			"can't get blobber: "+err.Error())
+	}
+	sp, err := sc.getStakePool(spenum.Blobber, cr.BlobberID, balances)
+	if err != nil {
+		return "", common.NewError("forfeit_capacity_reservation_failed",
+			"can't get blobber's stake pool: "+err.Error())
+	}
+	if err := releaseReservation(cr, blobber, sp); err != nil {
+		return "", common.NewError("forfeit_capacity_reservation_failed", err.Error())
+	}
+	if cr.Deposit > 0 {
+		if err := sc.payForfeitedDeposit(cr, sp, balances); err != nil {
+			return "", common.NewError("forfeit_capacity_reservation_failed",
+				"paying the deposit: "+err.Error())
+		}
+	}
+	if err := sp.Save(spenum.Blobber, cr.BlobberID, balances); err != nil {
+		return "", common.NewError("forfeit_capacity_reservation_failed",
+			"saving blobber's stake pool: "+err.Error())
+	}
+	if _, err := balances.InsertTrieNode(blobber.GetKey(), blobber); err != nil {
+		return "", common.NewError("forfeit_capacity_reservation_failed",
+			"saving blobber: "+err.Error())
+	}
+
+	cr.Status = ReservationForfeited
+	if err := cr.save(sc.ID, balances); err != nil {
+		return "", common.NewError("forfeit_capacity_reservation_failed",
+			"saving reservation: "+err.Error())
+	}
+
+	emitUpdateBlobberAllocatedSavedHealth(blobber, balances)
+	cr.emit(balances)
+	return toJson(cr), nil
+}
+
+// payForfeitedDeposit to the blobber's stake pool, the stake pool drops the
+// rewards if the blobber is killed or under the min stake, then the deposit
+// goes back to the client
+func (sc *StorageSmartContract) payForfeitedDeposit(cr *CapacityReservation, sp *stakePool,
+	balances cstate.StateContextI) error {
+
+	stake, err := sp.stake()
+	if err != nil {
+		return err
+	}
+	if sp.IsDead() || stake < sp.Settings.MinStake {
+		return balances.AddTransfer(state.NewTransfer(sc.ID, cr.ClientID, cr.Deposit))
+	}
+	return sp.DistributeRewards(cr.Deposit, cr.BlobberID, spenum.Blobber,
+		spenum.ReservationForfeitReward, balances)
+}
+
+// releaseReservation gives the reserved capacity back to the blobber
+func releaseReservation(cr *CapacityReservation, blobber *StorageNode, sp *stakePool) error {
+	blobber.Allocated -= cr.Size
+	if blobber.Allocated < 0 {
+		blobber.Allocated = 0
+	}
+	offer := cr.offer()
+	if offer > sp.TotalOffers {
+		offer = sp.TotalOffers
+	}
+	return sp.reduceOffer(offer)
+}
+
+// allocationReservations are converted into the new allocation
+type allocationReservations struct {
+	byBlobber map[string]*CapacityReservation
+	terms     map[string]Terms // the current terms of the blobbers
+}
+
+// useReservations releases the reservations of the new allocation request
+// and sets their locked terms to the blobbers, the current terms are to be
+// restored before the blobbers are saved. Nothing is saved, the quote of the
+// allocation prices the reservations the same way.
+func (sc *StorageSmartContract) useReservations(
+	clientID string,
+	now common.Timestamp,
+	ids []string,
+	blobbers []*StorageNode,
+	spMap map[string]*stakePool,
+	balances cstate.CommonStateContextI,
+) (*allocationReservations, error) {
+	ar := &allocationReservations{
+		byBlobber: make(map[string]*CapacityReservation, len(ids)),
+		terms:     make(map[string]Terms, len(ids)),
+	}
+	nodes := make(map[string]*StorageNode, len(blobbers))
+	for _, b := range blobbers {
+		nodes[b.ID] = b
+	}
+
+	for _, id := range ids {
+		cr, err := sc.getCapacityReservation(id, balances)
+		if err != nil {
+			return nil, fmt.Errorf("can't get reservation %s: %v", id, err)
+		}
+		switch {
+		case cr.ClientID != clientID:
+			return nil, fmt.Errorf("reservation %s is not of the client", id)
+		case !cr.isConvertible(now):
+			return nil, fmt.Errorf("reservation %s is not convertible", id)
+		}
+		b, ok := nodes[cr.BlobberID]
+		if !ok {
+			return nil, fmt.Errorf("blobber of reservation %s is not requested", id)
+		}
+		if _, ok := ar.byBlobber[b.ID]; ok {
+			return nil, fmt.Errorf("more than one reservation of blobber %s", b.ID)
+		}
+		if err := releaseReservation(cr, b, spMap[b.ID]); err != nil {
+			return nil, err
+		}
+		ar.byBlobber[b.ID] = cr
+		ar.terms[b.ID] = b.Terms
+		b.Terms = cr.Terms
+	}
+	return ar, nil
+}
+
+// convert the reservations into the allocation, the blobber allocations
+// keep the locked terms, the reservation deposits go to the write pool
+func (ar *allocationReservations) convert(
+	sa *StorageAllocation,
+	blobbers []*StorageNode,
+	sscKey string,
+	balances cstate.StateContextI,
+) (deposit currency.Coin, err error) {
+	for _, b := range blobbers {
+		if terms, ok := ar.terms[b.ID]; ok {
+			b.Terms = terms
+		}
+	}
+
+	if deposit, err = ar.deposit(sa); err != nil {
+		return 0, err
+	}
+
+	// in the order of the blobbers to keep the state deterministic
+	for _, ba := range sa.BlobberAllocs {
+		cr, ok := ar.byBlobber[ba.BlobberID]
+		if !ok {
+			continue
+		}
+		cr.Status = ReservationConverted
+		cr.AllocationID = sa.ID
+		if err := cr.save(sscKey, balances); err != nil {
+			return 0, fmt.Errorf("saving reservation: %v", err)
+		}
+		cr.emit(balances)
+	}
+	return deposit, nil
+}
+
+// deposit of the reservations checked against the blobber allocations
+func (ar *allocationReservations) deposit(sa *StorageAllocation) (deposit currency.Coin, err error) {
+	for blobberID, cr := range ar.byBlobber {
+		ba, ok := sa.BlobberAllocsMap[blobberID]
+		if !ok {
+			return 0, fmt.Errorf("blobber %s of reservation %s is not selected", blobberID, cr.ID)
+		}
+		if ba.Size > cr.Size {
+			return 0, fmt.Errorf("blobber %s allocation size %d is over the reserved %d",
+				blobberID, ba.Size, cr.Size)
+		}
+		if deposit, err = currency.AddCoin(deposit, cr.Deposit); err != nil {
+			return 0, err
+		}
+	}
+	return deposit, nil
+}
+
+// addReservationDeposit to the write pool, the deposit is on the smart
+// contract balance already
+func (sa *StorageAllocation) addReservationDeposit(clientID string, deposit currency.Coin,
+	balances cstate.StateContextI) (err error) {
+
+	if sa.WritePool, err = currency.AddCoin(sa.WritePool, deposit); err != nil {
+		return err
+	}
+	i, err := deposit.Int64()
+	if err != nil {
+		return err
+	}
+	balances.EmitEvent(event.TypeStats, event.TagLockWritePool, sa.ID, event.WritePoolLock{
+		Client:       clientID,
+		AllocationId: sa.ID,
+		Amount:       i,
+	})
+	return nil
+}
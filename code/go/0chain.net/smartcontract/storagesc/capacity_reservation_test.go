@@ -0,0 +1,193 @@
+package storagesc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityReservation(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		client   = newClient(1000*x10, balances)
+		now      = int64(1000)
+	)
+
+	setConfig(t, balances)
+
+	nar := &newAllocationRequest{
+		DataShards:      2,
+		ParityShards:    2,
+		Owner:           client.id,
+		OwnerPublicKey:  client.pk,
+		ReadPriceRange:  PriceRange{1 * x10, 10 * x10},
+		WritePriceRange: PriceRange{2 * x10, 20 * x10},
+		Size:            2 * GB,
+	}
+	for i := 0; i < 4; i++ {
+		b := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+		nar.Blobbers = append(nar.Blobbers, b.id)
+	}
+
+	reserve := func(blobberID string, size int64, deposit currency.Coin, start, end int64) (*CapacityReservation, error) {
+		tx := newTransaction(client.id, ssc.ID, deposit, now)
+		balances.setTransaction(t, tx)
+		_, err := ssc.reserveCapacity(tx, mustEncode(t, &reserveCapacityRequest{
+			BlobberID: blobberID,
+			Size:      size,
+			StartTime: common.Timestamp(start),
+			EndTime:   common.Timestamp(end),
+		}), balances)
+		if err != nil {
+			return nil, err
+		}
+		return ssc.getCapacityReservation(tx.Hash, balances)
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := reserve(nar.Blobbers[0], GB, x10, now-1, now+100)
+		require.EqualError(t, err, "reserve_capacity_failed: invalid request: start_time is in the past")
+		_, err = reserve(nar.Blobbers[0], 3*GB, x10, now+100, now+200)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "free capacity")
+		_, err = reserve(nar.Blobbers[0], GB, 1, now+100, now+200)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not enough deposit")
+	})
+
+	var (
+		start = now + 100
+		end   = now + 200
+	)
+	cr, err := reserve(nar.Blobbers[0], GB, x10, start, end)
+	require.NoError(t, err)
+	require.Equal(t, avgTerms, cr.Terms)
+	require.EqualValues(t, x10, cr.Deposit)
+
+	b, err := ssc.getBlobber(nar.Blobbers[0], balances)
+	require.NoError(t, err)
+	require.EqualValues(t, GB, b.Allocated)
+	sp, err := ssc.getStakePool(spenum.Blobber, b.ID, balances)
+	require.NoError(t, err)
+	require.Equal(t, cr.offer(), sp.TotalOffers)
+
+	// the blobber raises the prices after the reservation
+	raised := avgTerms
+	raised.WritePrice *= 2
+	b.Terms = raised
+	_, err = balances.InsertTrieNode(b.GetKey(), b)
+	require.NoError(t, err)
+
+	t.Run("convert", func(t *testing.T) {
+		nar := *nar
+		nar.Reservations = []string{cr.ID}
+
+		// too early
+		_, err := nar.callNewAllocReq(t, client.id, 100*x10, ssc, start-1, balances)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not convertible")
+
+		resp, err := nar.callNewAllocReq(t, client.id, 100*x10, ssc, start, balances)
+		require.NoError(t, err)
+		var out NewAllocationTxnOutput
+		require.NoError(t, json.Unmarshal([]byte(resp), &out))
+		alloc, err := ssc.getAllocation(out.ID, balances)
+		require.NoError(t, err)
+
+		ba := alloc.BlobberAllocsMap[cr.BlobberID]
+		require.NotNil(t, ba)
+		require.Equal(t, avgTerms, ba.Terms)
+		require.Equal(t, 100*x10+cr.Deposit, alloc.WritePool)
+
+		converted, err := ssc.getCapacityReservation(cr.ID, balances)
+		require.NoError(t, err)
+		require.Equal(t, ReservationConverted, converted.Status)
+		require.Equal(t, alloc.ID, converted.AllocationID)
+
+		b, err := ssc.getBlobber(cr.BlobberID, balances)
+		require.NoError(t, err)
+		require.Equal(t, raised, b.Terms)
+		require.Equal(t, ba.Size, b.Allocated)
+
+		// converted once only
+		_, err = nar.callNewAllocReq(t, client.id, 100*x10, ssc, start, balances)
+		require.Error(t, err)
+	})
+
+	t.Run("forfeit", func(t *testing.T) {
+		b, err := ssc.getBlobber(nar.Blobbers[1], balances)
+		require.NoError(t, err)
+		sp, err := ssc.getStakePool(spenum.Blobber, b.ID, balances)
+		require.NoError(t, err)
+		allocated, offers := b.Allocated, sp.TotalOffers
+
+		cr, err := reserve(b.ID, GB, x10, start, end)
+		require.NoError(t, err)
+
+		forfeit := func(now int64) error {
+			tx := newTransaction(nar.Blobbers[1], ssc.ID, 0, now)
+			balances.setTransaction(t, tx)
+			_, err := ssc.forfeitCapacityReservation(tx, mustEncode(t, &forfeitReservationRequest{
+				ReservationID: cr.ID,
+			}), balances)
+			return err
+		}
+
+		graceEnd := end + int64(toSeconds(reservationGracePeriod))
+		require.EqualError(t, forfeit(graceEnd),
+			"forfeit_capacity_reservation_failed: reservation is not forfeitable")
+		require.NoError(t, forfeit(graceEnd+1))
+
+		forfeited, err := ssc.getCapacityReservation(cr.ID, balances)
+		require.NoError(t, err)
+		require.Equal(t, ReservationForfeited, forfeited.Status)
+
+		b, err = ssc.getBlobber(cr.BlobberID, balances)
+		require.NoError(t, err)
+		require.Equal(t, allocated, b.Allocated)
+		sp, err = ssc.getStakePool(spenum.Blobber, b.ID, balances)
+		require.NoError(t, err)
+		require.Equal(t, offers, sp.TotalOffers)
+		rewards := sp.Reward
+		for _, dp := range sp.Pools {
+			rewards += dp.Reward
+		}
+		require.Equal(t, cr.Deposit, rewards)
+
+		require.Error(t, forfeit(graceEnd+2))
+	})
+
+	t.Run("forfeit killed", func(t *testing.T) {
+		cr, err := reserve(nar.Blobbers[2], GB, x10, start, end)
+		require.NoError(t, err)
+
+		sp, err := ssc.getStakePool(spenum.Blobber, cr.BlobberID, balances)
+		require.NoError(t, err)
+		sp.HasBeenKilled = true
+		require.NoError(t, sp.Save(spenum.Blobber, cr.BlobberID, balances))
+
+		graceEnd := end + int64(toSeconds(reservationGracePeriod))
+		tx := newTransaction(nar.Blobbers[2], ssc.ID, 0, graceEnd+1)
+		balances.setTransaction(t, tx)
+		clientBalance := balances.balances[client.id]
+		_, err = ssc.forfeitCapacityReservation(tx, mustEncode(t, &forfeitReservationRequest{
+			ReservationID: cr.ID,
+		}), balances)
+		require.NoError(t, err)
+
+		// the killed blobber takes no rewards, the deposit is refunded
+		require.Equal(t, clientBalance+cr.Deposit, balances.balances[client.id])
+		sp, err = ssc.getStakePool(spenum.Blobber, cr.BlobberID, balances)
+		require.NoError(t, err)
+		rewards := sp.Reward
+		for _, dp := range sp.Pools {
+			rewards += dp.Reward
+		}
+		require.Zero(t, rewards)
+	})
+}
@@ -405,7 +405,8 @@ func (sc *StorageSmartContract) blobberPenalty(
 		}
 
 		var move currency.Coin
-		move, err = sp.slash(blobAlloc.BlobberID, blobAlloc.Offer(), slash, balances, allocationID)
+		move, err = sp.slash(blobAlloc.BlobberID, blobAlloc.Offer(), slash, balances, allocationID,
+			spenum.ChallengeSlashPenalty)
 		if err != nil {
 			return fmt.Errorf("can't move tokens to write pool: %v", err)
 		}
@@ -544,6 +545,7 @@ type verifyTicketsResult struct {
 	threshold  int
 	success    int
 	validators []string
+	tickets    []*ValidationTicket
 }
 
 // challengeAllocBlobberPassResult wraps all the data structs for processing a challenge
@@ -619,6 +621,7 @@ func verifyChallengeTickets(balances cstate.StateContextI,
 		threshold:  threshold,
 		success:    success,
 		validators: validators,
+		tickets:    cr.ValidationTickets,
 	}, nil
 }
 
@@ -671,13 +674,15 @@ func (sc *StorageSmartContract) challengePassed(
 			StartRound: rewardRound,
 			Timestamp:  t.CreationDate,
 		}
+	}
 
-		_, err = balances.InsertTrieNode(blobber.GetKey(), blobber)
-		if err != nil {
-			return "", common.NewError("verify_challenge",
-				"error inserting blobber to chain"+err.Error())
-		}
+	blobber.Reputation.ChallengesPassed++
+	_, err = balances.InsertTrieNode(blobber.GetKey(), blobber)
+	if err != nil {
+		return "", common.NewError("verify_challenge",
+			"error inserting blobber to chain"+err.Error())
 	}
+	emitUpdateBlobberReputation(blobber, balances)
 
 	var brStats BlobberRewardNode
 	if err := ongoingParts.Get(balances, blobber.ID, &brStats); err != nil {
@@ -731,6 +736,7 @@ func (sc *StorageSmartContract) challengePassed(
 	}
 	validators := getRandomSubSlice(cab.validators, validatorsRewarded, balances.GetBlock().GetRoundRandomSeed())
 
+	challengeReward := cab.blobAlloc.ChallengeReward
 	err = sc.blobberReward(
 		cab.alloc, cab.latestCompletedChallTime, cab.blobAlloc,
 		validators,
@@ -748,6 +754,12 @@ func (sc *StorageSmartContract) challengePassed(
 		return "", common.NewError("challenge_reward_error", err.Error())
 	}
 
+	reward, err := currency.MinusCoin(cab.blobAlloc.ChallengeReward, challengeReward)
+	if err != nil {
+		return "", common.NewError("challenge_reward_error", err.Error())
+	}
+	emitChallengeAudit(cab, validators, reward, 0, balances)
+
 	if cab.success < cab.threshold {
 		return "challenge passed partially by blobber", nil
 	}
@@ -783,8 +795,17 @@ func (sc *StorageSmartContract) challengeFailed(
 		return "", common.NewError("challenge_penalty_error", err.Error())
 	}
 
+	err = sc.updateBlobberReputation(cab.blobAlloc.BlobberID, func(r *BlobberReputation) {
+		r.ChallengesFailed++
+	}, balances)
+	if err != nil {
+		return "", common.NewError("challenge_penalty_error",
+			"updating blobber reputation: "+err.Error())
+	}
+
 	logging.Logger.Info("Challenge failed", zap.String("challenge", cab.challenge.ID))
 	validators := getRandomSubSlice(cab.validators, validatorsRewarded, balances.GetBlock().GetRoundRandomSeed())
+	penalty := cab.blobAlloc.Penalty
 	err = sc.blobberPenalty(
 		cab.alloc, cab.latestCompletedChallTime, cab.blobAlloc, validators,
 		maxChallengeCompletionTime,
@@ -795,6 +816,12 @@ func (sc *StorageSmartContract) challengeFailed(
 		return "", common.NewError("challenge_penalty_error", err.Error())
 	}
 
+	slashed, err := currency.MinusCoin(cab.blobAlloc.Penalty, penalty)
+	if err != nil {
+		return "", common.NewError("challenge_penalty_error", err.Error())
+	}
+	emitChallengeAudit(cab, validators, 0, slashed, balances)
+
 	// save allocation object
 	_, err = balances.InsertTrieNode(cab.alloc.GetKey(sc.ID), cab.alloc)
 	if err != nil {
@@ -915,29 +942,18 @@ func selectBlobberForChallenge(selection challengeBlobberSelection, challengeBlo
 	}
 }
 
-func (sc *StorageSmartContract) populateGenerateChallenge(
-	challengeBlobbersPartition *partitions.Partitions,
-	seed int64,
-	validators *partitions.Partitions,
+// maxChallengeSelections is the max number of blobbers drawn for a
+// challenge, see the acceptChallenge
+const maxChallengeSelections = maxAssuranceTier + 1
+
+// selectAllocationForChallenge selects a random active allocation of the
+// blobber, it's nil if no allocation is found
+func (sc *StorageSmartContract) selectAllocationForChallenge(
 	txn *transaction.Transaction,
-	challengeID string,
+	blobberID string,
+	r *rand.Rand,
 	balances cstate.StateContextI,
-	needValidNum int,
-	conf *Config,
-) (*challengeOutput, error) {
-	r := rand.New(rand.NewSource(seed))
-	blobberSelection := challengeBlobberSelection(1) // challengeBlobberSelection(r.Intn(2))
-	blobberID, err := selectBlobberForChallenge(blobberSelection, challengeBlobbersPartition, r, balances)
-	if err != nil {
-		return nil, common.NewError("add_challenge", err.Error())
-	}
-
-	if blobberID == "" {
-		return nil, common.NewError("add_challenges", "empty blobber id")
-	}
-
-	logging.Logger.Debug("generate_challenges", zap.String("blobber id", blobberID))
-
+) (*StorageAllocation, error) {
 	// get blobber allocations partitions
 	blobberAllocParts, err := partitionsBlobberAllocations(blobberID, balances)
 	if err != nil {
@@ -1011,10 +1027,56 @@ func (sc *StorageSmartContract) populateGenerateChallenge(
 	}
 
 	if !foundAllocation {
-		logging.Logger.Error("populate_generate_challenge: couldn't find appropriate allocation for a blobber",
-			zap.String("blobberId", blobberID))
 		return nil, nil
 	}
+	return alloc, nil
+}
+
+func (sc *StorageSmartContract) populateGenerateChallenge(
+	challengeBlobbersPartition *partitions.Partitions,
+	seed int64,
+	validators *partitions.Partitions,
+	txn *transaction.Transaction,
+	challengeID string,
+	balances cstate.StateContextI,
+	needValidNum int,
+	conf *Config,
+) (*challengeOutput, error) {
+	r := rand.New(rand.NewSource(seed))
+	blobberSelection := challengeBlobberSelection(1) // challengeBlobberSelection(r.Intn(2))
+
+	// the blobbers of the higher assurance tiers are accepted more often,
+	// the last one selected is challenged if none is accepted
+	var (
+		blobberID string
+		alloc     *StorageAllocation
+	)
+	for i := 0; i < maxChallengeSelections; i++ {
+		var err error
+		blobberID, err = selectBlobberForChallenge(blobberSelection, challengeBlobbersPartition, r, balances)
+		if err != nil {
+			return nil, common.NewError("add_challenge", err.Error())
+		}
+
+		if blobberID == "" {
+			return nil, common.NewError("add_challenges", "empty blobber id")
+		}
+
+		logging.Logger.Debug("generate_challenges", zap.String("blobber id", blobberID))
+
+		alloc, err = sc.selectAllocationForChallenge(txn, blobberID, r, balances)
+		if err != nil {
+			return nil, err
+		}
+		if alloc == nil {
+			logging.Logger.Error("populate_generate_challenge: couldn't find appropriate allocation for a blobber",
+				zap.String("blobberId", blobberID))
+			return nil, nil
+		}
+		if conf.acceptChallenge(alloc, r) {
+			break
+		}
+	}
 
 	allocBlobber, ok := alloc.BlobberAllocsMap[blobberID]
 	if !ok {
@@ -1272,6 +1334,7 @@ func (sc *StorageSmartContract) addChallenge(alloc *StorageAllocation,
 
 	// maps blobberID to count of its expiredIDs.
 	expiredCountMap := make(map[string]int)
+	var expiredBlobberIDs []string
 
 	// TODO: maybe delete them periodically later instead of remove immediately
 	for _, challengeID := range expChalIDs {
@@ -1283,10 +1346,22 @@ func (sc *StorageSmartContract) addChallenge(alloc *StorageAllocation,
 
 		if _, ok := expiredCountMap[blobberID]; !ok {
 			expiredCountMap[blobberID] = 0
+			expiredBlobberIDs = append(expiredBlobberIDs, blobberID)
 		}
 		expiredCountMap[blobberID]++
 	}
 
+	// expired challenges are failed by the blobbers
+	for _, blobberID := range expiredBlobberIDs {
+		expired := int64(expiredCountMap[blobberID])
+		err := sc.updateBlobberReputation(blobberID, func(r *BlobberReputation) {
+			r.ChallengesFailed += expired
+		}, balances)
+		if err != nil {
+			return common.NewErrorf("add_challenge", "updating blobber reputation: %v", err)
+		}
+	}
+
 	// add the generated challenge to the open challenges list in the allocation
 	if !allocChallenges.addChallenge(challenge) {
 		return common.NewError("add_challenge", "challenge already exist in allocation")
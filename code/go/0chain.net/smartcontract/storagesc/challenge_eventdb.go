@@ -6,6 +6,7 @@ import (
 	common2 "0chain.net/smartcontract/common"
 	"0chain.net/smartcontract/dbs/event"
 	"errors"
+	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/logging"
 	"strings"
 )
@@ -186,3 +187,46 @@ func getChallenge(challengeID string,
 	}
 	return challInfo, nil
 }
+
+// emitChallengeAudit records the response of the challenge with its
+// validation tickets, so the signatures can be verified out of the chain
+func emitChallengeAudit(
+	cab *challengeAllocBlobberPassResult,
+	rewardedValidators []string,
+	reward, penalty currency.Coin,
+	balances cstate.StateContextI,
+) {
+	rewarded := make(map[string]struct{}, len(rewardedValidators))
+	for _, id := range rewardedValidators {
+		rewarded[id] = struct{}{}
+	}
+	tickets := make([]event.ChallengeValidationTicket, 0, len(cab.tickets))
+	for _, vt := range cab.tickets {
+		_, ok := rewarded[vt.ValidatorID]
+		tickets = append(tickets, event.ChallengeValidationTicket{
+			ChallengeID:  cab.challenge.ID,
+			ValidatorID:  vt.ValidatorID,
+			ValidatorKey: vt.ValidatorKey,
+			Result:       vt.Result,
+			Message:      vt.Message,
+			MessageCode:  vt.MessageCode,
+			Timestamp:    int64(vt.Timestamp),
+			Hash:         vt.hash(),
+			Signature:    vt.Signature,
+			Rewarded:     ok,
+		})
+	}
+	block := balances.GetBlock()
+	balances.EmitEvent(event.TypeStats, event.TagAddChallengeAudit, cab.challenge.ID, []event.ChallengeAudit{{
+		ChallengeID:  cab.challenge.ID,
+		AllocationID: cab.challenge.AllocationID,
+		BlobberID:    cab.challenge.BlobberID,
+		TxnHash:      balances.GetTransaction().Hash,
+		Round:        block.Round,
+		BlockHash:    block.Hash,
+		Passed:       cab.pass && cab.fresh,
+		Reward:       reward,
+		Penalty:      penalty,
+		Tickets:      tickets,
+	}})
+}
@@ -701,6 +701,73 @@ func TestVerifyChallengeOldChallenge(t *testing.T) {
 	})
 }
 
+// auditTestBalances keeps the emitted challenge audits
+type auditTestBalances struct {
+	*testBalances
+	audits []event.ChallengeAudit
+}
+
+func (tb *auditTestBalances) EmitEvent(_ event.EventType, tag event.EventTag, _ string, data interface{}, _ ...cstate.Appender) {
+	if tag == event.TagAddChallengeAudit {
+		tb.audits = append(tb.audits, data.([]event.ChallengeAudit)...)
+	}
+}
+
+func TestVerifyChallengeAudit(t *testing.T) {
+	ssc, balances, tp, alloc, b3, valids, validators, blobber, _ := prepareAllocChallenges(t, 10)
+	step := (int64(alloc.Expiration) - tp) / 10
+	tp += step / 2
+
+	challID := "chall-0"
+	genChall(t, ssc, tp, challID, 0, validators, alloc.ID, blobber, balances)
+
+	chall := &ChallengeResponse{ID: challID}
+	for i := 0; i < 10; i++ {
+		chall.ValidationTickets = append(chall.ValidationTickets,
+			valids[i].validTicket(t, chall.ID, b3.id, true, tp))
+	}
+
+	tx := newTransaction(b3.id, ssc.ID, 0, tp)
+	balances.setTransaction(t, tx)
+	bk := &block.Block{}
+	bk.Round = 500
+	bk.Hash = "block hash"
+	balances.setBlock(t, bk)
+
+	ab := &auditTestBalances{testBalances: balances}
+	_, err := ssc.verifyChallenge(tx, mustEncode(t, chall), ab)
+	require.NoError(t, err)
+
+	require.Len(t, ab.audits, 1)
+	audit := ab.audits[0]
+	require.Equal(t, challID, audit.ChallengeID)
+	require.Equal(t, alloc.ID, audit.AllocationID)
+	require.Equal(t, tx.Hash, audit.TxnHash)
+	require.EqualValues(t, 500, audit.Round)
+	require.Equal(t, "block hash", audit.BlockHash)
+	require.True(t, audit.Passed)
+	require.NotZero(t, audit.Reward)
+	require.Zero(t, audit.Penalty)
+	require.Len(t, audit.Tickets, 10)
+
+	conf, err := ssc.getConfig(balances, false)
+	require.NoError(t, err)
+	var rewarded int
+	for _, vt := range audit.Tickets {
+		if vt.Rewarded {
+			rewarded++
+		}
+		// the signature is verifiable with the audit data only
+		scheme := balances.GetSignatureScheme()
+		require.NoError(t, scheme.SetPublicKey(vt.ValidatorKey))
+		ok, err := scheme.Verify(vt.Signature, vt.Hash)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	require.NotZero(t, rewarded)
+	require.Equal(t, conf.NumValidatorsRewarded, rewarded)
+}
+
 func createTxnMPT(mpt util.MerklePatriciaTrieI) util.MerklePatriciaTrieI {
 	tdb := util.NewLevelNodeDB(util.NewMemoryNodeDB(), mpt.GetNodeDB(), false)
 	tmpt := util.NewMerklePatriciaTrie(tdb, mpt.GetVersion(), mpt.GetRoot())
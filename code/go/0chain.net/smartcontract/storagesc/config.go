@@ -10,6 +10,7 @@ import (
 	chainState "0chain.net/chaincore/chain/state"
 	"0chain.net/core/datastore"
 	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/util"
 )
 
@@ -31,6 +32,8 @@ type freeAllocationSettings struct {
 type stakePoolConfig struct {
 	MinLockPeriod time.Duration `json:"min_lock_period"`
 	KillSlash     float64       `json:"kill_slash"`
+	// UnbondingRounds the unlocked delegate pools wait before the claim
+	UnbondingRounds int64 `json:"unbonding_rounds"`
 }
 
 type readPoolConfig struct {
@@ -57,6 +60,14 @@ type blockRewardGamma struct {
 	B     float64 `json:"b"`
 }
 
+// assuranceConfig prices the assurance tiers of the allocations, the tier
+// price is added to the write price and the challenge weight makes the tier
+// blobbers challenged more often
+type assuranceConfig struct {
+	Price           float64 `json:"price"`            // per tier
+	ChallengeWeight float64 `json:"challenge_weight"` // per tier
+}
+
 type blockRewardZeta struct {
 	I  float64 `json:"i"`
 	K  float64 `json:"k"`
@@ -124,6 +135,12 @@ type Config struct {
 	MaxIndividualFreeAllocation currency.Coin          `json:"max_individual_free_allocation"`
 	FreeAllocationSettings      freeAllocationSettings `json:"free_allocation_settings"`
 
+	// Assurance of the allocations.
+	Assurance assuranceConfig `json:"assurance"`
+
+	// Governance of the settings changes.
+	Governance governance.Config `json:"governance"`
+
 	// challenges generating
 
 	// ChallengeEnabled is challenges generating pin.
@@ -171,6 +188,16 @@ func (conf *Config) validate() (err error) {
 		return fmt.Errorf("cancellation_charge not in [0, 1] range: %v",
 			conf.MinLockDemand)
 	}
+	if conf.Assurance.Price < 0 {
+		return fmt.Errorf("negative assurance.price: %v", conf.Assurance.Price)
+	}
+	if conf.Assurance.ChallengeWeight < 0 {
+		return fmt.Errorf("negative assurance.challenge_weight: %v",
+			conf.Assurance.ChallengeWeight)
+	}
+	if err := conf.Governance.Validate(); err != nil {
+		return fmt.Errorf("invalid governance: %v", err)
+	}
 	if conf.MaxBlobbersPerAllocation <= 0 {
 		return fmt.Errorf("invalid max_blobber_per_allocation <= 0: %v",
 			conf.MaxBlobbersPerAllocation)
@@ -197,6 +224,9 @@ func (conf *Config) validate() (err error) {
 	if conf.StakePool.KillSlash < 0 || conf.StakePool.KillSlash > 1 {
 		return fmt.Errorf("stakepool.kill_slash, %v must be in interval [0.1]", conf.StakePool.KillSlash)
 	}
+	if conf.StakePool.UnbondingRounds < 0 {
+		return fmt.Errorf("negative stakepool.unbonding_rounds: %v", conf.StakePool.UnbondingRounds)
+	}
 
 	if conf.FreeAllocationSettings.DataShards < 0 {
 		return fmt.Errorf("negative free_allocation_settings.data_shards: %v",
@@ -370,6 +400,7 @@ func getConfiguredConfig() (conf *Config, err error) {
 	conf.StakePool = new(stakePoolConfig)
 	conf.StakePool.MinLockPeriod = scc.GetDuration(pfx + "stakepool.min_lock_period")
 	conf.StakePool.KillSlash = scc.GetFloat64(pfx + "stakepool.kill_slash")
+	conf.StakePool.UnbondingRounds = scc.GetInt64(pfx + "stakepool.unbonding_rounds")
 
 	conf.MaxTotalFreeAllocation, err = currency.MultFloat64(1e10, scc.GetFloat64(pfx+"max_total_free_allocation"))
 	if err != nil {
@@ -417,6 +448,23 @@ func getConfiguredConfig() (conf *Config, err error) {
 	}
 	conf.FreeAllocationSettings.ReadPoolFraction = scc.GetFloat64(fas + "read_pool_fraction")
 
+	conf.Assurance.Price = scc.GetFloat64(pfx + "assurance.price")
+	conf.Assurance.ChallengeWeight = scc.GetFloat64(pfx + "assurance.challenge_weight")
+
+	conf.Governance.Council = scc.GetStringSlice(pfx + "governance.council")
+	conf.Governance.Quorum, err = currency.ParseZCN(scc.GetFloat64(pfx + "governance.quorum"))
+	if err != nil {
+		return nil, err
+	}
+	conf.Governance.Threshold = scc.GetFloat64(pfx + "governance.threshold")
+	conf.Governance.VotingPeriod = scc.GetDuration(pfx + "governance.voting_period")
+	conf.Governance.Timelock = scc.GetDuration(pfx + "governance.timelock")
+	conf.Governance.MinStake, err = currency.ParseZCN(scc.GetFloat64(pfx + "governance.min_stake"))
+	if err != nil {
+		return nil, err
+	}
+	conf.Governance.MaxVoters = scc.GetInt(pfx + "governance.max_voters")
+
 	// challenges generating
 	conf.ChallengeEnabled = scc.GetBool(pfx + "challenge_enabled")
 	conf.ValidatorsPerChallenge = scc.GetInt(pfx + "validators_per_challenge")
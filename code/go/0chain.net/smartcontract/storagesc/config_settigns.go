@@ -21,6 +21,7 @@ import (
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/governance"
 )
 
 type Setting int
@@ -44,6 +45,7 @@ const (
 
 	StakePoolMinLockPeriod
 	StakePoolKillSlash
+	StakePoolUnbondingRounds
 	MaxTotalFreeAllocation
 	MaxIndividualFreeAllocation
 	CancellationCharge
@@ -58,6 +60,17 @@ const (
 	FreeAllocationWritePriceRangeMax
 	FreeAllocationReadPoolFraction
 
+	AssurancePrice
+	AssuranceChallengeWeight
+
+	GovernanceCouncil
+	GovernanceQuorum
+	GovernanceThreshold
+	GovernanceVotingPeriod
+	GovernanceTimelock
+	GovernanceMinStake
+	GovernanceMaxVoters
+
 	ValidatorReward
 	BlobberSlash
 
@@ -149,6 +162,7 @@ func initSettingName() {
 	SettingName[ReadPoolMinLock] = "readpool.min_lock"
 	SettingName[WritePoolMinLock] = "writepool.min_lock"
 	SettingName[StakePoolKillSlash] = "stakepool.kill_slash"
+	SettingName[StakePoolUnbondingRounds] = "stakepool.unbonding_rounds"
 	SettingName[StakePoolMinLockPeriod] = "stakepool.min_lock_period"
 	SettingName[MaxTotalFreeAllocation] = "max_total_free_allocation"
 	SettingName[MaxIndividualFreeAllocation] = "max_individual_free_allocation"
@@ -162,6 +176,15 @@ func initSettingName() {
 	SettingName[FreeAllocationWritePriceRangeMin] = "free_allocation_settings.write_price_range.min"
 	SettingName[FreeAllocationWritePriceRangeMax] = "free_allocation_settings.write_price_range.max"
 	SettingName[FreeAllocationReadPoolFraction] = "free_allocation_settings.read_pool_fraction"
+	SettingName[AssurancePrice] = "assurance.price"
+	SettingName[AssuranceChallengeWeight] = "assurance.challenge_weight"
+	SettingName[GovernanceCouncil] = "governance.council"
+	SettingName[GovernanceQuorum] = "governance.quorum"
+	SettingName[GovernanceThreshold] = "governance.threshold"
+	SettingName[GovernanceVotingPeriod] = "governance.voting_period"
+	SettingName[GovernanceTimelock] = "governance.timelock"
+	SettingName[GovernanceMinStake] = "governance.min_stake"
+	SettingName[GovernanceMaxVoters] = "governance.max_voters"
 	SettingName[ValidatorReward] = "validator_reward"
 	SettingName[BlobberSlash] = "blobber_slash"
 	SettingName[HealthCheckPeriod] = "health_check_period"
@@ -232,6 +255,7 @@ func initSettings() {
 		WritePoolMinLock.String():                 {WritePoolMinLock, config.CurrencyCoin},
 		StakePoolMinLockPeriod.String():           {StakePoolMinLockPeriod, config.Duration},
 		StakePoolKillSlash.String():               {StakePoolKillSlash, config.Float64},
+		StakePoolUnbondingRounds.String():         {StakePoolUnbondingRounds, config.Int64},
 		MaxTotalFreeAllocation.String():           {MaxTotalFreeAllocation, config.CurrencyCoin},
 		MaxIndividualFreeAllocation.String():      {MaxIndividualFreeAllocation, config.CurrencyCoin},
 		CancellationCharge.String():               {CancellationCharge, config.Float64},
@@ -244,6 +268,15 @@ func initSettings() {
 		FreeAllocationWritePriceRangeMin.String(): {FreeAllocationWritePriceRangeMin, config.CurrencyCoin},
 		FreeAllocationWritePriceRangeMax.String(): {FreeAllocationWritePriceRangeMax, config.CurrencyCoin},
 		FreeAllocationReadPoolFraction.String():   {FreeAllocationReadPoolFraction, config.Float64},
+		AssurancePrice.String():                   {AssurancePrice, config.Float64},
+		AssuranceChallengeWeight.String():         {AssuranceChallengeWeight, config.Float64},
+		GovernanceCouncil.String():                {GovernanceCouncil, config.Strings},
+		GovernanceQuorum.String():                 {GovernanceQuorum, config.CurrencyCoin},
+		GovernanceThreshold.String():              {GovernanceThreshold, config.Float64},
+		GovernanceVotingPeriod.String():           {GovernanceVotingPeriod, config.Duration},
+		GovernanceTimelock.String():               {GovernanceTimelock, config.Duration},
+		GovernanceMinStake.String():               {GovernanceMinStake, config.CurrencyCoin},
+		GovernanceMaxVoters.String():              {GovernanceMaxVoters, config.Int},
 		ValidatorReward.String():                  {ValidatorReward, config.Float64},
 		BlobberSlash.String():                     {BlobberSlash, config.Float64},
 		HealthCheckPeriod.String():                {HealthCheckPeriod, config.Duration},
@@ -313,6 +346,13 @@ func (conf *Config) getConfigMap() (config.StringMap, error) {
 			}
 			iSetting = float64(sbSetting) / x10
 		}
+		if info.configType == config.Strings {
+			values, ok := iSetting.([]string)
+			if !ok {
+				return out, fmt.Errorf("%s key not implemented as strings", key)
+			}
+			iSetting = strings.Join(values, ",")
+		}
 		out.Fields[key] = fmt.Sprintf("%v", iSetting)
 	}
 	return out, nil
@@ -362,6 +402,8 @@ func (conf *Config) setInt(key string, change int) error {
 		conf.MaxBlobbersPerAllocation = change
 	case ValidatorsPerChallenge:
 		conf.ValidatorsPerChallenge = change
+	case GovernanceMaxVoters:
+		conf.Governance.MaxVoters = change
 	case NumValidatorsRewarded:
 		conf.NumValidatorsRewarded = change
 	case MaxDelegates:
@@ -401,6 +443,10 @@ func (conf *Config) setCoin(key string, change currency.Coin) error {
 		conf.MaxWritePrice = change
 	case MinWritePrice:
 		conf.MinWritePrice = change
+	case GovernanceQuorum:
+		conf.Governance.Quorum = change
+	case GovernanceMinStake:
+		conf.Governance.MinStake = change
 	case BlockRewardBlockReward:
 		if conf.BlockReward == nil {
 			conf.BlockReward = &blockReward{}
@@ -436,6 +482,11 @@ func (conf *Config) setInt64(key string, change int64) error {
 		conf.MinBlobberCapacity = change
 	case FreeAllocationSize:
 		conf.FreeAllocationSettings.Size = change
+	case StakePoolUnbondingRounds:
+		if conf.StakePool == nil {
+			conf.StakePool = &stakePoolConfig{}
+		}
+		conf.StakePool.UnbondingRounds = change
 	default:
 		return fmt.Errorf("key: %v not implemented as int64", key)
 	}
@@ -447,6 +498,12 @@ func (conf *Config) setFloat64(key string, change float64) error {
 	switch Settings[key].setting {
 	case FreeAllocationReadPoolFraction:
 		conf.FreeAllocationSettings.ReadPoolFraction = change
+	case AssurancePrice:
+		conf.Assurance.Price = change
+	case AssuranceChallengeWeight:
+		conf.Assurance.ChallengeWeight = change
+	case GovernanceThreshold:
+		conf.Governance.Threshold = change
 	case ValidatorReward:
 		conf.ValidatorReward = change
 	case CancellationCharge:
@@ -508,6 +565,10 @@ func (conf *Config) setDuration(key string, change time.Duration) error {
 		conf.StakePool.MinLockPeriod = change
 	case HealthCheckPeriod:
 		conf.HealthCheckPeriod = change
+	case GovernanceVotingPeriod:
+		conf.Governance.VotingPeriod = change
+	case GovernanceTimelock:
+		conf.Governance.Timelock = change
 	default:
 		return fmt.Errorf("key: %v not implemented as duration", key)
 	}
@@ -524,6 +585,16 @@ func (conf *Config) setBoolean(key string, change bool) error {
 	return nil
 }
 
+func (conf *Config) setStrings(key string, change []string) error {
+	switch Settings[key].setting {
+	case GovernanceCouncil:
+		conf.Governance.Council = change
+	default:
+		return fmt.Errorf("key: %v not implemented as strings", key)
+	}
+	return nil
+}
+
 func (conf *Config) setKey(key string, change string) {
 	switch Settings[key].setting {
 	case OwnerId:
@@ -605,6 +676,20 @@ func (conf *Config) set(key string, change string) error {
 			return fmt.Errorf("%s must be a hes string: %v", key, err)
 		}
 		conf.setKey(key, change)
+	case config.Strings:
+		var values []string
+		for _, value := range strings.Split(change, ",") {
+			if value = strings.TrimSpace(value); value == "" {
+				continue
+			}
+			if _, err := hex.DecodeString(value); err != nil {
+				return fmt.Errorf("%s must be a list of hex strings: %v", key, err)
+			}
+			values = append(values, value)
+		}
+		if err := conf.setStrings(key, values); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unsupported type setting " + config.ConfigTypeName[Settings[key].configType])
 	}
@@ -666,10 +751,30 @@ func (conf *Config) get(key Setting) interface{} {
 		return conf.FreeAllocationSettings.WritePriceRange.Max
 	case FreeAllocationReadPoolFraction:
 		return conf.FreeAllocationSettings.ReadPoolFraction
+	case AssurancePrice:
+		return conf.Assurance.Price
+	case AssuranceChallengeWeight:
+		return conf.Assurance.ChallengeWeight
+	case GovernanceCouncil:
+		return conf.Governance.Council
+	case GovernanceQuorum:
+		return conf.Governance.Quorum
+	case GovernanceThreshold:
+		return conf.Governance.Threshold
+	case GovernanceVotingPeriod:
+		return conf.Governance.VotingPeriod
+	case GovernanceTimelock:
+		return conf.Governance.Timelock
+	case GovernanceMinStake:
+		return conf.Governance.MinStake
+	case GovernanceMaxVoters:
+		return conf.Governance.MaxVoters
 	case ValidatorReward:
 		return conf.ValidatorReward
 	case StakePoolKillSlash:
 		return conf.StakePool.KillSlash
+	case StakePoolUnbondingRounds:
+		return conf.StakePool.UnbondingRounds
 	case BlobberSlash:
 		return conf.BlobberSlash
 	case MaxBlobbersPerAllocation:
@@ -752,9 +857,22 @@ func (ssc *StorageSmartContract) updateSettings(
 		return "", nil
 	}
 
+	if err := governance.CheckOwnerChanges(newChanges); err != nil {
+		return "", common.NewError("update_settings", err.Error())
+	}
+
+	if err := addSettingChanges(conf, newChanges, balances); err != nil {
+		return "", err
+	}
+
+	return "", nil
+}
+
+// addSettingChanges to the pending changes applied by commitSettingChanges
+func addSettingChanges(conf *Config, newChanges config.StringMap, balances chainState.StateContextI) error {
 	updateChanges, err := getSettingChanges(balances)
 	if err != nil {
-		return "", common.NewError("update_settings, getting setting changes", err.Error())
+		return common.NewError("update_settings, getting setting changes", err.Error())
 	}
 
 	for key, value := range newChanges.Fields {
@@ -763,15 +881,14 @@ func (ssc *StorageSmartContract) updateSettings(
 
 	err = conf.update(*updateChanges)
 	if err != nil {
-		return "", common.NewError("update_settings, updating settings", err.Error())
+		return common.NewError("update_settings, updating settings", err.Error())
 	}
 
 	_, err = balances.InsertTrieNode(settingChangesKey, updateChanges)
 	if err != nil {
-		return "", common.NewError("update_settings", err.Error())
+		return common.NewError("update_settings", err.Error())
 	}
-
-	return "", nil
+	return nil
 }
 
 func (ssc *StorageSmartContract) commitSettingChanges(
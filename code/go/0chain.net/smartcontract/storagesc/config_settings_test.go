@@ -3,6 +3,7 @@ package storagesc
 import (
 	"encoding/hex"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -143,6 +144,9 @@ func TestUpdateSettings(t *testing.T) {
 					"free_allocation_settings.write_price_range.max": "0.1",
 					"free_allocation_settings.read_pool_fraction":    "0.2",
 
+					"assurance.price":            "0.5",
+					"assurance.challenge_weight": "1",
+
 					"validator_reward":               "0.025",
 					"blobber_slash":                  "0.1",
 					"max_read_price":                 "100",
@@ -189,6 +193,21 @@ func TestUpdateSettings(t *testing.T) {
 				},
 			},
 		},
+		{
+			title: "governance_settings",
+			parameters: parameters{
+				client:      owner,
+				previousMap: map[string]string{},
+				inputMap: map[string]string{
+					"max_read_price":    "100",
+					"governance.quorum": "1",
+				},
+			},
+			want: want{
+				error: true,
+				msg:   "update_settings: governance settings can only be changed by a proposal",
+			},
+		},
 	}
 	for _, test := range testCases {
 		t.Run(test.title, func(t *testing.T) {
@@ -329,6 +348,14 @@ func TestCommitSettingChanges(t *testing.T) {
 								return false
 							}
 						}
+					case config.Strings:
+						{
+							actual, ok := setting.([]string)
+							require.True(t, ok)
+							if value != strings.Join(actual, ",") {
+								return false
+							}
+						}
 					default:
 						return false
 					}
@@ -373,6 +400,14 @@ func TestCommitSettingChanges(t *testing.T) {
 					"max_individual_free_allocation": "100",
 					"cancellation_charge":            "0.2",
 
+					"governance.council":       "f769ccdf8587b8cab6a0f6a8a5a0a91d3405392768f283c80a45d6023a1bfa1f",
+					"governance.quorum":        "1000",
+					"governance.threshold":     "0.66",
+					"governance.voting_period": "72h",
+					"governance.timelock":      "24h",
+					"governance.min_stake":     "10",
+					"governance.max_voters":    "100",
+
 					"free_allocation_settings.data_shards":           "10",
 					"free_allocation_settings.parity_shards":         "5",
 					"free_allocation_settings.size":                  "10000000000",
@@ -498,6 +533,24 @@ func getConfField(conf Config, field string) interface{} {
 		return conf.FreeAllocationSettings.WritePriceRange.Max
 	case FreeAllocationReadPoolFraction:
 		return conf.FreeAllocationSettings.ReadPoolFraction
+	case AssurancePrice:
+		return conf.Assurance.Price
+	case AssuranceChallengeWeight:
+		return conf.Assurance.ChallengeWeight
+	case GovernanceCouncil:
+		return conf.Governance.Council
+	case GovernanceQuorum:
+		return conf.Governance.Quorum
+	case GovernanceThreshold:
+		return conf.Governance.Threshold
+	case GovernanceVotingPeriod:
+		return conf.Governance.VotingPeriod
+	case GovernanceTimelock:
+		return conf.Governance.Timelock
+	case GovernanceMinStake:
+		return conf.Governance.MinStake
+	case GovernanceMaxVoters:
+		return conf.Governance.MaxVoters
 
 	case ValidatorReward:
 		return conf.ValidatorReward
@@ -0,0 +1,204 @@
+package storagesc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+const (
+	earthRadiusKm = 6371.0
+	// geoRegionSize in degrees, the globe is split into the grid of
+	// 6 x 12 regions to count distinct regions of the blobbers
+	geoRegionSize = 30.0
+)
+
+// GeoBoundingBox is an area the blobbers are allowed in. The box crosses
+// the antimeridian if MinLongitude is greater than MaxLongitude.
+type GeoBoundingBox struct {
+	MinLatitude  float64 `json:"min_latitude"`
+	MaxLatitude  float64 `json:"max_latitude"`
+	MinLongitude float64 `json:"min_longitude"`
+	MaxLongitude float64 `json:"max_longitude"`
+}
+
+func (b *GeoBoundingBox) validate() error {
+	if b.MinLatitude < MinLatitude || b.MaxLatitude > MaxLatitude || b.MinLatitude > b.MaxLatitude {
+		return fmt.Errorf("invalid latitude range [%v, %v]", b.MinLatitude, b.MaxLatitude)
+	}
+	if b.MinLongitude < MinLongitude || b.MinLongitude > MaxLongitude ||
+		b.MaxLongitude < MinLongitude || b.MaxLongitude > MaxLongitude {
+		return fmt.Errorf("invalid longitude range [%v, %v]", b.MinLongitude, b.MaxLongitude)
+	}
+	return nil
+}
+
+func (b *GeoBoundingBox) contains(loc StorageNodeGeolocation) bool {
+	if loc.Latitude < b.MinLatitude || loc.Latitude > b.MaxLatitude {
+		return false
+	}
+	if b.MinLongitude <= b.MaxLongitude {
+		return b.MinLongitude <= loc.Longitude && loc.Longitude <= b.MaxLongitude
+	}
+	return loc.Longitude >= b.MinLongitude || loc.Longitude <= b.MaxLongitude
+}
+
+// GeoConstraints on the locations of the allocation blobbers.
+type GeoConstraints struct {
+	// MinDistance between any two blobbers, in km.
+	MinDistance float64 `json:"min_distance,omitempty"`
+	// AllowedAreas the blobbers must be in, any area if empty.
+	AllowedAreas []GeoBoundingBox `json:"allowed_areas,omitempty"`
+	// MinRegions is the number of distinct regions the blobbers must be in.
+	MinRegions int `json:"min_regions,omitempty"`
+}
+
+func (gc *GeoConstraints) validate(blobbers int) error {
+	if gc.MinDistance < 0 {
+		return errors.New("negative min_distance")
+	}
+	if gc.MinRegions < 0 || gc.MinRegions > blobbers {
+		return fmt.Errorf("min_regions must be between 0 and the number of blobbers %d", blobbers)
+	}
+	for i := range gc.AllowedAreas {
+		if err := gc.AllowedAreas[i].validate(); err != nil {
+			return fmt.Errorf("allowed area %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (gc *GeoConstraints) isAllowed(loc StorageNodeGeolocation) bool {
+	if len(gc.AllowedAreas) == 0 {
+		return true
+	}
+	for i := range gc.AllowedAreas {
+		if gc.AllowedAreas[i].contains(loc) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFarEnough from all the given locations
+func (gc *GeoConstraints) isFarEnough(loc StorageNodeGeolocation, from []StorageNodeGeolocation) bool {
+	for _, l := range from {
+		if geoDistance(loc, l) < gc.MinDistance {
+			return false
+		}
+	}
+	return true
+}
+
+// selectBlobbers picks the indexes of size locations satisfying the
+// constraints, the earlier locations are preferred. The picked indexes are
+// in the original order.
+func (gc *GeoConstraints) selectBlobbers(locs []StorageNodeGeolocation, size int) ([]int, error) {
+	var allowed []int
+	for i, loc := range locs {
+		if gc.isAllowed(loc) {
+			allowed = append(allowed, i)
+		}
+	}
+
+	var (
+		picked    = make([]bool, len(locs))
+		pickedLoc = make([]StorageNodeGeolocation, 0, size)
+		regions   = make(map[int]struct{})
+	)
+	pick := func(newRegionOnly bool) {
+		for _, i := range allowed {
+			if len(pickedLoc) == size {
+				return
+			}
+			if picked[i] {
+				continue
+			}
+			region := geoRegion(locs[i])
+			if _, ok := regions[region]; ok && newRegionOnly {
+				continue
+			}
+			if !gc.isFarEnough(locs[i], pickedLoc) {
+				continue
+			}
+			picked[i] = true
+			pickedLoc = append(pickedLoc, locs[i])
+			regions[region] = struct{}{}
+		}
+	}
+	// cover as many regions as possible first
+	pick(true)
+	pick(false)
+
+	var shortfall []string
+	switch {
+	case len(allowed) < size:
+		shortfall = append(shortfall, fmt.Sprintf("%d of %d blobbers in the allowed areas",
+			len(allowed), size))
+	case len(pickedLoc) < size:
+		shortfall = append(shortfall, fmt.Sprintf("%d of %d blobbers at least %v km apart",
+			len(pickedLoc), size, gc.MinDistance))
+	}
+	if len(regions) < gc.MinRegions {
+		shortfall = append(shortfall, fmt.Sprintf("%d of %d distinct regions",
+			len(regions), gc.MinRegions))
+	}
+	if len(shortfall) > 0 {
+		return nil, errors.New("geo constraints can't be satisfied, found " +
+			strings.Join(shortfall, ", "))
+	}
+
+	idx := make([]int, 0, size)
+	for i := range picked {
+		if picked[i] {
+			idx = append(idx, i)
+		}
+	}
+	return idx, nil
+}
+
+// check the blobbers of the allocation satisfy the constraints
+func (gc *GeoConstraints) check(blobbers []*StorageNode) error {
+	locs := make([]StorageNodeGeolocation, 0, len(blobbers))
+	for _, b := range blobbers {
+		locs = append(locs, b.Geolocation)
+	}
+	_, err := gc.selectBlobbers(locs, len(locs))
+	return err
+}
+
+// geoRegion of the location, the cell of the region grid
+func geoRegion(loc StorageNodeGeolocation) int {
+	band := func(v, min float64, n int) int {
+		i := int((v - min) / geoRegionSize)
+		if i >= n {
+			i = n - 1
+		}
+		if i < 0 {
+			i = 0
+		}
+		return i
+	}
+	const (
+		latBands = int((MaxLatitude - MinLatitude) / geoRegionSize)
+		lonBands = int((MaxLongitude - MinLongitude) / geoRegionSize)
+	)
+	return band(loc.Latitude, MinLatitude, latBands)*lonBands +
+		band(loc.Longitude, MinLongitude, lonBands)
+}
+
+// geoDistance is the great-circle distance between the locations in km
+func geoDistance(a, b StorageNodeGeolocation) float64 {
+	var (
+		lat1 = a.Latitude * math.Pi / 180
+		lat2 = b.Latitude * math.Pi / 180
+		dLat = lat2 - lat1
+		dLon = (b.Longitude - a.Longitude) * math.Pi / 180
+		h    = math.Sin(dLat/2)*math.Sin(dLat/2) +
+			math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
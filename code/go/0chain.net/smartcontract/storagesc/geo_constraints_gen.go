@@ -0,0 +1,180 @@
+package storagesc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *GeoBoundingBox) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "MinLatitude"
+	o = append(o, 0x84, 0xab, 0x4d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65)
+	o = msgp.AppendFloat64(o, z.MinLatitude)
+	// string "MaxLatitude"
+	o = append(o, 0xab, 0x4d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65)
+	o = msgp.AppendFloat64(o, z.MaxLatitude)
+	// string "MinLongitude"
+	o = append(o, 0xac, 0x4d, 0x69, 0x6e, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65)
+	o = msgp.AppendFloat64(o, z.MinLongitude)
+	// string "MaxLongitude"
+	o = append(o, 0xac, 0x4d, 0x61, 0x78, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65)
+	o = msgp.AppendFloat64(o, z.MaxLongitude)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *GeoBoundingBox) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "MinLatitude":
+			z.MinLatitude, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinLatitude")
+				return
+			}
+		case "MaxLatitude":
+			z.MaxLatitude, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxLatitude")
+				return
+			}
+		case "MinLongitude":
+			z.MinLongitude, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinLongitude")
+				return
+			}
+		case "MaxLongitude":
+			z.MaxLongitude, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxLongitude")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *GeoBoundingBox) Msgsize() (s int) {
+	s = 1 + 12 + msgp.Float64Size + 12 + msgp.Float64Size + 13 + msgp.Float64Size + 13 + msgp.Float64Size
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *GeoConstraints) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "MinDistance"
+	o = append(o, 0x83, 0xab, 0x4d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65)
+	o = msgp.AppendFloat64(o, z.MinDistance)
+	// string "AllowedAreas"
+	o = append(o, 0xac, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x72, 0x65, 0x61, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.AllowedAreas)))
+	for za0001 := range z.AllowedAreas {
+		o, err = z.AllowedAreas[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "AllowedAreas", za0001)
+			return
+		}
+	}
+	// string "MinRegions"
+	o = append(o, 0xaa, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73)
+	o = msgp.AppendInt(o, z.MinRegions)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *GeoConstraints) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "MinDistance":
+			z.MinDistance, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinDistance")
+				return
+			}
+		case "AllowedAreas":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AllowedAreas")
+				return
+			}
+			if cap(z.AllowedAreas) >= int(zb0002) {
+				z.AllowedAreas = (z.AllowedAreas)[:zb0002]
+			} else {
+				z.AllowedAreas = make([]GeoBoundingBox, zb0002)
+			}
+			for za0001 := range z.AllowedAreas {
+				bts, err = z.AllowedAreas[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AllowedAreas", za0001)
+					return
+				}
+			}
+		case "MinRegions":
+			z.MinRegions, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinRegions")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *GeoConstraints) Msgsize() (s int) {
+	s = 1 + 12 + msgp.Float64Size + 13 + msgp.ArrayHeaderSize
+	for za0001 := range z.AllowedAreas {
+		s += z.AllowedAreas[za0001].Msgsize()
+	}
+	s += 11 + msgp.IntSize
+	return
+}
@@ -0,0 +1,87 @@
+package storagesc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoDistance(t *testing.T) {
+	var (
+		london = StorageNodeGeolocation{Latitude: 51.5074, Longitude: -0.1278}
+		paris  = StorageNodeGeolocation{Latitude: 48.8566, Longitude: 2.3522}
+	)
+	require.InDelta(t, 344, geoDistance(london, paris), 1)
+	require.Zero(t, geoDistance(paris, paris))
+	// across the antimeridian
+	require.InDelta(t, 111, geoDistance(
+		StorageNodeGeolocation{Longitude: 179.5},
+		StorageNodeGeolocation{Longitude: -179.5},
+	), 1)
+}
+
+func TestGeoBoundingBox(t *testing.T) {
+	box := GeoBoundingBox{MinLatitude: -10, MaxLatitude: 10, MinLongitude: 170, MaxLongitude: -170}
+	require.NoError(t, box.validate())
+	require.True(t, box.contains(StorageNodeGeolocation{Latitude: 0, Longitude: 175}))
+	require.True(t, box.contains(StorageNodeGeolocation{Latitude: 0, Longitude: -175}))
+	require.False(t, box.contains(StorageNodeGeolocation{Latitude: 0, Longitude: 0}))
+	require.False(t, box.contains(StorageNodeGeolocation{Latitude: 20, Longitude: 175}))
+
+	box.MaxLatitude = -20
+	require.EqualError(t, box.validate(), "invalid latitude range [-10, -20]")
+}
+
+func TestGeoConstraintsSelectBlobbers(t *testing.T) {
+	locs := []StorageNodeGeolocation{
+		{Latitude: 51.5, Longitude: -0.1},  // London
+		{Latitude: 40.4, Longitude: -3.7},  // Madrid
+		{Latitude: 51.6, Longitude: -0.2},  // London
+		{Latitude: 40.7, Longitude: -74},   // New York
+		{Latitude: 35.7, Longitude: 139.7}, // Tokyo
+	}
+
+	t.Run("min distance", func(t *testing.T) {
+		gc := GeoConstraints{MinDistance: 100}
+		idx, err := gc.selectBlobbers(locs, 4)
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 1, 3, 4}, idx)
+
+		_, err = gc.selectBlobbers(locs[:3], 3)
+		require.EqualError(t, err, "geo constraints can't be satisfied, found 2 of 3 blobbers at least 100 km apart")
+	})
+
+	t.Run("regions", func(t *testing.T) {
+		gc := GeoConstraints{MinRegions: 3}
+		idx, err := gc.selectBlobbers(locs, 3)
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 3, 4}, idx)
+
+		_, err = gc.selectBlobbers(locs[:3], 3)
+		require.EqualError(t, err, "geo constraints can't be satisfied, found 1 of 3 distinct regions")
+	})
+
+	t.Run("allowed areas", func(t *testing.T) {
+		gc := GeoConstraints{
+			MinRegions: 2,
+			AllowedAreas: []GeoBoundingBox{
+				{MinLatitude: 30, MaxLatitude: 60, MinLongitude: -10, MaxLongitude: 10},
+			},
+		}
+		_, err := gc.selectBlobbers(locs, 4)
+		require.EqualError(t, err, "geo constraints can't be satisfied, found 3 of 4 blobbers in the allowed areas, 1 of 2 distinct regions")
+	})
+
+	t.Run("check", func(t *testing.T) {
+		gc := GeoConstraints{MinDistance: 100}
+		require.NoError(t, gc.check([]*StorageNode{{Geolocation: locs[0]}, {Geolocation: locs[1]}}))
+		require.Error(t, gc.check([]*StorageNode{{Geolocation: locs[0]}, {Geolocation: locs[2]}}))
+	})
+}
+
+func TestGeoConstraintsValidate(t *testing.T) {
+	require.NoError(t, (&GeoConstraints{MinDistance: 10, MinRegions: 2}).validate(2))
+	require.EqualError(t, (&GeoConstraints{MinDistance: -1}).validate(2), "negative min_distance")
+	require.EqualError(t, (&GeoConstraints{MinRegions: 3}).validate(2),
+		"min_regions must be between 0 and the number of blobbers 2")
+}
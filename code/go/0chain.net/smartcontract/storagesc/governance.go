@@ -0,0 +1,85 @@
+package storagesc
+
+import (
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+func init() {
+	getSP := func(providerType spenum.Provider) func(string, cstate.CommonStateContextI) (stakepool.AbstractStakePool, error) {
+		return func(providerID string, balances cstate.CommonStateContextI) (stakepool.AbstractStakePool, error) {
+			sp, err := getStakePool(providerType, providerID, balances)
+			if err != nil {
+				return nil, err
+			}
+			return sp, nil
+		}
+	}
+	governance.RegisterStakePools(spenum.Blobber, getSP(spenum.Blobber))
+	governance.RegisterStakePools(spenum.Validator, getSP(spenum.Validator))
+}
+
+// governanceSettings are the storage settings changed by the governance,
+// the changes of the passed proposals are added to the setting changes
+type governanceSettings struct{}
+
+func (governanceSettings) Config(balances cstate.StateContextI) (*governance.Config, error) {
+	conf, err := getConfig(balances)
+	if err != nil {
+		return nil, err
+	}
+	return &conf.Governance, nil
+}
+
+func (governanceSettings) Validate(changes config.StringMap, balances cstate.StateContextI) error {
+	conf, err := getConfig(balances)
+	if err != nil {
+		return err
+	}
+	if err := conf.update(changes); err != nil {
+		return err
+	}
+	return conf.validate()
+}
+
+func (governanceSettings) Apply(changes config.StringMap, balances cstate.StateContextI) error {
+	conf, err := getConfig(balances)
+	if err != nil {
+		return err
+	}
+	return addSettingChanges(conf, changes, balances)
+}
+
+// governancePropose submits the proposal of the settings changes, the
+// proposer is a council member or a staker
+func (ssc *StorageSmartContract) governancePropose(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	return governance.Propose(t, input, ssc.ID, governanceSettings{}, balances)
+}
+
+// governanceVote on the open proposal with the stake of the voter, a vote
+// again replaces the former one
+func (ssc *StorageSmartContract) governanceVote(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	return governance.CastVote(t, input, ssc.ID, governanceSettings{}, balances)
+}
+
+// governanceExecute closes the proposal after the voting, the passed one
+// is added to the setting changes after the timelock. Anyone can do it.
+func (ssc *StorageSmartContract) governanceExecute(
+	t *transaction.Transaction,
+	input []byte,
+	balances cstate.StateContextI,
+) (string, error) {
+	return governance.Execute(t, input, ssc.ID, governanceSettings{}, balances)
+}
@@ -0,0 +1,134 @@
+package storagesc
+
+import (
+	"testing"
+	"time"
+
+	chainState "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernance(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		outsider = newClient(100*x10, balances)
+		now      = int64(1000)
+		hour     = int64(time.Hour / time.Second)
+	)
+	conf := setConfig(t, balances)
+	conf.OwnerId = owner
+	conf.Governance = governance.Config{
+		Quorum:       1,
+		Threshold:    0.5,
+		VotingPeriod: time.Hour,
+		Timelock:     time.Hour,
+		MinStake:     50 * x10,
+		MaxVoters:    1,
+	}
+	mustSave(t, scConfigKey(ADDRESS), conf, balances)
+
+	staker := addBlobber(t, ssc, 10*GB, now, avgTerms, 50*x10, balances)
+	stakes := []governance.StakeRef{{ProviderType: spenum.Blobber, ProviderID: staker.id}}
+	small := addBlobber(t, ssc, 2*GB, now, avgTerms, 10*x10, balances)
+	smallStakes := []governance.StakeRef{{ProviderType: spenum.Blobber, ProviderID: small.id}}
+
+	type scFunc func(*transaction.Transaction, []byte, chainState.StateContextI) (string, error)
+	call := func(fn scFunc, clientID string, at int64, req interface{}) (string, error) {
+		tx := newTransaction(clientID, ADDRESS, 0, at)
+		balances.setTransaction(t, tx)
+		return fn(tx, mustEncode(t, req), balances)
+	}
+	propose, vote, execute := ssc.governancePropose, ssc.governanceVote, ssc.governanceExecute
+
+	changes := config.StringMap{Fields: map[string]string{"max_read_price": "50"}}
+	_, err := call(propose, outsider.id, now, &governance.ProposeRequest{Changes: changes})
+	require.EqualError(t, err, "governance_propose_failed: "+
+		"proposer is neither a council member nor a staker")
+	_, err = call(propose, small.id, now, &governance.ProposeRequest{Changes: changes, Stakes: smallStakes})
+	require.EqualError(t, err, "governance_propose_failed: "+
+		"stake 100000000000 is less than min stake 500000000000")
+	_, err = call(propose, staker.id, now, &governance.ProposeRequest{
+		Changes: config.StringMap{Fields: map[string]string{"max_read_price": "-1"}},
+		Stakes:  stakes,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "governance_propose_failed: invalid changes")
+
+	_, err = call(propose, staker.id, now, &governance.ProposeRequest{Changes: changes, Stakes: stakes})
+	require.NoError(t, err)
+	id := balances.GetTransaction().Hash
+
+	_, err = call(vote, outsider.id, now+1, &governance.VoteRequest{ProposalID: id, Approve: false})
+	require.EqualError(t, err, "governance_vote_failed: no stake to vote with")
+	_, err = call(vote, small.id, now+1, &governance.VoteRequest{ProposalID: id, Approve: false, Stakes: smallStakes})
+	require.EqualError(t, err, "governance_vote_failed: "+
+		"stake 100000000000 is less than min stake 500000000000")
+	_, err = call(vote, staker.id, now+1, &governance.VoteRequest{ProposalID: id, Approve: false, Stakes: stakes})
+	require.NoError(t, err)
+	// the vote again replaces the former one
+	_, err = call(vote, staker.id, now+2, &governance.VoteRequest{ProposalID: id, Approve: true, Stakes: stakes})
+	require.NoError(t, err)
+	p, err := governance.GetProposal(ADDRESS, id, balances)
+	require.NoError(t, err)
+	require.Len(t, p.Votes, 1)
+	require.EqualValues(t, 50*x10, p.Tally.Yes)
+	require.Zero(t, p.Tally.No)
+
+	// the max voters of the proposal are reached
+	conf.Governance.MinStake = 0
+	mustSave(t, scConfigKey(ADDRESS), conf, balances)
+	_, err = call(vote, small.id, now+3, &governance.VoteRequest{ProposalID: id, Approve: false, Stakes: smallStakes})
+	require.EqualError(t, err, "governance_vote_failed: max voters reached: 1")
+
+	// the stake added after the vote doesn't count
+	setStake := func(balance currency.Coin) {
+		sp, err := getStakePool(spenum.Blobber, staker.id, balances)
+		require.NoError(t, err)
+		for _, dp := range sp.Pools {
+			dp.Balance = balance
+		}
+		require.NoError(t, sp.Save(spenum.Blobber, staker.id, balances))
+	}
+	setStake(100 * x10)
+
+	_, err = call(execute, outsider.id, now+2, &governance.ExecuteRequest{ProposalID: id})
+	require.Error(t, err)
+	_, err = call(vote, small.id, now+hour+1, &governance.VoteRequest{ProposalID: id, Approve: true, Stakes: smallStakes})
+	require.EqualError(t, err, "governance_vote_failed: voting is closed")
+	_, err = call(execute, outsider.id, now+hour+1, &governance.ExecuteRequest{ProposalID: id})
+	require.Error(t, err)
+
+	_, err = call(execute, outsider.id, now+2*hour, &governance.ExecuteRequest{ProposalID: id})
+	require.NoError(t, err)
+	p, err = governance.GetProposal(ADDRESS, id, balances)
+	require.NoError(t, err)
+	require.Equal(t, governance.ProposalExecuted, p.Status)
+	require.EqualValues(t, 50*x10, p.Tally.Yes)
+	pending, err := getSettingChanges(balances)
+	require.NoError(t, err)
+	require.Equal(t, "50", pending.Fields["max_read_price"])
+
+	_, err = call(execute, outsider.id, now+2*hour, &governance.ExecuteRequest{ProposalID: id})
+	require.EqualError(t, err, "governance_execute_failed: proposal is closed")
+
+	// the stake unlocked after the vote doesn't count
+	now += 2 * hour
+	_, err = call(propose, staker.id, now, &governance.ProposeRequest{Changes: changes, Stakes: stakes})
+	require.NoError(t, err)
+	id = balances.GetTransaction().Hash
+	_, err = call(vote, staker.id, now+1, &governance.VoteRequest{ProposalID: id, Approve: true, Stakes: stakes})
+	require.NoError(t, err)
+	setStake(0)
+	_, err = call(execute, outsider.id, now+hour+1, &governance.ExecuteRequest{ProposalID: id})
+	require.NoError(t, err)
+	p, err = governance.GetProposal(ADDRESS, id, balances)
+	require.NoError(t, err)
+	require.Equal(t, governance.ProposalRejected, p.Status)
+	require.Zero(t, p.Tally.Yes)
+}
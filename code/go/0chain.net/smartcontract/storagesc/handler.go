@@ -26,6 +26,7 @@ import (
 	"0chain.net/smartcontract/stakepool/spenum"
 
 	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/governance"
 
 	"0chain.net/core/datastore"
 	"github.com/0chain/common/core/util"
@@ -59,13 +60,24 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		rest.MakeEndpoint(storage+"/blobbers-by-geolocation", common.UserRateLimit(srh.getBlobbersByGeoLocation)),
 		rest.MakeEndpoint(storage+"/transaction", common.UserRateLimit(srh.getTransactionByHash)),
 		rest.MakeEndpoint(storage+"/transactions", common.UserRateLimit(srh.getTransactionByFilter)),
+		rest.MakeEndpoint(storage+"/transactions-export", common.UserRateLimit(srh.getTransactionHistory)),
 
 		rest.MakeEndpoint(storage+"/writemarkers", common.UserRateLimit(srh.getWriteMarker)),
 		rest.MakeEndpoint(storage+"/errors", common.UserRateLimit(srh.getErrors)),
 		rest.MakeEndpoint(storage+"/allocations", common.UserRateLimit(srh.getAllocations)),
 		rest.MakeEndpoint(storage+"/allocation_min_lock", common.UserRateLimit(srh.getAllocationMinLock)),
+		rest.MakeEndpoint(storage+"/allocation-quote", common.UserRateLimit(srh.getAllocationQuote)),
 		rest.MakeEndpoint(storage+"/allocation-update-min-lock", common.UserRateLimit(srh.getAllocationUpdateMinLock)),
 		rest.MakeEndpoint(storage+"/allocation", common.UserRateLimit(srh.getAllocation)),
+		rest.MakeEndpoint(storage+"/allocation-acl", common.UserRateLimit(srh.getAllocationACL)),
+		rest.MakeEndpoint(storage+"/allocation-renewals", common.UserRateLimit(srh.getAllocationRenewals)),
+		rest.MakeEndpoint(storage+"/allocation-ownership-transfers", common.UserRateLimit(srh.getAllocationOwnershipTransfers)),
+		rest.MakeEndpoint(storage+"/blobber-capacity-reservations", common.UserRateLimit(srh.getBlobberCapacityReservations)),
+		rest.MakeEndpoint(storage+"/client-capacity-reservations", common.UserRateLimit(srh.getClientCapacityReservations)),
+		rest.MakeEndpoint(storage+"/allocation-audit", common.UserRateLimit(srh.getAllocationAudit)),
+		rest.MakeEndpoint(storage+"/blobber-migrations", common.UserRateLimit(srh.getBlobberMigrations)),
+		rest.MakeEndpoint(storage+"/governance-proposals", common.UserRateLimit(srh.getGovernanceProposals)),
+		rest.MakeEndpoint(storage+"/governance-proposal", common.UserRateLimit(srh.getGovernanceProposal)),
 		rest.MakeEndpoint(storage+"/latestreadmarker", common.UserRateLimit(srh.getLatestReadMarker)),
 		rest.MakeEndpoint(storage+"/readmarkers", common.UserRateLimit(srh.getReadMarkers)),
 		rest.MakeEndpoint(storage+"/count_readmarkers", common.UserRateLimit(srh.getReadMarkersCount)),
@@ -77,6 +89,7 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		rest.MakeEndpoint(storage+"/blobber-challenges", common.UserRateLimit(srh.getBlobberChallenges)),
 		rest.MakeEndpoint(storage+"/getStakePoolStat", common.UserRateLimit(srh.getStakePoolStat)),
 		rest.MakeEndpoint(storage+"/getUserStakePoolStat", common.UserRateLimit(srh.getUserStakePoolStat)),
+		rest.MakeEndpoint(storage+"/getUserUnbondingPools", common.UserRateLimit(srh.getUserUnbondingPools)),
 		rest.MakeEndpoint(storage+"/block", common.UserRateLimit(srh.getBlock)),
 		rest.MakeEndpoint(storage+"/get_blocks", common.UserRateLimit(srh.getBlocks)),
 		rest.MakeEndpoint(storage+"/storage-config", common.UserRateLimit(srh.getConfig)),
@@ -274,11 +287,13 @@ func (srh *StorageRestHandler) getFreeAllocationBlobbers(w http.ResponseWriter,
 }
 
 type allocationBlobbersRequest struct {
-	ParityShards    int        `json:"parity_shards"`
-	DataShards      int        `json:"data_shards"`
-	ReadPriceRange  PriceRange `json:"read_price_range"`
-	WritePriceRange PriceRange `json:"write_price_range"`
-	Size            int64      `json:"size"`
+	ParityShards    int             `json:"parity_shards"`
+	DataShards      int             `json:"data_shards"`
+	ReadPriceRange  PriceRange      `json:"read_price_range"`
+	WritePriceRange PriceRange      `json:"write_price_range"`
+	Size            int64           `json:"size"`
+	GeoConstraints  *GeoConstraints `json:"geo_constraints,omitempty"`
+	MinReputation   float64         `json:"min_reputation,omitempty"`
 }
 
 func (nar *allocationBlobbersRequest) decode(b []byte) error {
@@ -373,6 +388,11 @@ func getBlobbersForRequest(request allocationBlobbersRequest, edb *event.EventDb
 			"invalid data shards:%v or parity shards:%v", request.DataShards, request.ParityShards)
 	}
 
+	if request.MinReputation < 0 || request.MinReputation > 1 {
+		return nil, common.NewErrorf("allocation_creation_failed",
+			"invalid min_reputation: %v, must be between 0 and 1", request.MinReputation)
+	}
+
 	var allocationSize = bSize(request.Size, request.DataShards)
 
 	allocation := event.AllocationQuery{
@@ -393,12 +413,14 @@ func getBlobbersForRequest(request allocationBlobbersRequest, edb *event.EventDb
 		AllocationSize:     allocationSize,
 		AllocationSizeInGB: sizeInGB(allocationSize),
 		NumberOfDataShards: request.DataShards,
+		MinReputation:      request.MinReputation,
 	}
 
 	logging.Logger.Debug("alloc_blobbers", zap.Int64("ReadPriceRange.Min", allocation.ReadPriceRange.Min),
 		zap.Int64("ReadPriceRange.Max", allocation.ReadPriceRange.Max), zap.Int64("WritePriceRange.Min", allocation.WritePriceRange.Min),
 		zap.Int64("WritePriceRange.Max", allocation.WritePriceRange.Max),
 		zap.Int64("AllocationSize", allocation.AllocationSize), zap.Float64("AllocationSizeInGB", allocation.AllocationSizeInGB),
+		zap.Float64("MinReputation", allocation.MinReputation),
 		zap.Int64("last_health_check", int64(balances.Now())),
 	)
 
@@ -411,9 +433,53 @@ func getBlobbersForRequest(request allocationBlobbersRequest, edb *event.EventDb
 	if len(blobberIDs) < numberOfBlobbers {
 		return nil, errors.New(fmt.Sprintf("not enough blobbers to honor the allocation : %d < %d", len(blobberIDs), numberOfBlobbers))
 	}
+
+	if request.GeoConstraints != nil {
+		return selectGeoBlobbers(*request.GeoConstraints, blobberIDs, numberOfBlobbers, edb)
+	}
 	return blobberIDs, nil
 }
 
+// selectGeoBlobbers orders the blobbers satisfying the geo constraints
+// first, the rest of the blobbers in the allowed areas follow them.
+func selectGeoBlobbers(gc GeoConstraints, blobberIDs []string, size int, edb *event.EventDb) ([]string, error) {
+	if err := gc.validate(size); err != nil {
+		return nil, common.NewErrBadRequest("invalid geo_constraints: " + err.Error())
+	}
+
+	blobbers, err := edb.GetBlobbersFromIDs(blobberIDs)
+	if err != nil {
+		return nil, errors.New("failed to get blobbers: " + err.Error())
+	}
+	byID := make(map[string]event.Blobber, len(blobbers))
+	for _, b := range blobbers {
+		byID[b.ID] = b
+	}
+	locs := make([]StorageNodeGeolocation, 0, len(blobberIDs))
+	for _, id := range blobberIDs {
+		b := byID[id]
+		locs = append(locs, StorageNodeGeolocation{Latitude: b.Latitude, Longitude: b.Longitude})
+	}
+
+	idx, err := gc.selectBlobbers(locs, size)
+	if err != nil {
+		return nil, common.NewErrBadRequest(err.Error())
+	}
+
+	picked := make(map[int]bool, len(idx))
+	ids := make([]string, 0, len(blobberIDs))
+	for _, i := range idx {
+		picked[i] = true
+		ids = append(ids, blobberIDs[i])
+	}
+	for i, id := range blobberIDs {
+		if !picked[i] && gc.isAllowed(locs[i]) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/collected_reward collected_reward
 // Returns collected reward for a client_id.
 // > Note: start-date and end-date resolves to the closest block number for those timestamps on the network.
@@ -909,12 +975,16 @@ func (srh *StorageRestHandler) getUserStakePoolStat(w http.ResponseWriter, r *ht
 		var dps = stakepool.DelegatePoolStat{
 			ID:           pool.PoolID,
 			DelegateID:   pool.DelegateID,
-			UnStake:      false,
+			UnStake:      pool.Status == spenum.Unbonding,
 			ProviderId:   pool.ProviderID,
 			ProviderType: pool.ProviderType,
 			Status:       pool.Status.String(),
 			RoundCreated: pool.RoundCreated,
 			StakedAt:     pool.StakedAt,
+			ReleaseRound: pool.ReleaseRound,
+
+			AutoCompound:    pool.AutoCompound,
+			TotalCompounded: pool.TotalCompounded,
 		}
 		dps.Balance = pool.Balance
 
@@ -930,6 +1000,63 @@ func (srh *StorageRestHandler) getUserStakePoolStat(w http.ResponseWriter, r *ht
 	common.Respond(w, r, ups, nil)
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/getUserUnbondingPools getUserUnbondingPools
+// Gets the pending withdrawals of a user from the blobbers and the validators
+// stake pools with the rounds they can be claimed from
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client for which to get the unbonding pools
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []DelegatePool
+//	400:
+//	500:
+func (srh *StorageRestHandler) getUserUnbondingPools(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	pagination, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	pools, err := edb.GetUserUnbondingPools(clientID,
+		[]spenum.Provider{spenum.Blobber, spenum.Validator}, pagination)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get unbonding pools", err.Error()))
+		return
+	}
+
+	common.Respond(w, r, pools, nil)
+}
+
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/getStakePoolStat getStakePoolStat
 // Gets statistic for all locked tokens of a stake pool
 //
@@ -1602,6 +1729,47 @@ func (srh *StorageRestHandler) getLatestReadMarker(w http.ResponseWriter, r *htt
 	}
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/allocation-quote allocation-quote
+// Prices a new allocation request: the blobbers it would use, their prices, min lock demand, cancellation
+// charge, expected challenge pool and the total cost. The blobbers are selected if the request has none.
+// Nothing is saved.
+//
+// parameters:
+//
+//	+name: allocation_data
+//	 description: json marshall of new allocation request input data
+//	 in: query
+//	 type: string
+//	 required: true
+//
+// responses:
+//
+//	200: allocationQuote
+//	400:
+//	500:
+func (srh *StorageRestHandler) getAllocationQuote(w http.ResponseWriter, r *http.Request) {
+	var request newAllocationRequest
+	if err := request.decode([]byte(r.URL.Query().Get("allocation_data"))); err != nil {
+		common.Respond(w, r, nil, common.NewErrBadRequest("can't decode allocation request: "+err.Error()))
+		return
+	}
+
+	balances := srh.GetQueryStateContext()
+	conf, err := getConfig(balances)
+	if err != nil {
+		common.Respond(w, r, nil, smartcontract.NewErrNoResourceOrErrInternal(err, true, cantGetConfigErrMsg))
+		return
+	}
+
+	quote, err := quoteAllocation(request, conf, balances)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrBadRequest("can't quote the allocation: "+err.Error()))
+		return
+	}
+
+	common.Respond(w, r, quote, nil)
+}
+
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/allocation_min_lock allocation_min_lock
 // Calculates the cost of a new allocation request.
 //
@@ -1778,6 +1946,14 @@ func (srh *StorageRestHandler) getAllocationUpdateMinLock(w http.ResponseWriter,
 		return
 	}
 
+	if req.assuranceTierChanged(&alloc.StorageAllocation) {
+		if err := validateAssuranceTier(*req.AssuranceTier); err != nil {
+			common.Respond(w, r, nil, common.NewErrBadRequest(err.Error()))
+			return
+		}
+		alloc.setAssuranceTier(*req.AssuranceTier, conf)
+	}
+
 	if err := updateAllocBlobberTerms(edb, &alloc.StorageAllocation); err != nil {
 		common.Respond(w, r, nil, err)
 		return
@@ -1948,7 +2124,7 @@ func updateAllocBlobberTerms(
 	}
 
 	for i := range alloc.BlobberAllocs {
-		alloc.BlobberAllocs[i].Terms = bTerms[i]
+		alloc.BlobberAllocs[i].Terms = alloc.assuranceTerms(bTerms[i])
 	}
 
 	return nil
@@ -2104,16 +2280,20 @@ func (srh *StorageRestHandler) getAllocation(w http.ResponseWriter, r *http.Requ
 	common.Respond(w, r, sa, nil)
 }
 
-// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/errors errors
-// Gets errors returned by indicated transaction
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/allocation-acl allocation-acl
+// Gets per-client access grants of an allocation
 //
 // parameters:
 //
-//	+name: transaction_hash
-//	 description: transaction_hash
+//	+name: allocation_id
+//	 description: allocation id
 //	 required: true
 //	 in: query
 //	 type: string
+//	+name: client_id
+//	 description: filter grants by this client
+//	 in: query
+//	 type: string
 //	+name: offset
 //	 description: offset
 //	 in: query
@@ -2129,14 +2309,18 @@ func (srh *StorageRestHandler) getAllocation(w http.ResponseWriter, r *http.Requ
 //
 // responses:
 //
-//	200: []Error
+//	200: []AllocationACL
 //	400:
 //	500:
-func (srh *StorageRestHandler) getErrors(w http.ResponseWriter, r *http.Request) {
-
+func (srh *StorageRestHandler) getAllocationACL(w http.ResponseWriter, r *http.Request) {
 	var (
-		transactionHash = r.URL.Query().Get("transaction_hash")
+		allocationID = r.URL.Query().Get("allocation_id")
+		clientID     = r.URL.Query().Get("client_id")
 	)
+	if allocationID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing allocation_id"))
+		return
+	}
 
 	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
 	if err != nil {
@@ -2144,69 +2328,30 @@ func (srh *StorageRestHandler) getErrors(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if len(transactionHash) == 0 {
-		common.Respond(w, r, nil, common.NewErrBadRequest("transaction_hash is empty"))
-		return
-	}
 	edb := srh.GetQueryStateContext().GetEventDB()
 	if edb == nil {
 		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
 		return
 	}
-	rtv, err := edb.GetErrorByTransactionHash(transactionHash, limit)
+	acl, err := edb.GetAllocationACL(allocationID, clientID, limit)
 	if err != nil {
-		common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+		common.Respond(w, r, nil, common.NewErrInternal("can't get allocation acl", err.Error()))
 		return
 	}
-	common.Respond(w, r, rtv, nil)
-}
-
-type WriteMarkerResponse struct {
-	ID            uint
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	ClientID      string `json:"client_id"`
-	BlobberID     string `json:"blobber_id"`
-	AllocationID  string `json:"allocation_id"` //used in alloc_write_marker_count, alloc_written_size
-	TransactionID string `json:"transaction_id"`
-
-	AllocationRoot         string `json:"allocation_root"`
-	PreviousAllocationRoot string `json:"previous_allocation_root"`
-	Size                   int64  `json:"size"`
-	Timestamp              int64  `json:"timestamp"`
-	Signature              string `json:"signature"`
-	BlockNumber            int64  `json:"block_number"` //used in alloc_written_size
-
-	// TODO: Decide which pieces of information are important to the response
-	// User       User       `model:"foreignKey:ClientID;references:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	// Allocation Allocation `model:"references:AllocationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-}
-
-func toWriteMarkerResponse(wm event.WriteMarker) WriteMarkerResponse {
-	return WriteMarkerResponse{
-		ID:                     wm.ID,
-		CreatedAt:              wm.CreatedAt,
-		UpdatedAt:              wm.UpdatedAt,
-		Timestamp:              wm.Timestamp,
-		ClientID:               wm.ClientID,
-		BlobberID:              wm.BlobberID,
-		AllocationID:           wm.AllocationID,
-		TransactionID:          wm.TransactionID,
-		AllocationRoot:         wm.AllocationRoot,
-		PreviousAllocationRoot: wm.PreviousAllocationRoot,
-		Size:                   wm.Size,
-		Signature:              wm.Signature,
-		BlockNumber:            wm.BlockNumber,
 
-		// TODO: Add sub-fields or relationships as needed
-	}
+	common.Respond(w, r, acl, nil)
 }
 
-// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/writemarkers writemarkers
-// Gets list of write markers satisfying filter
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/allocation-renewals allocation-renewals
+// Gets automatic renewals of an allocation, successful and failed
 //
 // parameters:
 //
+//	+name: allocation_id
+//	 description: allocation id
+//	 required: true
+//	 in: query
+//	 type: string
 //	+name: offset
 //	 description: offset
 //	 in: query
@@ -2215,17 +2360,23 @@ func toWriteMarkerResponse(wm event.WriteMarker) WriteMarkerResponse {
 //	 description: limit
 //	 in: query
 //	 type: string
-//	+name: is_descending
-//	 description: is descending
+//	+name: sort
+//	 description: desc or asc
 //	 in: query
 //	 type: string
 //
 // responses:
 //
-//	200: []WriteMarker
+//	200: []AllocationRenewal
 //	400:
 //	500:
-func (srh *StorageRestHandler) getWriteMarker(w http.ResponseWriter, r *http.Request) {
+func (srh *StorageRestHandler) getAllocationRenewals(w http.ResponseWriter, r *http.Request) {
+	allocationID := r.URL.Query().Get("allocation_id")
+	if allocationID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing allocation_id"))
+		return
+	}
+
 	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
 	if err != nil {
 		common.Respond(w, r, nil, err)
@@ -2237,38 +2388,23 @@ func (srh *StorageRestHandler) getWriteMarker(w http.ResponseWriter, r *http.Req
 		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
 		return
 	}
-	rtv, err := edb.GetWriteMarkers(limit)
+	renewals, err := edb.GetAllocationRenewals(allocationID, limit)
 	if err != nil {
-		common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+		common.Respond(w, r, nil, common.NewErrInternal("can't get allocation renewals", err.Error()))
 		return
 	}
 
-	wmrs := make([]WriteMarkerResponse, 0, len(rtv))
-	for _, wm := range rtv {
-		wmrs = append(wmrs, toWriteMarkerResponse(wm))
-	}
-
-	common.Respond(w, r, wmrs, nil)
+	common.Respond(w, r, renewals, nil)
 }
 
-// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/transactions transactions
-// Gets filtered list of transaction information. The list is filtered on the first valid input,
-// or otherwise all the endpoint returns all translations.
-//
-// Filters processed in the order: client id, to client id, block hash and start, end blocks.
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/allocation-ownership-transfers allocation-ownership-transfers
+// Gets completed ownership transfers of an allocation
 //
 // parameters:
 //
-//	+name: client_id
-//	 description: restrict to transactions sent by the specified client
-//	 in: query
-//	 type: string
-//	+name: to_client_id
-//	 description: restrict to transactions sent to a specified client
-//	 in: query
-//	 type: string
-//	+name: block_hash
-//	 description: restrict to transactions in indicated block
+//	+name: allocation_id
+//	 description: allocation id
+//	 required: true
 //	 in: query
 //	 type: string
 //	+name: offset
@@ -2283,26 +2419,18 @@ func (srh *StorageRestHandler) getWriteMarker(w http.ResponseWriter, r *http.Req
 //	 description: desc or asc
 //	 in: query
 //	 type: string
-//	+name: start
-//	 description: restrict to transactions in specified start block and endblock
-//	 in: query
-//	 type: string
-//	+name: end
-//	 description: restrict to transactions in specified start block and endblock
-//	 in: query
-//	 type: string
 //
 // responses:
 //
-//	200: []Transaction
+//	200: []AllocationOwnershipTransfer
 //	400:
 //	500:
-func (srh *StorageRestHandler) getTransactionByFilter(w http.ResponseWriter, r *http.Request) {
-	var (
-		clientID   = r.URL.Query().Get("client_id")
-		toClientID = r.URL.Query().Get("to_client_id")
-		blockHash  = r.URL.Query().Get("block_hash")
-	)
+func (srh *StorageRestHandler) getAllocationOwnershipTransfers(w http.ResponseWriter, r *http.Request) {
+	allocationID := r.URL.Query().Get("allocation_id")
+	if allocationID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing allocation_id"))
+		return
+	}
 
 	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
 	if err != nil {
@@ -2315,38 +2443,606 @@ func (srh *StorageRestHandler) getTransactionByFilter(w http.ResponseWriter, r *
 		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
 		return
 	}
-	if clientID != "" {
-		rtv, err := edb.GetTransactionByClientId(clientID, limit)
-		if err != nil {
-			common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
-			return
-		}
-		common.Respond(w, r, rtv, nil)
-		return
-	}
-
-	if toClientID != "" {
-		rtv, err := edb.GetTransactionByToClientId(toClientID, limit)
-		if err != nil {
-			common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
-			return
-		}
-		common.Respond(w, r, rtv, nil)
+	transfers, err := edb.GetAllocationOwnershipTransfers(allocationID, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get allocation ownership transfers", err.Error()))
 		return
 	}
 
-	if blockHash != "" {
-		rtv, err := edb.GetTransactionByBlockHash(blockHash, limit)
-		if err != nil {
-			common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
-			return
-		}
-		common.Respond(w, r, rtv, nil)
-		return
-	}
+	common.Respond(w, r, transfers, nil)
+}
 
-	start, end, err := common2.GetStartEndBlock(r.URL.Query())
-	if err != nil || end == 0 {
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/blobber-capacity-reservations blobber-capacity-reservations
+// Gets capacity reservations of a blobber
+//
+// parameters:
+//
+//	+name: blobber_id
+//	 description: blobber id
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []CapacityReservation
+//	400:
+//	500:
+func (srh *StorageRestHandler) getBlobberCapacityReservations(w http.ResponseWriter, r *http.Request) {
+	srh.getCapacityReservations(w, r, "blobber_id", (*event.EventDb).GetBlobberCapacityReservations)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/client-capacity-reservations client-capacity-reservations
+// Gets capacity reservations of a client
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client id
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []CapacityReservation
+//	400:
+//	500:
+func (srh *StorageRestHandler) getClientCapacityReservations(w http.ResponseWriter, r *http.Request) {
+	srh.getCapacityReservations(w, r, "client_id", (*event.EventDb).GetClientCapacityReservations)
+}
+
+func (srh *StorageRestHandler) getCapacityReservations(w http.ResponseWriter, r *http.Request, param string,
+	query func(*event.EventDb, string, common2.Pagination) ([]event.CapacityReservation, error)) {
+
+	id := r.URL.Query().Get(param)
+	if id == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing "+param))
+		return
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	reservations, err := query(edb, id, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get capacity reservations", err.Error()))
+		return
+	}
+
+	common.Respond(w, r, reservations, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/blobber-migrations blobber-migrations
+// Gets the progress of the data migrations of an allocation or of a blobber
+// migrated from or to, either allocation_id or blobber_id is required
+//
+// parameters:
+//
+//	+name: allocation_id
+//	 description: allocation id
+//	 in: query
+//	 type: string
+//	+name: blobber_id
+//	 description: blobber id
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []BlobberMigration
+//	400:
+//	500:
+func (srh *StorageRestHandler) getBlobberMigrations(w http.ResponseWriter, r *http.Request) {
+	var (
+		allocationID = r.URL.Query().Get("allocation_id")
+		blobberID    = r.URL.Query().Get("blobber_id")
+	)
+	if allocationID == "" && blobberID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing allocation_id or blobber_id"))
+		return
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	var migrations []event.BlobberMigration
+	if allocationID != "" {
+		migrations, err = edb.GetAllocationBlobberMigrations(allocationID, limit)
+	} else {
+		migrations, err = edb.GetBlobberMigrations(blobberID, limit)
+	}
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get blobber migrations", err.Error()))
+		return
+	}
+
+	common.Respond(w, r, migrations, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/governance-proposals governance-proposals
+// Gets the governance proposals of the settings changes of a smart contract
+//
+// parameters:
+//
+//	+name: smart_contract
+//	 description: address of the smart contract, the storage one if missing
+//	 in: query
+//	 type: string
+//	+name: status
+//	 description: open, executed or rejected, all the proposals if missing
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []GovernanceProposal
+//	400:
+//	500:
+func (srh *StorageRestHandler) getGovernanceProposals(w http.ResponseWriter, r *http.Request) {
+	scAddress := r.URL.Query().Get("smart_contract")
+	if scAddress == "" {
+		scAddress = ADDRESS
+	}
+
+	status := -1
+	if s := r.URL.Query().Get("status"); s != "" {
+		var ok bool
+		if status, ok = governance.ProposalStatusFromString(s); !ok {
+			common.Respond(w, r, nil, common.NewErrBadRequest("invalid status "+s))
+			return
+		}
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	proposals, err := edb.GetGovernanceProposals(scAddress, status, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get governance proposals", err.Error()))
+		return
+	}
+
+	common.Respond(w, r, proposals, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/governance-proposal governance-proposal
+// Gets the governance proposal with the votes
+//
+// parameters:
+//
+//	+name: proposal_id
+//	 description: proposal id
+//	 required: true
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: GovernanceProposal
+//	400:
+//	500:
+func (srh *StorageRestHandler) getGovernanceProposal(w http.ResponseWriter, r *http.Request) {
+	proposalID := r.URL.Query().Get("proposal_id")
+	if proposalID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing proposal_id"))
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	proposal, err := edb.GetGovernanceProposal(proposalID)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrBadRequest("can't get governance proposal", err.Error()))
+		return
+	}
+
+	common.Respond(w, r, proposal, nil)
+}
+
+// allocationAuditEntry is a challenge of the allocation, the audit is
+// missing for the open and expired challenges
+// swagger:model allocationAuditEntry
+type allocationAuditEntry struct {
+	event.Challenge
+	Audit *event.ChallengeAudit `json:"audit,omitempty"`
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/allocation-audit allocation-audit
+// Gets challenge history of an allocation. Responded challenges have the
+// validation tickets with the signed hashes, the round and the block hash of
+// the response, to verify the signatures against the validators public keys.
+//
+// parameters:
+//
+//	+name: allocation_id
+//	 description: allocation id
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []allocationAuditEntry
+//	400:
+//	500:
+func (srh *StorageRestHandler) getAllocationAudit(w http.ResponseWriter, r *http.Request) {
+	allocationID := r.URL.Query().Get("allocation_id")
+	if allocationID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing allocation_id"))
+		return
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	challenges, err := edb.GetAllocationChallenges(allocationID, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get challenges", err.Error()))
+		return
+	}
+	ids := make([]string, 0, len(challenges))
+	for _, ch := range challenges {
+		ids = append(ids, ch.ChallengeID)
+	}
+	audits, err := edb.GetChallengeAudits(ids)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get challenge audits", err.Error()))
+		return
+	}
+	auditsMap := make(map[string]*event.ChallengeAudit, len(audits))
+	for i := range audits {
+		auditsMap[audits[i].ChallengeID] = &audits[i]
+	}
+
+	entries := make([]allocationAuditEntry, 0, len(challenges))
+	for _, ch := range challenges {
+		entries = append(entries, allocationAuditEntry{
+			Challenge: ch,
+			Audit:     auditsMap[ch.ChallengeID],
+		})
+	}
+	common.Respond(w, r, entries, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/errors errors
+// Gets errors returned by indicated transaction
+//
+// parameters:
+//
+//	+name: transaction_hash
+//	 description: transaction_hash
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []Error
+//	400:
+//	500:
+func (srh *StorageRestHandler) getErrors(w http.ResponseWriter, r *http.Request) {
+
+	var (
+		transactionHash = r.URL.Query().Get("transaction_hash")
+	)
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	if len(transactionHash) == 0 {
+		common.Respond(w, r, nil, common.NewErrBadRequest("transaction_hash is empty"))
+		return
+	}
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	rtv, err := edb.GetErrorByTransactionHash(transactionHash, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+		return
+	}
+	common.Respond(w, r, rtv, nil)
+}
+
+type WriteMarkerResponse struct {
+	ID            uint
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	ClientID      string `json:"client_id"`
+	BlobberID     string `json:"blobber_id"`
+	AllocationID  string `json:"allocation_id"` //used in alloc_write_marker_count, alloc_written_size
+	TransactionID string `json:"transaction_id"`
+
+	AllocationRoot         string `json:"allocation_root"`
+	PreviousAllocationRoot string `json:"previous_allocation_root"`
+	Size                   int64  `json:"size"`
+	Timestamp              int64  `json:"timestamp"`
+	Signature              string `json:"signature"`
+	BlockNumber            int64  `json:"block_number"` //used in alloc_written_size
+
+	// TODO: Decide which pieces of information are important to the response
+	// User       User       `model:"foreignKey:ClientID;references:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Allocation Allocation `model:"references:AllocationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func toWriteMarkerResponse(wm event.WriteMarker) WriteMarkerResponse {
+	return WriteMarkerResponse{
+		ID:                     wm.ID,
+		CreatedAt:              wm.CreatedAt,
+		UpdatedAt:              wm.UpdatedAt,
+		Timestamp:              wm.Timestamp,
+		ClientID:               wm.ClientID,
+		BlobberID:              wm.BlobberID,
+		AllocationID:           wm.AllocationID,
+		TransactionID:          wm.TransactionID,
+		AllocationRoot:         wm.AllocationRoot,
+		PreviousAllocationRoot: wm.PreviousAllocationRoot,
+		Size:                   wm.Size,
+		Signature:              wm.Signature,
+		BlockNumber:            wm.BlockNumber,
+
+		// TODO: Add sub-fields or relationships as needed
+	}
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/writemarkers writemarkers
+// Gets list of write markers satisfying filter
+//
+// parameters:
+//
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: is_descending
+//	 description: is descending
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []WriteMarker
+//	400:
+//	500:
+func (srh *StorageRestHandler) getWriteMarker(w http.ResponseWriter, r *http.Request) {
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	rtv, err := edb.GetWriteMarkers(limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+		return
+	}
+
+	wmrs := make([]WriteMarkerResponse, 0, len(rtv))
+	for _, wm := range rtv {
+		wmrs = append(wmrs, toWriteMarkerResponse(wm))
+	}
+
+	common.Respond(w, r, wmrs, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/transactions transactions
+// Gets filtered list of transaction information. The list is filtered on the first valid input,
+// or otherwise all the endpoint returns all translations.
+//
+// Filters processed in the order: client id, to client id, block hash and start, end blocks.
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: restrict to transactions sent by the specified client
+//	 in: query
+//	 type: string
+//	+name: to_client_id
+//	 description: restrict to transactions sent to a specified client
+//	 in: query
+//	 type: string
+//	+name: block_hash
+//	 description: restrict to transactions in indicated block
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//	+name: start
+//	 description: restrict to transactions in specified start block and endblock
+//	 in: query
+//	 type: string
+//	+name: end
+//	 description: restrict to transactions in specified start block and endblock
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []Transaction
+//	400:
+//	500:
+func (srh *StorageRestHandler) getTransactionByFilter(w http.ResponseWriter, r *http.Request) {
+	var (
+		clientID   = r.URL.Query().Get("client_id")
+		toClientID = r.URL.Query().Get("to_client_id")
+		blockHash  = r.URL.Query().Get("block_hash")
+	)
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	if clientID != "" {
+		rtv, err := edb.GetTransactionByClientId(clientID, limit)
+		if err != nil {
+			common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+			return
+		}
+		common.Respond(w, r, rtv, nil)
+		return
+	}
+
+	if toClientID != "" {
+		rtv, err := edb.GetTransactionByToClientId(toClientID, limit)
+		if err != nil {
+			common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+			return
+		}
+		common.Respond(w, r, rtv, nil)
+		return
+	}
+
+	if blockHash != "" {
+		rtv, err := edb.GetTransactionByBlockHash(blockHash, limit)
+		if err != nil {
+			common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+			return
+		}
+		common.Respond(w, r, rtv, nil)
+		return
+	}
+
+	start, end, err := common2.GetStartEndBlock(r.URL.Query())
+	if err != nil || end == 0 {
 		rtv, err := edb.GetTransactions(limit)
 		if err != nil {
 			common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
@@ -2364,6 +3060,92 @@ func (srh *StorageRestHandler) getTransactionByFilter(w http.ResponseWriter, r *
 	common.Respond(w, r, rtv, nil)
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/transactions-export transactions-export
+// Exports transaction history of a client. The history contains transactions sent by or to the client
+// and transactions moving tokens of the client, each transaction is followed by the transfers and the mints
+// it made. Transactions are ordered by round and index in the block.
+//
+// The history is paginated by a cursor, the cursor of the next page is returned in the X-Next-Cursor header.
+// The header is missing for the last page.
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client to export the history of
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: function_name
+//	 description: restrict to calls of the smart contract function
+//	 in: query
+//	 type: string
+//	+name: start
+//	 description: first round of the history
+//	 in: query
+//	 type: string
+//	+name: end
+//	 description: round after the last round of the history
+//	 in: query
+//	 type: string
+//	+name: cursor
+//	 description: round:index of the last transaction of the previous page
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: number of transactions in the page, 100 by default, 1000 at most
+//	 in: query
+//	 type: string
+//	+name: format
+//	 description: jsonl (default) or csv
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200:
+//	400:
+//	500:
+func (srh *StorageRestHandler) getTransactionHistory(w http.ResponseWriter, r *http.Request) {
+	filter, format, err := parseHistoryFilter(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	edb := srh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	txns, transfers, err := edb.GetTransactionHistory(filter)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+		return
+	}
+
+	if len(txns) == filter.Limit {
+		last := txns[len(txns)-1]
+		w.Header().Set(historyNextCursorHeader, formatHistoryCursor(event.TransactionCursor{
+			Round: last.Round,
+			Index: last.Index,
+		}))
+	}
+
+	entries := historyEntries(txns, transfers)
+	if format == historyFormatCSV {
+		w.Header().Set("Content-Type", "text/csv")
+		err = writeHistoryCSV(w, entries)
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		err = writeHistoryJSONL(w, entries)
+	}
+	if err != nil {
+		logging.Logger.Error("writing transaction history",
+			zap.String("client_id", filter.ClientID),
+			zap.Error(err))
+	}
+}
+
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/transaction transaction
 // Gets transaction information from transaction hash
 //
@@ -2417,6 +3199,8 @@ type storageNodeResponse struct {
 	StakePoolSettings       stakepool.Settings     `json:"stake_pool_settings"`
 	RewardRound             RewardRound            `json:"reward_round"`
 	NotAvailable            bool                   `json:"not_available"`
+	Reputation              float64                `json:"reputation"`
+	ReputationStats         BlobberReputation      `json:"reputation_stats"`
 
 	TotalStake               currency.Coin `json:"total_stake"`
 	CreationRound            int64         `json:"creation_round"`
@@ -2446,6 +3230,8 @@ func StoragNodeToStorageNodeResponse(sn StorageNode) storageNodeResponse {
 		IsKilled:                sn.IsKilled(),
 		IsShutdown:              sn.IsShutDown(),
 		NotAvailable:            sn.NotAvailable,
+		Reputation:              sn.ReputationScore(),
+		ReputationStats:         sn.Reputation,
 	}
 }
 
@@ -2470,6 +3256,7 @@ func StoragNodeResponseToStorageNode(snr storageNodeResponse) StorageNode {
 		StakePoolSettings:       snr.StakePoolSettings,
 		RewardRound:             snr.RewardRound,
 		NotAvailable:            snr.NotAvailable,
+		Reputation:              snr.ReputationStats,
 	}
 }
 
@@ -2504,6 +3291,7 @@ func blobberTableToStorageNode(blobber event.Blobber) storageNodeResponse {
 		IsShutdown:               blobber.IsShutdown,
 		SavedData:                blobber.SavedData,
 		NotAvailable:             blobber.NotAvailable,
+		Reputation:               blobber.Reputation,
 		CreatedAt:                blobber.CreatedAt,
 	}
 }
@@ -2525,10 +3313,15 @@ func blobberTableToStorageNode(blobber event.Blobber) storageNodeResponse {
 //	 description: desc or asc
 //	 in: query
 //	 type: string
+//	+name: order_by
+//	 description: capacity (default) or reputation
+//	 in: query
+//	 type: string
 //
 // responses:
 //
 //	200: storageNodesResponse
+//	400:
 //	500:
 func (srh *StorageRestHandler) getBlobbers(w http.ResponseWriter, r *http.Request) {
 	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
@@ -2540,6 +3333,14 @@ func (srh *StorageRestHandler) getBlobbers(w http.ResponseWriter, r *http.Reques
 	values := r.URL.Query()
 	active := values.Get("active")
 	idsStr := values.Get("blobber_ids")
+	orderBy := values.Get("order_by")
+	if orderBy == "" {
+		orderBy = event.BlobberOrderByCapacity
+	}
+	if !event.IsValidBlobberOrderBy(orderBy) {
+		common.Respond(w, r, nil, common.NewErrBadRequest("invalid order_by: "+orderBy))
+		return
+	}
 	edb := srh.GetQueryStateContext().GetEventDB()
 	if edb == nil {
 		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
@@ -2559,7 +3360,7 @@ func (srh *StorageRestHandler) getBlobbers(w http.ResponseWriter, r *http.Reques
 			healthCheckPeriod = conf.HealthCheckPeriod
 		}
 
-		blobbers, err = edb.GetActiveBlobbers(limit, healthCheckPeriod)
+		blobbers, err = edb.GetActiveBlobbers(limit, healthCheckPeriod, orderBy)
 	} else if idsStr != "" {
 		var blobber_ids []string
 		err = json.Unmarshal([]byte(idsStr), &blobber_ids)
@@ -2580,7 +3381,7 @@ func (srh *StorageRestHandler) getBlobbers(w http.ResponseWriter, r *http.Reques
 
 		blobbers, err = edb.GetBlobbersFromIDs(blobber_ids)
 	} else {
-		blobbers, err = edb.GetBlobbers(limit)
+		blobbers, err = edb.GetBlobbers(limit, orderBy)
 	}
 
 	if err != nil {
@@ -2602,7 +3403,7 @@ func (srh *StorageRestHandler) getBlobbers(w http.ResponseWriter, r *http.Reques
 }
 
 // getBlobbers swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/blobbers-by-rank blobbers-by-rank
-// Gets list of all blobbers ordered by rank
+// Gets list of all blobbers ordered by reputation
 // TODO: See if we need to remove since no longer used
 // parameters:
 //
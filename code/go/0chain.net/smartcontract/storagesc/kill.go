@@ -61,6 +61,7 @@ func (_ *StorageSmartContract) killBlobber(
 	if err != nil {
 		return "", common.NewError("kill_blobber_failed", "saving blobber: "+err.Error())
 	}
+	emitUpdateBlobberReputation(blobber, balances)
 	return "", nil
 }
 
@@ -348,6 +348,8 @@ type StorageNode struct {
 	StakePoolSettings stakepool.Settings `json:"stake_pool_settings"`
 	RewardRound       RewardRound        `json:"reward_round"`
 	NotAvailable      bool               `json:"not_available"`
+	// Reputation is the track record blobber selection can be constrained by.
+	Reputation BlobberReputation `json:"reputation"`
 }
 
 func GetUrlKey(baseUrl, globalKey string) datastore.Key {
@@ -543,7 +545,7 @@ func newBlobberAllocation(
 	ba := &BlobberAllocation{}
 	ba.Stats = &StorageAllocationStats{}
 	ba.Size = size
-	ba.Terms = blobber.Terms
+	ba.Terms = allocation.assuranceTerms(blobber.Terms)
 	ba.AllocationID = allocation.ID
 	ba.BlobberID = blobber.ID
 
@@ -552,7 +554,7 @@ func newBlobberAllocation(
 		return nil, fmt.Errorf("new blobber allocation failed: %v", err)
 	}
 
-	ba.MinLockDemand, err = blobber.Terms.minLockDemand(sizeInGB(size), rdtu, allocation.MinLockDemand)
+	ba.MinLockDemand, err = ba.Terms.minLockDemand(sizeInGB(size), rdtu, allocation.MinLockDemand)
 	return ba, err
 }
 
@@ -844,6 +846,29 @@ type StorageAllocation struct {
 	// 00100000 - 32 - rename
 	FileOptions uint16 `json:"file_options"`
 
+	// ACL grants file operations to particular third-party clients,
+	// in addition to the operations allowed for everyone by FileOptions.
+	ACL []*ACLEntry `json:"acl,omitempty"`
+
+	// AutoRenew extends the allocation from its write pool before it expires.
+	AutoRenew *AutoRenewPolicy `json:"auto_renew,omitempty"`
+
+	// OwnershipOffer is a pending transfer of the allocation to another
+	// client, it's completed when the client accepts it.
+	OwnershipOffer *OwnershipOffer `json:"ownership_offer,omitempty"`
+
+	// MinReputation the blobbers added to the allocation must have.
+	MinReputation float64 `json:"min_reputation,omitempty"`
+
+	// GeoConstraints on the locations of the allocation blobbers.
+	GeoConstraints *GeoConstraints `json:"geo_constraints,omitempty"`
+
+	// AssuranceTier weights how often the allocation blobbers are
+	// challenged. The write prices of the blobbers are increased by the
+	// AssurancePrice of the tier, it's locked when the tier is set.
+	AssuranceTier  int     `json:"assurance_tier"`
+	AssurancePrice float64 `json:"assurance_price"`
+
 	WritePool currency.Coin `json:"write_pool"`
 
 	// Requested ranges.
@@ -1248,6 +1273,11 @@ func (sa *StorageAllocation) isActive(
 		return fmt.Errorf("blobber %s is not currently available for new allocations", blobber.ID)
 	}
 
+	if score := blobber.ReputationScore(); score < sa.MinReputation {
+		return fmt.Errorf("blobber %s reputation %v is below the required %v",
+			blobber.ID, score, sa.MinReputation)
+	}
+
 	// filter by read price
 	if !sa.ReadPriceRange.isMatch(blobber.Terms.ReadPrice) {
 		return fmt.Errorf("read price range %v does not match blobber %s read price %v",
@@ -1471,6 +1501,12 @@ func (sa *StorageAllocation) changeBlobbers(
 		sa.BlobberAllocs = append(sa.BlobberAllocs, ba)
 	}
 
+	if sa.GeoConstraints != nil {
+		if err := sa.GeoConstraints.check(blobbers); err != nil {
+			return nil, fmt.Errorf("can't add blobber %s: %v", addId, err)
+		}
+	}
+
 	sa.BlobberAllocsMap[addId] = ba
 
 	if err := sp.addOffer(ba.Offer()); err != nil {
@@ -1536,6 +1572,10 @@ List:
 		if b.Capacity-b.Allocated < bsize {
 			continue
 		}
+		// filter by reputation, the same way isActive does
+		if b.ReputationScore() < sa.MinReputation {
+			continue
+		}
 
 		for _, filter := range filters {
 			kick, err := filter(b)
@@ -1967,6 +2007,19 @@ func (rm *ReadMarker) GetHashData() string {
 }
 
 func (rm *ReadMarker) Verify(prevRM *ReadMarker, balances cstate.StateContextI) error {
+	if err := rm.validate(prevRM); err != nil {
+		return err
+	}
+
+	if ok := rm.VerifySignature(rm.ClientPublicKey, balances); !ok {
+		return common.NewError("invalid_read_marker", "Signature verification failed for the read marker")
+	}
+
+	return nil
+}
+
+// validate the read marker fields, not the signature
+func (rm *ReadMarker) validate(prevRM *ReadMarker) error {
 	if rm.ReadCounter <= 0 || rm.BlobberID == "" || rm.ClientID == "" || rm.Timestamp == 0 {
 		return common.NewError("invalid_read_marker", "length validations of fields failed")
 	}
@@ -1979,11 +2032,6 @@ func (rm *ReadMarker) Verify(prevRM *ReadMarker, balances cstate.StateContextI)
 				"validations with previous marker failed.")
 		}
 	}
-
-	if ok := rm.VerifySignature(rm.ClientPublicKey, balances); !ok {
-		return common.NewError("invalid_read_marker", "Signature verification failed for the read marker")
-	}
-
 	return nil
 }
 
@@ -2014,10 +2062,15 @@ type ValidationTicket struct {
 	Signature    string           `json:"signature"`
 }
 
-func (vt *ValidationTicket) VerifySign(balances cstate.StateContextI) (bool, error) {
+// hash is the message signed by the validator
+func (vt *ValidationTicket) hash() string {
 	hashData := fmt.Sprintf("%v:%v:%v:%v:%v:%v", vt.ChallengeID, vt.BlobberID,
 		vt.ValidatorID, vt.ValidatorKey, vt.Result, vt.Timestamp)
-	hash := encryption.Hash(hashData)
+	return encryption.Hash(hashData)
+}
+
+func (vt *ValidationTicket) VerifySign(balances cstate.StateContextI) (bool, error) {
+	hash := vt.hash()
 	signatureScheme := balances.GetSignatureScheme()
 	if err := signatureScheme.SetPublicKey(vt.ValidatorKey); err != nil {
 		return false, err
@@ -1141,9 +1141,9 @@ func (z *RewardRound) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *StorageAllocationDecode) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 25
+	// map header, size 32
 	// string "ID"
-	o = append(o, 0xde, 0x0, 0x19, 0xa2, 0x49, 0x44)
+	o = append(o, 0xde, 0x0, 0x20, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "Tx"
 	o = append(o, 0xa2, 0x54, 0x78)
@@ -1210,6 +1210,62 @@ func (z *StorageAllocationDecode) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "FileOptions"
 	o = append(o, 0xab, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73)
 	o = msgp.AppendUint16(o, z.FileOptions)
+	// string "ACL"
+	o = append(o, 0xa3, 0x41, 0x43, 0x4c)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.ACL)))
+	for za0003 := range z.ACL {
+		if z.ACL[za0003] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.ACL[za0003].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "ACL", za0003)
+				return
+			}
+		}
+	}
+	// string "AutoRenew"
+	o = append(o, 0xa9, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77)
+	if z.AutoRenew == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.AutoRenew.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "AutoRenew")
+			return
+		}
+	}
+	// string "OwnershipOffer"
+	o = append(o, 0xae, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72)
+	if z.OwnershipOffer == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.OwnershipOffer.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "OwnershipOffer")
+			return
+		}
+	}
+	// string "MinReputation"
+	o = append(o, 0xad, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendFloat64(o, z.MinReputation)
+	// string "GeoConstraints"
+	o = append(o, 0xae, 0x47, 0x65, 0x6f, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73)
+	if z.GeoConstraints == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.GeoConstraints.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "GeoConstraints")
+			return
+		}
+	}
+	// string "AssuranceTier"
+	o = append(o, 0xad, 0x41, 0x73, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72)
+	o = msgp.AppendInt(o, z.AssuranceTier)
+	// string "AssurancePrice"
+	o = append(o, 0xae, 0x41, 0x73, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65)
+	o = msgp.AppendFloat64(o, z.AssurancePrice)
 	// string "WritePool"
 	o = append(o, 0xa9, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c)
 	o, err = z.WritePool.MarshalMsg(o)
@@ -1444,6 +1500,105 @@ func (z *StorageAllocationDecode) UnmarshalMsg(bts []byte) (o []byte, err error)
 				err = msgp.WrapError(err, "FileOptions")
 				return
 			}
+		case "ACL":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ACL")
+				return
+			}
+			if cap(z.ACL) >= int(zb0004) {
+				z.ACL = (z.ACL)[:zb0004]
+			} else {
+				z.ACL = make([]*ACLEntry, zb0004)
+			}
+			for za0003 := range z.ACL {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.ACL[za0003] = nil
+				} else {
+					if z.ACL[za0003] == nil {
+						z.ACL[za0003] = new(ACLEntry)
+					}
+					bts, err = z.ACL[za0003].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "ACL", za0003)
+						return
+					}
+				}
+			}
+		case "AutoRenew":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.AutoRenew = nil
+			} else {
+				if z.AutoRenew == nil {
+					z.AutoRenew = new(AutoRenewPolicy)
+				}
+				bts, err = z.AutoRenew.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AutoRenew")
+					return
+				}
+			}
+		case "OwnershipOffer":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.OwnershipOffer = nil
+			} else {
+				if z.OwnershipOffer == nil {
+					z.OwnershipOffer = new(OwnershipOffer)
+				}
+				bts, err = z.OwnershipOffer.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "OwnershipOffer")
+					return
+				}
+			}
+		case "MinReputation":
+			z.MinReputation, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinReputation")
+				return
+			}
+		case "GeoConstraints":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.GeoConstraints = nil
+			} else {
+				if z.GeoConstraints == nil {
+					z.GeoConstraints = new(GeoConstraints)
+				}
+				bts, err = z.GeoConstraints.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "GeoConstraints")
+					return
+				}
+			}
+		case "AssuranceTier":
+			z.AssuranceTier, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AssuranceTier")
+				return
+			}
+		case "AssurancePrice":
+			z.AssurancePrice, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AssurancePrice")
+				return
+			}
 		case "WritePool":
 			bts, err = z.WritePool.UnmarshalMsg(bts)
 			if err != nil {
@@ -1451,14 +1606,14 @@ func (z *StorageAllocationDecode) UnmarshalMsg(bts []byte) (o []byte, err error)
 				return
 			}
 		case "ReadPriceRange":
-			var zb0004 uint32
-			zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "ReadPriceRange")
 				return
 			}
-			for zb0004 > 0 {
-				zb0004--
+			for zb0005 > 0 {
+				zb0005--
 				field, bts, err = msgp.ReadMapKeyZC(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ReadPriceRange")
@@ -1486,14 +1641,14 @@ func (z *StorageAllocationDecode) UnmarshalMsg(bts []byte) (o []byte, err error)
 				}
 			}
 		case "WritePriceRange":
-			var zb0005 uint32
-			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+			var zb0006 uint32
+			zb0006, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "WritePriceRange")
 				return
 			}
-			for zb0005 > 0 {
-				zb0005--
+			for zb0006 > 0 {
+				zb0006--
 				field, bts, err = msgp.ReadMapKeyZC(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "WritePriceRange")
@@ -1600,7 +1755,33 @@ func (z *StorageAllocationDecode) Msgsize() (s int) {
 			s += z.BlobberAllocs[za0002].Msgsize()
 		}
 	}
-	s += 21 + msgp.BoolSize + 12 + msgp.Uint16Size + 10 + z.WritePool.Msgsize() + 15 + 1 + 4 + z.ReadPriceRange.Min.Msgsize() + 4 + z.ReadPriceRange.Max.Msgsize() + 16 + 1 + 4 + z.WritePriceRange.Min.Msgsize() + 4 + z.WritePriceRange.Max.Msgsize() + 10 + z.StartTime.Msgsize() + 10 + msgp.BoolSize + 9 + msgp.BoolSize + 14 + msgp.Float64Size + 17 + z.MovedToChallenge.Msgsize() + 10 + z.MovedBack.Msgsize() + 18 + z.MovedToValidators.Msgsize() + 9 + msgp.DurationSize
+	s += 21 + msgp.BoolSize + 12 + msgp.Uint16Size + 4 + msgp.ArrayHeaderSize
+	for za0003 := range z.ACL {
+		if z.ACL[za0003] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.ACL[za0003].Msgsize()
+		}
+	}
+	s += 10
+	if z.AutoRenew == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.AutoRenew.Msgsize()
+	}
+	s += 15
+	if z.OwnershipOffer == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.OwnershipOffer.Msgsize()
+	}
+	s += 14 + msgp.Float64Size + 15
+	if z.GeoConstraints == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.GeoConstraints.Msgsize()
+	}
+	s += 14 + msgp.IntSize + 15 + msgp.Float64Size + 10 + z.WritePool.Msgsize() + 15 + 1 + 4 + z.ReadPriceRange.Min.Msgsize() + 4 + z.ReadPriceRange.Max.Msgsize() + 16 + 1 + 4 + z.WritePriceRange.Min.Msgsize() + 4 + z.WritePriceRange.Max.Msgsize() + 10 + z.StartTime.Msgsize() + 10 + msgp.BoolSize + 9 + msgp.BoolSize + 14 + msgp.Float64Size + 17 + z.MovedToChallenge.Msgsize() + 10 + z.MovedBack.Msgsize() + 18 + z.MovedToValidators.Msgsize() + 9 + msgp.DurationSize
 	return
 }
 
@@ -1861,9 +2042,9 @@ func (z *StorageChallenge) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *StorageNode) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 13
+	// map header, size 14
 	// string "Provider"
-	o = append(o, 0x8d, 0xa8, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72)
+	o = append(o, 0x8e, 0xa8, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72)
 	o, err = z.Provider.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "Provider")
@@ -1939,6 +2120,13 @@ func (z *StorageNode) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "NotAvailable"
 	o = append(o, 0xac, 0x4e, 0x6f, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65)
 	o = msgp.AppendBool(o, z.NotAvailable)
+	// string "Reputation"
+	o = append(o, 0xaa, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e)
+	o, err = z.Reputation.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Reputation")
+		return
+	}
 	return
 }
 
@@ -2125,6 +2313,12 @@ func (z *StorageNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "NotAvailable")
 				return
 			}
+		case "Reputation":
+			bts, err = z.Reputation.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Reputation")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -2139,7 +2333,7 @@ func (z *StorageNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *StorageNode) Msgsize() (s int) {
-	s = 1 + 9 + z.Provider.Msgsize() + 8 + msgp.StringPrefixSize + len(z.BaseURL) + 12 + 1 + 9 + msgp.Float64Size + 10 + msgp.Float64Size + 6 + 1 + 10 + z.Terms.ReadPrice.Msgsize() + 11 + z.Terms.WritePrice.Msgsize() + 9 + msgp.Int64Size + 10 + msgp.Int64Size + 10 + msgp.StringPrefixSize + len(z.PublicKey) + 10 + msgp.Int64Size + 24 + msgp.Float64Size + 24 + msgp.Int64Size + 18 + z.StakePoolSettings.Msgsize() + 12 + 1 + 11 + msgp.Int64Size + 10 + z.RewardRound.Timestamp.Msgsize() + 13 + msgp.BoolSize
+	s = 1 + 9 + z.Provider.Msgsize() + 8 + msgp.StringPrefixSize + len(z.BaseURL) + 12 + 1 + 9 + msgp.Float64Size + 10 + msgp.Float64Size + 6 + 1 + 10 + z.Terms.ReadPrice.Msgsize() + 11 + z.Terms.WritePrice.Msgsize() + 9 + msgp.Int64Size + 10 + msgp.Int64Size + 10 + msgp.StringPrefixSize + len(z.PublicKey) + 10 + msgp.Int64Size + 24 + msgp.Float64Size + 24 + msgp.Int64Size + 18 + z.StakePoolSettings.Msgsize() + 12 + 1 + 11 + msgp.Int64Size + 10 + z.RewardRound.Timestamp.Msgsize() + 13 + msgp.BoolSize + 11 + z.Reputation.Msgsize()
 	return
 }
 
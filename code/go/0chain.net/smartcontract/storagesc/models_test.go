@@ -105,6 +105,14 @@ func TestStorageAllocation_filterBlobbers(t *testing.T) {
 	list[1].Capacity, list[1].Allocated = 330, 100
 	bs, err = alloc.filterBlobbers(list, now, size)
 	assert.Len(t, bs, 2)
+
+	// filter one by reputation
+	alloc.MinReputation = 0.6
+	list[1].Reputation = BlobberReputation{ChallengesPassed: 7, ChallengesFailed: 1}
+	bs, err = alloc.filterBlobbers(list, now, size)
+	require.NoError(t, err)
+	require.Len(t, bs, 1)
+	require.Equal(t, 0.8, bs[0].ReputationScore())
 }
 
 func TestVerifyClientID(t *testing.T) {
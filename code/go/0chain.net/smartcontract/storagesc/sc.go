@@ -61,6 +61,7 @@ func (ssc *StorageSmartContract) setSC(sc *sci.SmartContract, _ sci.BCContextI)
 	ssc.SmartContractExecutionStats["update_settings"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "update_settings"), nil)
 	// reading / writing
 	ssc.SmartContractExecutionStats["read_redeem"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "read_redeem"), nil)
+	ssc.SmartContractExecutionStats["read_redeem_batch"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "read_redeem_batch"), nil)
 	ssc.SmartContractExecutionStats["commit_connection"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "commit_connection"), nil)
 	// allocation
 	ssc.SmartContractExecutionStats["new_allocation_request"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "new_allocation_request"), nil)
@@ -69,6 +70,20 @@ func (ssc *StorageSmartContract) setSC(sc *sci.SmartContract, _ sci.BCContextI)
 	ssc.SmartContractExecutionStats["cancel_allocation"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "cancel_allocation"), nil)
 	ssc.SmartContractExecutionStats["free_allocation_request"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "free_allocation_request"), nil)
 	ssc.SmartContractExecutionStats["free_update_allocation"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "update_free_storage"), nil)
+	ssc.SmartContractExecutionStats["add_allocation_acl"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "add_allocation_acl"), nil)
+	ssc.SmartContractExecutionStats["remove_allocation_acl"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "remove_allocation_acl"), nil)
+	ssc.SmartContractExecutionStats["expire_allocation_acl"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "expire_allocation_acl"), nil)
+	ssc.SmartContractExecutionStats["allocation_auto_renew"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "allocation_auto_renew"), nil)
+	ssc.SmartContractExecutionStats["offer_allocation_ownership"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "offer_allocation_ownership"), nil)
+	ssc.SmartContractExecutionStats["accept_allocation_ownership"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "accept_allocation_ownership"), nil)
+	ssc.SmartContractExecutionStats["reserve_capacity"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "reserve_capacity"), nil)
+	ssc.SmartContractExecutionStats["forfeit_capacity_reservation"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "forfeit_capacity_reservation"), nil)
+	ssc.SmartContractExecutionStats["complete_blobber_migration"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "complete_blobber_migration"), nil)
+	ssc.SmartContractExecutionStats["expire_blobber_migration"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "expire_blobber_migration"), nil)
+	ssc.SmartContractExecutionStats["migrate_blobber_allocations"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "migrate_blobber_allocations"), nil)
+	ssc.SmartContractExecutionStats["governance_propose"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "governance_propose"), nil)
+	ssc.SmartContractExecutionStats["governance_vote"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "governance_vote"), nil)
+	ssc.SmartContractExecutionStats["governance_execute"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "governance_execute"), nil)
 	// challenge
 	ssc.SmartContractExecutionStats["challenge_response"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "challenge_response"), nil)
 	ssc.SmartContractExecutionStats["generate_challenge"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "generate_challenge"), nil)
@@ -104,6 +119,9 @@ func (ssc *StorageSmartContract) setSC(sc *sci.SmartContract, _ sci.BCContextI)
 	// stake pool
 	ssc.SmartContractExecutionStats["stake_pool_lock"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "stake_pool_lock"), nil)
 	ssc.SmartContractExecutionStats["stake_pool_unlock"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "stake_pool_unlock"), nil)
+	ssc.SmartContractExecutionStats["stake_pool_claim_unbonded"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "stake_pool_claim_unbonded"), nil)
+	ssc.SmartContractExecutionStats["stake_pool_redelegate"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "stake_pool_redelegate"), nil)
+	ssc.SmartContractExecutionStats["stake_pool_auto_compound"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "stake_pool_auto_compound"), nil)
 	ssc.SmartContractExecutionStats["pay_reward"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", ssc.ID, "pay_reward (add/update/remove SC function)"), nil)
 
 }
@@ -173,6 +191,11 @@ func (sc *StorageSmartContract) Execute(t *transaction.Transaction,
 			return
 		}
 
+	case "read_redeem_batch":
+		if resp, err = sc.commitBlobberReadBatch(t, input, balances); err != nil {
+			return
+		}
+
 	case "commit_connection":
 		resp, err = sc.commitBlobberConnection(t, input, balances)
 		if err != nil {
@@ -190,6 +213,52 @@ func (sc *StorageSmartContract) Execute(t *transaction.Transaction,
 	case "cancel_allocation":
 		resp, err = sc.cancelAllocationRequest(t, input, balances)
 
+	// allocation access control list
+
+	case "add_allocation_acl":
+		resp, err = sc.addAllocationACL(t, input, balances)
+	case "remove_allocation_acl":
+		resp, err = sc.removeAllocationACL(t, input, balances)
+	case "expire_allocation_acl":
+		resp, err = sc.expireAllocationACL(t, input, balances)
+
+	// allocation auto-renewal
+
+	case "allocation_auto_renew":
+		resp, err = sc.setAllocationAutoRenew(t, input, balances)
+
+	// allocation ownership transfer
+
+	case "offer_allocation_ownership":
+		resp, err = sc.offerAllocationOwnership(t, input, balances)
+	case "accept_allocation_ownership":
+		resp, err = sc.acceptAllocationOwnership(t, input, balances)
+
+	// capacity reservations
+
+	case "reserve_capacity":
+		resp, err = sc.reserveCapacity(t, input, balances)
+	case "forfeit_capacity_reservation":
+		resp, err = sc.forfeitCapacityReservation(t, input, balances)
+
+	// blobber migrations
+
+	case "complete_blobber_migration":
+		resp, err = sc.completeBlobberMigration(t, input, balances)
+	case "expire_blobber_migration":
+		resp, err = sc.expireBlobberMigration(t, input, balances)
+	case "migrate_blobber_allocations":
+		resp, err = sc.migrateBlobberAllocationsNext(t, input, balances)
+
+	// governance
+
+	case "governance_propose":
+		resp, err = sc.governancePropose(t, input, balances)
+	case "governance_vote":
+		resp, err = sc.governanceVote(t, input, balances)
+	case "governance_execute":
+		resp, err = sc.governanceExecute(t, input, balances)
+
 	// free allocations
 
 	case "add_free_storage_assigner":
@@ -214,7 +283,9 @@ func (sc *StorageSmartContract) Execute(t *transaction.Transaction,
 	case "update_validator_settings":
 		resp, err = sc.updateValidatorSettings(t, input, balances)
 	case "blobber_block_rewards":
-		err = sc.blobberBlockRewards(t, input, balances)
+		if err = sc.blobberBlockRewards(t, input, balances); err == nil {
+			err = sc.renewAllocations(t, balances)
+		}
 
 	case "shutdown_blobber":
 		_, err = sc.shutdownBlobber(t, input, balances)
@@ -245,6 +316,12 @@ func (sc *StorageSmartContract) Execute(t *transaction.Transaction,
 		resp, err = sc.stakePoolLock(t, input, balances)
 	case "stake_pool_unlock":
 		resp, err = sc.stakePoolUnlock(t, input, balances)
+	case "stake_pool_claim_unbonded":
+		resp, err = sc.stakePoolClaimUnbonded(t, input, balances)
+	case "stake_pool_redelegate":
+		resp, err = sc.stakePoolRedelegate(t, input, balances)
+	case "stake_pool_auto_compound":
+		resp, err = sc.stakePoolAutoCompound(t, input, balances)
 	case "collect_reward":
 		resp, err = sc.collectReward(t, input, balances)
 	case "generate_challenge":
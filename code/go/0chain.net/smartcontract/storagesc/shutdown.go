@@ -1,6 +1,8 @@
 package storagesc
 
 import (
+	"math"
+
 	"0chain.net/smartcontract/provider"
 	"0chain.net/smartcontract/stakepool"
 
@@ -11,8 +13,9 @@ import (
 
 // shutdownBlobber
 // shuts down the blobber: It is no longer available for new allocations
-// but its existing commitments will still be upheld.
-func (_ *StorageSmartContract) shutdownBlobber(
+// but its existing commitments will still be upheld until the allocations
+// data is migrated to the replacement blobbers.
+func (sc *StorageSmartContract) shutdownBlobber(
 	tx *transaction.Transaction,
 	input []byte,
 	balances cstate.StateContextI,
@@ -48,6 +51,19 @@ func (_ *StorageSmartContract) shutdownBlobber(
 	if err != nil {
 		return "", common.NewError("shutdown_blobber_failed", "saving blobber: "+err.Error())
 	}
+	// the first partition of the allocations is migrated on the shutdown,
+	// the rest by migrate_blobber_allocations transactions
+	cursor := &blobberMigrationCursor{BlobberID: blobber.ID, Partition: math.MaxInt32}
+	if err := sc.migrateBlobberAllocations(tx, blobber, cursor, balances); err != nil {
+		return "", common.NewError("shutdown_blobber_failed", "migrating allocations: "+err.Error())
+	}
+	if !cursor.done() {
+		if err := cursor.save(sc.ID, balances); err != nil {
+			return "", common.NewError("shutdown_blobber_failed",
+				"saving migration cursor: "+err.Error())
+		}
+	}
+	emitUpdateBlobberReputation(blobber, balances)
 	return "", nil
 }
 
@@ -96,10 +96,14 @@ func (sp *stakePool) Save(providerType spenum.Provider, providerID string,
 	return nil
 }
 
-// The stake() returns total stake size including delegate pools want to unstake.
+// The stake() returns total stake size excluding the unbonding delegate
+// pools, they don't back the capacity of the blobber.
 func (sp *stakePool) stake() (stake currency.Coin, err error) {
 	var newStake currency.Coin
 	for _, dp := range sp.GetOrderedPools() {
+		if dp.Status == spenum.Unbonding {
+			continue
+		}
 		newStake, err = currency.AddCoin(stake, dp.Balance)
 		if err != nil {
 			return
@@ -125,6 +129,60 @@ func (sp *stakePool) Empty(
 		return errors.New("trying to unlock not by delegate pool owner")
 	}
 
+	// the unbonding pool is checked when it starts unbonding
+	if dp.Status != spenum.Unbonding {
+		if err := sp.coversOffers(dp); err != nil {
+			return err
+		}
+	}
+
+	transfer := state.NewTransfer(sscID, clientID, dp.Balance)
+	if err := balances.AddTransfer(transfer); err != nil {
+		return err
+	}
+
+	sp.Pools[poolID].Balance = 0
+	sp.Pools[poolID].Status = spenum.Deleted
+
+	return nil
+}
+
+// UnbondPool starts unbonding of a delegate pool if the rest of the stake
+// still covers the offers
+func (sp *stakePool) UnbondPool(
+	clientID string,
+	providerType spenum.Provider,
+	providerId datastore.Key,
+	releaseRound int64,
+	balances chainstate.StateContextI,
+) (string, error) {
+	if dp, ok := sp.Pools[clientID]; ok && dp.Status != spenum.Unbonding {
+		if err := sp.coversOffers(dp); err != nil {
+			return "", err
+		}
+	}
+	return sp.StakePool.UnbondPool(clientID, providerType, providerId, releaseRound, balances)
+}
+
+// UnlockPool pays out the rewards of the delegate pool leaving the stake pool
+// if the rest of the stake still covers the offers, the unbonding pool is
+// checked when it starts unbonding
+func (sp *stakePool) UnlockPool(
+	clientID string,
+	providerType spenum.Provider,
+	providerId datastore.Key,
+	balances chainstate.StateContextI,
+) (string, error) {
+	if dp, ok := sp.Pools[clientID]; ok && dp.Status != spenum.Unbonding {
+		if err := sp.coversOffers(dp); err != nil {
+			return "", err
+		}
+	}
+	return sp.StakePool.UnlockPool(clientID, providerType, providerId, balances)
+}
+
+// coversOffers checks the stake without the delegate pool covers the offers
+func (sp *stakePool) coversOffers(dp *stakepool.DelegatePool) error {
 	requiredBalance, err := currency.AddCoin(sp.TotalOffers, dp.Balance)
 	if err != nil {
 		return err
@@ -139,15 +197,6 @@ func (sp *stakePool) Empty(
 		return fmt.Errorf("insufficent stake to cover offers: existing stake %d, unlock balance %d, offers %d",
 			staked, dp.Balance, sp.TotalOffers)
 	}
-
-	transfer := state.NewTransfer(sscID, clientID, dp.Balance)
-	if err := balances.AddTransfer(transfer); err != nil {
-		return err
-	}
-
-	sp.Pools[poolID].Balance = 0
-	sp.Pools[poolID].Status = spenum.Deleted
-
 	return nil
 }
 
@@ -180,6 +229,7 @@ func (sp *stakePool) slash(
 	offer, slash currency.Coin,
 	balances chainstate.StateContextI,
 	allocationID string,
+	penalty spenum.Reward,
 ) (move currency.Coin, err error) {
 	if offer == 0 || slash == 0 {
 		return // nothing to move
@@ -189,18 +239,28 @@ func (sp *stakePool) slash(
 		slash = offer // can't move the offer left
 	}
 
-	staked, err := sp.stake()
-	if err != nil {
-		return 0, err
+	// the unbonding pools are slashed too, until they are released
+	pools := sp.GetOrderedPools()
+	var slashable currency.Coin
+	for _, dp := range pools {
+		if slashable, err = currency.AddCoin(slashable, dp.Balance); err != nil {
+			return 0, err
+		}
+	}
+	if slashable == 0 {
+		return // nothing to slash
+	}
+	if slash > slashable {
+		slash = slashable
 	}
 
 	// offer ratio of entire stake; we are slashing only part of the offer
 	// moving the tokens to allocation user; the ratio is part of entire
 	// stake should be moved;
-	var ratio = float64(slash) / float64(staked)
-	edbSlash := stakepool.NewStakePoolReward(blobID, spenum.Blobber, spenum.ChallengeSlashPenalty)
+	var ratio = float64(slash) / float64(slashable)
+	edbSlash := stakepool.NewStakePoolReward(blobID, spenum.Blobber, penalty)
 	edbSlash.AllocationID = allocationID
-	for _, dp := range sp.GetOrderedPools() {
+	for _, dp := range pools {
 		dpSlash, err := currency.MultFloat64(dp.Balance, ratio)
 		if err != nil {
 			return 0, err
@@ -358,6 +418,18 @@ func (spr *stakePoolRequest) decode(p []byte) (err error) {
 	return // ok
 }
 
+func init() {
+	maxStake := func(balances chainstate.CommonStateContextI) (currency.Coin, error) {
+		conf, err := getConfig(balances)
+		if err != nil {
+			return 0, err
+		}
+		return conf.MaxStake, nil
+	}
+	stakepool.RegisterMaxStake(spenum.Blobber, maxStake)
+	stakepool.RegisterMaxStake(spenum.Validator, maxStake)
+}
+
 // add delegated stake pool
 func (ssc *StorageSmartContract) stakePoolLock(t *transaction.Transaction,
 	input []byte, balances chainstate.StateContextI) (resp string, err error) {
@@ -376,5 +448,45 @@ func (ssc *StorageSmartContract) stakePoolUnlock(
 	input []byte,
 	balances chainstate.StateContextI,
 ) (resp string, err error) {
-	return stakepool.StakePoolUnlock(t, input, balances, ssc.getStakePoolAdapter)
+	conf, err := getConfig(balances)
+	if err != nil {
+		return "", err
+	}
+	return stakepool.StakePoolUnlock(t, input, balances, conf.StakePool.UnbondingRounds, ssc.getStakePoolAdapter)
+}
+
+// stakePoolClaimUnbonded pays out the unbonding delegate pool after its
+// release round
+func (ssc *StorageSmartContract) stakePoolClaimUnbonded(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (resp string, err error) {
+	return stakepool.StakePoolClaimUnbonded(t, input, balances, ssc.getStakePoolAdapter)
+}
+
+// stakePoolAutoCompound turns the auto compounding of the rewards of the
+// delegate pool of the client on or off
+func (ssc *StorageSmartContract) stakePoolAutoCompound(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (resp string, err error) {
+	return stakepool.StakePoolAutoCompound(t, input, balances, ssc.getStakePoolAdapter)
+}
+
+// stakePoolRedelegate moves the delegate pool of the client to the stake
+// pool of another provider without unlocking it
+func (ssc *StorageSmartContract) stakePoolRedelegate(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (resp string, err error) {
+	conf, err := getConfig(balances)
+	if err != nil {
+		return "", err
+	}
+	return stakepool.StakePoolRedelegate(t, input, balances, conf.StakePool.UnbondingRounds,
+		stakepool.ValidationSettings{MaxStake: conf.MaxStake, MinStake: conf.MinStake, MaxNumDelegates: conf.MaxDelegates},
+		ssc.getStakePoolAdapter)
 }
@@ -2,6 +2,7 @@ package storagesc
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 	"testing"
@@ -207,3 +208,216 @@ type formulaeStakePoolLock struct {
 	scYaml        Config
 	now           common.Timestamp
 }
+
+func TestStakePoolUnbonding(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		now      = int64(1000)
+	)
+	conf := setConfig(t, balances)
+	conf.StakePool.UnbondingRounds = 10
+	_, err := balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	require.NoError(t, err)
+
+	blob := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+	sp, err := ssc.getStakePool(spenum.Blobber, blob.id, balances)
+	require.NoError(t, err)
+	stake := sp.Pools[blob.id].Balance
+
+	balances.block.Round = 5
+	tx := newTransaction(blob.id, ADDRESS, 0, now+1)
+	balances.setTransaction(t, tx)
+
+	// the stake backing the offers can't start unbonding
+	sp.TotalOffers = 1
+	require.NoError(t, sp.Save(spenum.Blobber, blob.id, balances))
+	_, err = ssc.stakePoolUnlock(tx, blob.stakeLockRequest(t), balances)
+	require.EqualError(t, err, fmt.Sprintf("stake_pool_unlock_failed: "+
+		"insufficent stake to cover offers: existing stake %d, unlock balance %d, offers 1", stake, stake))
+	sp.TotalOffers = 0
+	require.NoError(t, sp.Save(spenum.Blobber, blob.id, balances))
+
+	_, err = ssc.stakePoolUnlock(tx, blob.stakeLockRequest(t), balances)
+	require.NoError(t, err)
+
+	sp, err = ssc.getStakePool(spenum.Blobber, blob.id, balances)
+	require.NoError(t, err)
+	dp := sp.Pools[blob.id]
+	require.Equal(t, spenum.Unbonding, dp.Status)
+	require.EqualValues(t, 15, dp.ReleaseRound)
+	require.Equal(t, stake, dp.Balance)
+
+	// the unbonding pool doesn't back the capacity, but it's still slashed
+	staked, err := sp.stake()
+	require.NoError(t, err)
+	require.Zero(t, staked)
+	require.NoError(t, sp.SlashFraction(0.5, blob.id, spenum.Blobber, balances))
+	require.Equal(t, stake/2, dp.Balance)
+	require.NoError(t, sp.Save(spenum.Blobber, blob.id, balances))
+	stake = dp.Balance
+
+	_, err = ssc.stakePoolUnlock(tx, blob.stakeLockRequest(t), balances)
+	require.EqualError(t, err, "stake_pool_unlock_failed: delegate pool is unbonding until round 15")
+	_, err = ssc.stakePoolClaimUnbonded(tx, blob.stakeLockRequest(t), balances)
+	require.EqualError(t, err, "stake_pool_claim_unbonded_failed: "+
+		"delegate pool is unbonding until round 15, current round 5")
+
+	balances.block.Round = 15
+	before := balances.balances[blob.id]
+	_, err = ssc.stakePoolClaimUnbonded(tx, blob.stakeLockRequest(t), balances)
+	require.NoError(t, err)
+	require.Equal(t, before+stake, balances.balances[blob.id])
+
+	sp, err = ssc.getStakePool(spenum.Blobber, blob.id, balances)
+	require.NoError(t, err)
+	require.NotContains(t, sp.Pools, blob.id)
+}
+
+func Test_stakePool_slash(t *testing.T) {
+	var (
+		balances = newTestBalances(t, false)
+		sp       = newStakePool()
+	)
+	sp.Pools["active"] = &stakepool.DelegatePool{Balance: 30, Status: spenum.Active, DelegateID: "active"}
+	sp.Pools["unbonding"] = &stakepool.DelegatePool{Balance: 10, Status: spenum.Unbonding, DelegateID: "unbonding"}
+
+	// the unbonding pools are slashed too, by the same ratio
+	move, err := sp.slash("blobber", 40, 20, balances, "", spenum.ChallengeSlashPenalty)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, move)
+	require.EqualValues(t, 15, sp.Pools["active"].Balance)
+	require.EqualValues(t, 5, sp.Pools["unbonding"].Balance)
+
+	// all the pools are unbonding, no more than their stake is moved
+	sp.Pools["active"].Status = spenum.Unbonding
+	move, err = sp.slash("blobber", 40, 40, balances, "", spenum.ChallengeSlashPenalty)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, move)
+	require.Zero(t, sp.Pools["active"].Balance)
+	require.Zero(t, sp.Pools["unbonding"].Balance)
+
+	move, err = sp.slash("blobber", 40, 40, balances, "", spenum.ChallengeSlashPenalty)
+	require.NoError(t, err)
+	require.Zero(t, move)
+}
+
+func TestStakePoolRedelegate(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		now      = int64(1000)
+	)
+	setConfig(t, balances)
+
+	from := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+	to := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+	fromSp, err := ssc.getStakePool(spenum.Blobber, from.id, balances)
+	require.NoError(t, err)
+	stake := fromSp.Pools[from.id].Balance
+	toSp, err := ssc.getStakePool(spenum.Blobber, to.id, balances)
+	require.NoError(t, err)
+	toStake, err := toSp.stake()
+	require.NoError(t, err)
+
+	redelegate := func() error {
+		tx := newTransaction(from.id, ADDRESS, 0, now+1)
+		balances.setTransaction(t, tx)
+		_, err := ssc.stakePoolRedelegate(tx, (&stakepool.RedelegateRequest{
+			ProviderType:   spenum.Blobber,
+			ProviderID:     from.id,
+			ToProviderType: spenum.Blobber,
+			ToProviderID:   to.id,
+		}).Encode(), balances)
+		return err
+	}
+
+	toSp.Settings.MinStake = stake + 1
+	require.NoError(t, toSp.Save(spenum.Blobber, to.id, balances))
+	require.EqualError(t, redelegate(), fmt.Sprintf("stake_pool_redelegate_failed: "+
+		"too small stake for the target provider: %v < %v", stake, stake+1))
+
+	toSp.Settings.MinStake = 0
+	require.NoError(t, toSp.Save(spenum.Blobber, to.id, balances))
+
+	// the stake backing the offers can't leave like it can't be unlocked
+	fromSp.TotalOffers = 1
+	require.NoError(t, fromSp.Save(spenum.Blobber, from.id, balances))
+	require.EqualError(t, redelegate(), fmt.Sprintf("stake_pool_redelegate_failed: "+
+		"insufficent stake to cover offers: existing stake %d, unlock balance %d, offers 1", stake, stake))
+	fromSp.TotalOffers = 0
+	fromSp.Pools[from.id].AutoCompound = true
+	require.NoError(t, fromSp.Save(spenum.Blobber, from.id, balances))
+
+	before := balances.balances[from.id]
+	require.NoError(t, redelegate())
+	require.Equal(t, before, balances.balances[from.id])
+
+	fromSp, err = ssc.getStakePool(spenum.Blobber, from.id, balances)
+	require.NoError(t, err)
+	require.NotContains(t, fromSp.Pools, from.id)
+	toSp, err = ssc.getStakePool(spenum.Blobber, to.id, balances)
+	require.NoError(t, err)
+	require.Equal(t, stake, toSp.Pools[from.id].Balance)
+	require.Equal(t, spenum.Active, toSp.Pools[from.id].Status)
+	require.True(t, toSp.Pools[from.id].AutoCompound)
+	total, err := toSp.stake()
+	require.NoError(t, err)
+	require.Equal(t, toStake+stake, total)
+
+	require.EqualError(t, redelegate(), "stake_pool_redelegate_failed: "+
+		"no such delegate pool: "+from.id)
+}
+
+func TestStakePoolRedelegateUnbonding(t *testing.T) {
+	var (
+		ssc      = newTestStorageSC()
+		balances = newTestBalances(t, false)
+		now      = int64(1000)
+	)
+	conf := setConfig(t, balances)
+	conf.StakePool.UnbondingRounds = 10
+	_, err := balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	require.NoError(t, err)
+
+	from := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+	to := addBlobber(t, ssc, 2*GB, now, avgTerms, 50*x10, balances)
+	fromSp, err := ssc.getStakePool(spenum.Blobber, from.id, balances)
+	require.NoError(t, err)
+	stake := fromSp.Pools[from.id].Balance
+
+	balances.block.Round = 5
+	tx := newTransaction(from.id, ADDRESS, 0, now+1)
+	balances.setTransaction(t, tx)
+	redelegate := func() error {
+		_, err := ssc.stakePoolRedelegate(tx, (&stakepool.RedelegateRequest{
+			ProviderType:   spenum.Blobber,
+			ProviderID:     from.id,
+			ToProviderType: spenum.Blobber,
+			ToProviderID:   to.id,
+		}).Encode(), balances)
+		return err
+	}
+
+	// the pool is unbonded first, like it's unlocked
+	require.EqualError(t, redelegate(), "stake_pool_redelegate_failed: "+
+		"delegate pool should be unlocked and unbonded for 10 rounds first")
+	_, err = ssc.stakePoolUnlock(tx, from.stakeLockRequest(t), balances)
+	require.NoError(t, err)
+	require.EqualError(t, redelegate(), "stake_pool_redelegate_failed: "+
+		"delegate pool is unbonding until round 15, current round 5")
+
+	// and it's redelegated instead of claimed after the release round
+	balances.block.Round = 15
+	before := balances.balances[from.id]
+	require.NoError(t, redelegate())
+	require.Equal(t, before, balances.balances[from.id])
+
+	fromSp, err = ssc.getStakePool(spenum.Blobber, from.id, balances)
+	require.NoError(t, err)
+	require.NotContains(t, fromSp.Pools, from.id)
+	toSp, err := ssc.getStakePool(spenum.Blobber, to.id, balances)
+	require.NoError(t, err)
+	require.Equal(t, stake, toSp.Pools[from.id].Balance)
+	require.Equal(t, spenum.Active, toSp.Pools[from.id].Status)
+}
@@ -0,0 +1,208 @@
+package storagesc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/0chain/common/core/currency"
+
+	"0chain.net/smartcontract/dbs/event"
+)
+
+// Formats of the transaction history export.
+const (
+	historyFormatJSONL = "jsonl"
+	historyFormatCSV   = "csv"
+)
+
+const (
+	defaultHistoryLimit = 100
+	maxHistoryLimit     = 1000
+)
+
+// historyNextCursorHeader carries the cursor of the next page, it's not set
+// for the last page
+const historyNextCursorHeader = "X-Next-Cursor"
+
+// historyTransactionKind is the kind of the entry of the transaction itself,
+// transfers and mints made by the transaction follow it
+const historyTransactionKind = "transaction"
+
+var historyCSVHeader = []string{
+	"round", "index", "hash", "kind", "function_name",
+	"from", "to", "amount", "fee", "status", "creation_date",
+}
+
+// historyEntry is a line of the transaction history export
+type historyEntry struct {
+	Round        int64         `json:"round"`
+	Index        int           `json:"index"`
+	Hash         string        `json:"hash"`
+	Kind         string        `json:"kind"` // transaction, transfer or mint
+	FunctionName string        `json:"function_name,omitempty"`
+	From         string        `json:"from"`
+	To           string        `json:"to"`
+	Amount       currency.Coin `json:"amount"`
+	Fee          currency.Coin `json:"fee"`
+	Status       int           `json:"status"`
+	CreationDate int64         `json:"creation_date"`
+}
+
+func (he *historyEntry) csvRecord() []string {
+	return []string{
+		strconv.FormatInt(he.Round, 10),
+		strconv.Itoa(he.Index),
+		he.Hash,
+		he.Kind,
+		he.FunctionName,
+		he.From,
+		he.To,
+		strconv.FormatUint(uint64(he.Amount), 10),
+		strconv.FormatUint(uint64(he.Fee), 10),
+		strconv.Itoa(he.Status),
+		strconv.FormatInt(he.CreationDate, 10),
+	}
+}
+
+// historyEntries lists every transaction followed by its transfers and mints
+func historyEntries(txns []event.Transaction, transfers []event.TransactionTransfer) []historyEntry {
+	byTxn := make(map[string][]event.TransactionTransfer, len(txns))
+	for _, t := range transfers {
+		byTxn[t.TxnHash] = append(byTxn[t.TxnHash], t)
+	}
+
+	entries := make([]historyEntry, 0, len(txns)+len(transfers))
+	for _, txn := range txns {
+		entries = append(entries, historyEntry{
+			Round:        txn.Round,
+			Index:        txn.Index,
+			Hash:         txn.Hash,
+			Kind:         historyTransactionKind,
+			FunctionName: txn.FunctionName,
+			From:         txn.ClientId,
+			To:           txn.ToClientId,
+			Amount:       txn.Value,
+			Fee:          txn.Fee,
+			Status:       txn.Status,
+			CreationDate: txn.CreationDate,
+		})
+		for _, t := range byTxn[txn.Hash] {
+			entries = append(entries, historyEntry{
+				Round:        txn.Round,
+				Index:        txn.Index,
+				Hash:         txn.Hash,
+				Kind:         t.Kind,
+				FunctionName: txn.FunctionName,
+				From:         t.FromClientID,
+				To:           t.ToClientID,
+				Amount:       t.Amount,
+				Status:       txn.Status,
+				CreationDate: txn.CreationDate,
+			})
+		}
+	}
+	return entries
+}
+
+func writeHistoryJSONL(w io.Writer, entries []historyEntry) error {
+	enc := json.NewEncoder(w)
+	for i := range entries {
+		if err := enc.Encode(&entries[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHistoryCSV(w io.Writer, entries []historyEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(historyCSVHeader); err != nil {
+		return err
+	}
+	for i := range entries {
+		if err := cw.Write(entries[i].csvRecord()); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatHistoryCursor encodes the cursor as "round:index"
+func formatHistoryCursor(c event.TransactionCursor) string {
+	return fmt.Sprintf("%d:%d", c.Round, c.Index)
+}
+
+func parseHistoryCursor(s string) (*event.TransactionCursor, error) {
+	round, index, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, errors.New("cursor must be round:index")
+	}
+	var (
+		c   event.TransactionCursor
+		err error
+	)
+	if c.Round, err = strconv.ParseInt(round, 10, 64); err != nil || c.Round < 0 {
+		return nil, fmt.Errorf("invalid cursor round: %s", round)
+	}
+	if c.Index, err = strconv.Atoi(index); err != nil || c.Index < 0 {
+		return nil, fmt.Errorf("invalid cursor index: %s", index)
+	}
+	return &c, nil
+}
+
+// parseHistoryFilter reads the transaction history filter and the format
+// from the query
+func parseHistoryFilter(query url.Values) (filter event.TransactionHistoryFilter, format string, err error) {
+	filter.ClientID = query.Get("client_id")
+	if filter.ClientID == "" {
+		return filter, "", errors.New("missing client_id")
+	}
+	filter.FunctionName = query.Get("function_name")
+
+	switch format = query.Get("format"); format {
+	case "":
+		format = historyFormatJSONL
+	case historyFormatJSONL, historyFormatCSV:
+	default:
+		return filter, "", fmt.Errorf("unknown format %q, should be jsonl or csv", format)
+	}
+
+	if start := query.Get("start"); start != "" {
+		if filter.StartRound, err = strconv.ParseInt(start, 10, 64); err != nil || filter.StartRound < 0 {
+			return filter, "", errors.New("start block number is not valid")
+		}
+	}
+	if end := query.Get("end"); end != "" {
+		if filter.EndRound, err = strconv.ParseInt(end, 10, 64); err != nil || filter.EndRound <= 0 {
+			return filter, "", errors.New("end block number is not valid")
+		}
+		if filter.StartRound >= filter.EndRound {
+			return filter, "", errors.New("start block number is not less than end block number")
+		}
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		if filter.After, err = parseHistoryCursor(cursor); err != nil {
+			return filter, "", err
+		}
+	}
+
+	filter.Limit = defaultHistoryLimit
+	if limit := query.Get("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil || filter.Limit <= 0 {
+			return filter, "", errors.New("limit parameter is not valid")
+		}
+		if filter.Limit > maxHistoryLimit {
+			return filter, "", fmt.Errorf("limit %d too high, cannot exceed %d",
+				filter.Limit, maxHistoryLimit)
+		}
+	}
+	return filter, format, nil
+}
@@ -0,0 +1,94 @@
+package storagesc
+
+import (
+	"bytes"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/smartcontract/dbs/event"
+)
+
+func Test_parseHistoryCursor(t *testing.T) {
+	c, err := parseHistoryCursor("15:3")
+	require.NoError(t, err)
+	require.Equal(t, event.TransactionCursor{Round: 15, Index: 3}, *c)
+	require.Equal(t, "15:3", formatHistoryCursor(*c))
+
+	_, err = parseHistoryCursor("15")
+	require.EqualError(t, err, "cursor must be round:index")
+	_, err = parseHistoryCursor("15:-1")
+	require.EqualError(t, err, "invalid cursor index: -1")
+	_, err = parseHistoryCursor("x:1")
+	require.EqualError(t, err, "invalid cursor round: x")
+}
+
+func Test_parseHistoryFilter(t *testing.T) {
+	filter, format, err := parseHistoryFilter(url.Values{
+		"client_id":     {"client"},
+		"function_name": {"pour"},
+		"start":         {"10"},
+		"cursor":        {"12:1"},
+		"format":        {"csv"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, historyFormatCSV, format)
+	require.Equal(t, event.TransactionHistoryFilter{
+		ClientID:     "client",
+		FunctionName: "pour",
+		StartRound:   10,
+		After:        &event.TransactionCursor{Round: 12, Index: 1},
+		Limit:        defaultHistoryLimit,
+	}, filter)
+
+	_, format, err = parseHistoryFilter(url.Values{"client_id": {"client"}})
+	require.NoError(t, err)
+	require.Equal(t, historyFormatJSONL, format)
+
+	for _, tt := range []struct {
+		query url.Values
+		err   string
+	}{
+		{url.Values{}, "missing client_id"},
+		{url.Values{"client_id": {"c"}, "format": {"xml"}}, `unknown format "xml", should be jsonl or csv`},
+		{url.Values{"client_id": {"c"}, "start": {"10"}, "end": {"10"}}, "start block number is not less than end block number"},
+		{url.Values{"client_id": {"c"}, "limit": {"1001"}}, "limit 1001 too high, cannot exceed 1000"},
+		{url.Values{"client_id": {"c"}, "limit": {"0"}}, "limit parameter is not valid"},
+	} {
+		_, _, err = parseHistoryFilter(tt.query)
+		require.EqualError(t, err, tt.err)
+	}
+}
+
+func Test_historyEntries(t *testing.T) {
+	txns := []event.Transaction{
+		{Hash: "a", Round: 5, Index: 0, FunctionName: "pour", ClientId: "c", ToClientId: "faucet", Fee: 10, Status: 1},
+		{Hash: "b", Round: 5, Index: 1, ClientId: "c", ToClientId: "d", Value: 7, Status: 1},
+	}
+	transfers := []event.TransactionTransfer{
+		{TxnHash: "b", Kind: event.TransferKind, FromClientID: "c", ToClientID: "d", Amount: 7},
+		{TxnHash: "a", Kind: event.TransferKind, FromClientID: "faucet", ToClientID: "c", Amount: 100},
+		{TxnHash: "a", Kind: event.MintKind, FromClientID: "minter", ToClientID: "c", Amount: 3},
+	}
+
+	entries := historyEntries(txns, transfers)
+	require.Len(t, entries, 5)
+	var kinds []string
+	for _, e := range entries {
+		kinds = append(kinds, e.Hash+":"+e.Kind)
+	}
+	require.Equal(t, []string{"a:transaction", "a:transfer", "a:mint", "b:transaction", "b:transfer"}, kinds)
+	require.Equal(t, "pour", entries[2].FunctionName)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeHistoryCSV(&buf, entries[:2]))
+	require.Equal(t, "round,index,hash,kind,function_name,from,to,amount,fee,status,creation_date\n"+
+		"5,0,a,transaction,pour,c,faucet,0,10,1,0\n"+
+		"5,0,a,transfer,pour,faucet,c,100,0,1,0\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, writeHistoryJSONL(&buf, entries[3:4]))
+	require.Equal(t, `{"round":5,"index":1,"hash":"b","kind":"transaction","from":"c","to":"d","amount":7,"fee":0,"status":1,"creation_date":0}`+"\n",
+		buf.String())
+}
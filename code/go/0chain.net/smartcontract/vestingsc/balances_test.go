@@ -22,6 +22,7 @@ type testBalances struct {
 	txn       *transaction.Transaction
 	transfers []*state.Transfer
 	tree      map[datastore.Key]util.MPTSerializable
+	events    []event.Event
 }
 
 func newTestBalances() *testBalances {
@@ -47,7 +48,8 @@ func (tb *testBalances) GetTransfers() []*state.Transfer              { return n
 func (tb *testBalances) GetChainCurrentMagicBlock() *block.MagicBlock { return nil }
 func (tb *testBalances) AddSignedTransfer(st *state.SignedTransfer)   {}
 func (tb *testBalances) GetEventDB() *event.EventDb                   { return nil }
-func (tb *testBalances) EmitEvent(event.EventType, event.EventTag, string, interface{}, ...cstate.Appender) {
+func (tb *testBalances) EmitEvent(eventType event.EventType, tag event.EventTag, index string, data interface{}, _ ...cstate.Appender) {
+	tb.events = append(tb.events, event.Event{Type: eventType, Tag: tag, Index: index, Data: data})
 }
 func (tb *testBalances) EmitError(error)                             {}
 func (tb *testBalances) GetEvents() []event.Event                    { return nil }
@@ -32,6 +32,13 @@ func BenchmarkRestTests(
 				},
 				Endpoint: vrh.getClientPools,
 			},
+			{
+				FuncName: "pool-transfers",
+				Params: map[string]string{
+					"pool_id": geMockVestingPoolId(0),
+				},
+				Endpoint: vrh.getPoolTransfers,
+			},
 		},
 		ADDRESS,
 		vrh,
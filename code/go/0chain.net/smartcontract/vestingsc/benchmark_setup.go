@@ -8,12 +8,17 @@ import (
 
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/core/common"
+	config2 "0chain.net/core/config"
 	"0chain.net/core/encryption"
 	"0chain.net/core/viper"
 	"0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool/spenum"
 )
 
 const mockVpBalance = 100e10
+const mockGovernanceWeight = 100e10
 const mockDestinationBalance = 1e10
 
 func AddMockClientPools(
@@ -44,6 +49,10 @@ func AddMockConfig(balances cstate.StateContextI) {
 	conf.MaxDuration = viper.GetDuration(benchmark.VestingMaxDuration)
 	conf.MaxDestinations = viper.GetInt(benchmark.VestingMaxDestinations)
 	conf.MaxDescriptionLength = viper.GetInt(benchmark.VestingMaxDescriptionLength)
+	conf.Governance, err = governance.ReadConfig(benchmark.SmartContract + benchmark.VestingSc)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), &conf)
 	if err != nil {
@@ -51,6 +60,27 @@ func AddMockConfig(balances cstate.StateContextI) {
 	}
 }
 
+// AddMockGovernanceProposals adds the open proposal to vote on and the one
+// voted by the first client to execute, the vesting pools aren't staked so
+// the client votes with the stake in the first miner
+func AddMockGovernanceProposals(
+	clients, miners []string,
+	eventDb *event.EventDb,
+	balances cstate.StateContextI,
+) {
+	governance.AddMockProposals(ADDRESS, clients[0], clients[0], mockGovernanceWeight,
+		[]governance.StakeRef{{ProviderType: spenum.Miner, ProviderID: miners[0]}},
+		config2.StringMap{Fields: map[string]string{Settings[MaxDestinations]: "4"}},
+		eventDb, balances)
+}
+
+func mockGovernanceStakes(data benchmark.BenchData) []governance.StakeRef {
+	return []governance.StakeRef{{
+		ProviderType: spenum.Miner,
+		ProviderID:   data.Miners[0],
+	}}
+}
+
 func AddMockVestingPools(
 	clients []string,
 	balances cstate.StateContextI,
@@ -62,6 +92,8 @@ func AddMockVestingPools(
 			StartTime:   0,
 			ExpireAt:    now + common.Timestamp(viper.GetDuration(benchmark.VestingMaxDuration).Seconds()),
 			ClientID:    clients[i],
+			Schedule:    getMockSchedule(i, now),
+			Revocable:   true,
 		}
 		for j := 0; j < viper.GetInt(benchmark.NumVestingDestinationsClient); j++ {
 			dest := &destination{
@@ -78,6 +110,25 @@ func AddMockVestingPools(
 	}
 }
 
+func AddMockPoolTransfers(
+	clients []string,
+	eventDb *event.EventDb,
+) {
+	transfers := make([]event.VestingPoolTransfer, 0, len(clients))
+	for i := 0; i < len(clients)-1; i++ {
+		transfers = append(transfers, event.VestingPoolTransfer{
+			PoolID:       geMockVestingPoolId(i),
+			TxnHash:      encryption.Hash("mock vesting pool transfer" + strconv.Itoa(i)),
+			Type:         int(OwnerTransfer),
+			FromClientID: clients[i+1],
+			ToClientID:   clients[i],
+		})
+	}
+	if err := eventDb.Store.Get().Create(&transfers).Error; err != nil {
+		log.Fatal(err)
+	}
+}
+
 func geMockVestingPoolId(client int) string {
 	return encryption.Hash("mock vesting pool for" + strconv.Itoa(client))
 }
@@ -85,3 +136,16 @@ func geMockVestingPoolId(client int) string {
 func getMockDestinationId(dest, client int) string {
 	return encryption.Hash("mock destination" + strconv.Itoa(dest) + strconv.Itoa(client))
 }
+
+// getMockSchedule alternates the schedule types, the cliff and the first
+// tranche are released by now
+func getMockSchedule(client int, now common.Timestamp) schedule {
+	switch ScheduleType(client % 3) {
+	case CliffSchedule:
+		return schedule{Type: CliffSchedule, CliffAt: now / 2}
+	case StepSchedule:
+		return schedule{Type: StepSchedule, Step: now / 2}
+	default:
+		return schedule{}
+	}
+}
@@ -15,7 +15,9 @@ import (
 	sci "0chain.net/chaincore/smartcontractinterface"
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
+	"0chain.net/core/encryption"
 	bk "0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/governance"
 )
 
 type BenchTest struct {
@@ -47,8 +49,26 @@ func (bt BenchTest) Run(balances cstate.TimedQueryStateContext, _ *testing.B) er
 	return err
 }
 
+func mockConsent(sigScheme bk.SignatureScheme, data bk.BenchData, client int,
+	message string) consent {
+
+	if err := sigScheme.SetPublicKey(data.PublicKeys[client]); err != nil {
+		panic(err)
+	}
+	sigScheme.SetPrivateKey(data.PrivateKeys[client])
+	signature, err := sigScheme.Sign(encryption.Hash(message))
+	if err != nil {
+		panic(err)
+	}
+	return consent{
+		ClientID:  data.Clients[client],
+		PublicKey: data.PublicKeys[client],
+		Signature: signature,
+	}
+}
+
 func BenchmarkTests(
-	data bk.BenchData, _ bk.SignatureScheme,
+	data bk.BenchData, sigScheme bk.SignatureScheme,
 ) bk.TestSuite {
 	creationTimeRaw := viper.GetInt64("MptCreationTime")
 	creationTime := common.Now()
@@ -147,6 +167,56 @@ func BenchmarkTests(
 				return bytes
 			}(),
 		},
+		{
+			name:     "vesting.revoke",
+			endpoint: vsc.revoke,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&stopRequest{
+					PoolID:      geMockVestingPoolId(0),
+					Destination: getMockDestinationId(0, 0),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "vesting.transfer",
+			endpoint: vsc.transfer,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				poolID := geMockVestingPoolId(0)
+				bytes, _ := json.Marshal(&transferRequest{
+					PoolID: poolID,
+					NewOwner: mockConsent(sigScheme, data, 1,
+						ownerConsentMessage(poolID, data.Clients[0], data.Clients[1])),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "vesting.reassign",
+			endpoint: vsc.reassign,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				poolID := geMockVestingPoolId(0)
+				bytes, _ := json.Marshal(&reassignRequest{
+					PoolID:      poolID,
+					Destination: getMockDestinationId(0, 0),
+					NewDestination: mockConsent(sigScheme, data, 2,
+						destinationConsentMessage(poolID, getMockDestinationId(0, 0), data.Clients[2])),
+				})
+				return bytes
+			}(),
+		},
 		{
 			name:     "vesting.delete",
 			endpoint: vsc.delete,
@@ -161,6 +231,47 @@ func BenchmarkTests(
 				return bytes
 			}(),
 		},
+		{
+			name:     "vesting.governance-propose",
+			endpoint: vsc.governancePropose,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ProposeRequest{
+				Changes: sc.StringMap{
+					Fields: map[string]string{Settings[MaxDestinations]: "3"},
+				},
+				Stakes: mockGovernanceStakes(data),
+			}).Encode(),
+		},
+		{
+			name:     "vesting.governance-vote",
+			endpoint: vsc.governanceVote,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.VoteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 0),
+				Approve:    true,
+				Stakes:     mockGovernanceStakes(data),
+			}).Encode(),
+		},
+		{
+			name:     "vesting.governance-execute",
+			endpoint: vsc.governanceExecute,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[1],
+				ToClientID:   ADDRESS,
+				CreationDate: creationTime,
+			},
+			input: (&governance.ExecuteRequest{
+				ProposalID: governance.GetMockProposalId(ADDRESS, 1),
+			}).Encode(),
+		},
 	}
 	var testsI []bk.BenchTestI
 	for _, test := range tests {
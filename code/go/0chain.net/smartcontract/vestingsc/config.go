@@ -12,6 +12,7 @@ import (
 	"time"
 
 	config2 "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/currency"
 
 	"0chain.net/chaincore/smartcontractinterface"
@@ -54,6 +55,9 @@ var (
 		"add",
 		"delete",
 		"stop",
+		"revoke",
+		"transfer",
+		"reassign",
 		"trigger",
 		"unlock",
 		"vestingsc-update-settings",
@@ -73,6 +77,8 @@ type config struct {
 	MaxDescriptionLength int            `json:"max_description_length"`
 	OwnerId              string         `json:"owner_id"`
 	Cost                 map[string]int `json:"cost"`
+	// Governance of the vesting settings changes
+	Governance governance.Config `json:"governance"`
 }
 
 func (c *config) validate() (err error) {
@@ -88,6 +94,9 @@ func (c *config) validate() (err error) {
 	case c.OwnerId == "":
 		return errors.New("owner_id is not set or empty")
 	}
+	if err = c.Governance.Validate(); err != nil {
+		return fmt.Errorf("invalid governance: %v", err)
+	}
 	return
 }
 
@@ -105,6 +114,13 @@ func (c *config) Decode(b []byte) error {
 
 func (c *config) update(changes *config2.StringMap) error {
 	for key, value := range changes.Fields {
+		if strings.HasPrefix(key, governance.Prefix) {
+			if err := c.Governance.Set(strings.TrimPrefix(key, governance.Prefix), value); err != nil {
+				return err
+			}
+			continue
+		}
+
 		switch key {
 		case Settings[MinLock]:
 			if sbValue, err := strconv.ParseFloat(value, 64); err != nil {
@@ -200,6 +216,9 @@ func (c *config) getConfigMap() config2.StringMap {
 	for _, key := range costFunctions {
 		fields[fmt.Sprintf("cost.%s", key)] = fmt.Sprintf("%0v", c.Cost[strings.ToLower(key)])
 	}
+	for key, value := range c.Governance.Fields() {
+		fields[key] = value
+	}
 
 	return config2.StringMap{
 		Fields: fields,
@@ -228,6 +247,10 @@ func (vsc *VestingSmartContract) updateConfig(
 		return "", common.NewError("update_config", err.Error())
 	}
 
+	if err := governance.CheckOwnerChanges(*update); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
 	if err := conf.update(update); err != nil {
 		return "", common.NewError("update_config", err.Error())
 	}
@@ -262,6 +285,10 @@ func getConfiguredConfig() (conf *config, err error) {
 	conf.MaxDescriptionLength = scconf.GetInt(prefix + "max_description_length")
 	conf.OwnerId = scconf.GetString(prefix + "owner_id")
 	conf.Cost = scconf.GetStringMapInt(prefix + "cost")
+	conf.Governance, err = governance.ReadConfig(prefix)
+	if err != nil {
+		return nil, err
+	}
 
 	err = conf.validate()
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	config2 "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/currency"
 
 	chainstate "0chain.net/chaincore/chain/state"
@@ -41,18 +42,21 @@ func Test_config_validate(t *testing.T) {
 		err    string
 	}{
 		// min duration
-		{config{1, s(-1), 0, 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}}, "invalid min_duration (< 1s)"},
-		{config{1, s(0), 0, 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}}, "invalid min_duration (< 1s)"},
+		{config{1, s(-1), 0, 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}, governance.Config{}}, "invalid min_duration (< 1s)"},
+		{config{1, s(0), 0, 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}, governance.Config{}}, "invalid min_duration (< 1s)"},
 		// max duration
-		{config{1, s(1), s(0), 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}},
+		{config{1, s(1), s(0), 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}, governance.Config{}},
 			"invalid max_duration: less or equal to min_duration"},
-		{config{1, s(1), s(1), 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}},
+		{config{1, s(1), s(1), 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}, governance.Config{}},
 			"invalid max_duration: less or equal to min_duration"},
 		// max_destinations
-		{config{1, s(1), s(2), 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}}, "invalid max_destinations (< 1)"},
+		{config{1, s(1), s(2), 0, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}, governance.Config{}}, "invalid max_destinations (< 1)"},
 		// max_description_length
-		{config{1, s(1), s(2), 1, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}}, "invalid max_description_length (< 1)"},
-		{config{1, s(1), s(2), 1, 1, "", map[string]int{"1": 1, "2": 2, "3": 3}}, "owner_id is not set or empty"},
+		{config{1, s(1), s(2), 1, 0, "", map[string]int{"1": 1, "2": 2, "3": 3}, governance.Config{}}, "invalid max_description_length (< 1)"},
+		{config{1, s(1), s(2), 1, 1, "", map[string]int{"1": 1, "2": 2, "3": 3}, governance.Config{}}, "owner_id is not set or empty"},
+		// governance
+		{config{1, s(1), s(2), 1, 1, "owner", map[string]int{"1": 1, "2": 2, "3": 3}, governance.Config{}},
+			"invalid governance: quorum must be positive"},
 	} {
 		requireErrMsg(t, tt.config.validate(), tt.err)
 	}
@@ -68,12 +72,22 @@ func configureConfig() (configured *config) {
 	config2.SmartContractConfig.Set(pfx+"max_description_length", 20)
 	config2.SmartContractConfig.Set(pfx+"owner_id", "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745802")
 	config2.SmartContractConfig.Set(pfx+"cost", "{\"1\":1, \"2\":2, \"3\":3}")
+	config2.SmartContractConfig.Set(pfx+"governance.quorum", 1000)
+	config2.SmartContractConfig.Set(pfx+"governance.threshold", 0.66)
+	config2.SmartContractConfig.Set(pfx+"governance.voting_period", time.Hour)
+	config2.SmartContractConfig.Set(pfx+"governance.max_voters", 100)
 
 	return &config{
 		100e10,
 		1 * time.Second, 10 * time.Hour,
 		2, 20, "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745802",
 		map[string]int{"1": 1, "2": 2, "3": 3},
+		governance.Config{
+			Quorum:       1000e10,
+			Threshold:    0.66,
+			VotingPeriod: time.Hour,
+			MaxVoters:    100,
+		},
 	}
 }
 
@@ -0,0 +1,80 @@
+package vestingsc
+
+import (
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	config2 "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+)
+
+// governanceSettings are the vesting settings changed by the governance,
+// the same way vestingsc-update-settings does
+type governanceSettings struct {
+	vsc *VestingSmartContract
+}
+
+func (s governanceSettings) Config(balances chainstate.StateContextI) (*governance.Config, error) {
+	conf, err := s.vsc.getConfig(balances)
+	if err != nil {
+		return nil, err
+	}
+	return &conf.Governance, nil
+}
+
+func (s governanceSettings) update(changes config2.StringMap, balances chainstate.StateContextI) (*config, error) {
+	conf, err := s.vsc.getConfig(balances)
+	if err != nil {
+		return nil, err
+	}
+	if err := conf.update(&changes); err != nil {
+		return nil, err
+	}
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (s governanceSettings) Validate(changes config2.StringMap, balances chainstate.StateContextI) error {
+	_, err := s.update(changes, balances)
+	return err
+}
+
+func (s governanceSettings) Apply(changes config2.StringMap, balances chainstate.StateContextI) error {
+	conf, err := s.update(changes, balances)
+	if err != nil {
+		return err
+	}
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	return err
+}
+
+// governancePropose submits the proposal of the vesting settings changes,
+// the proposer is a council member or a staker
+func (vsc *VestingSmartContract) governancePropose(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.Propose(t, input, vsc.ID, governanceSettings{vsc}, balances)
+}
+
+// governanceVote on the open proposal with the stake of the voter, a vote
+// again replaces the former one
+func (vsc *VestingSmartContract) governanceVote(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.CastVote(t, input, vsc.ID, governanceSettings{vsc}, balances)
+}
+
+// governanceExecute closes the proposal after the voting, the passed one
+// is applied to the settings after the timelock. Anyone can do it.
+func (vsc *VestingSmartContract) governanceExecute(
+	t *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (string, error) {
+	return governance.Execute(t, input, vsc.ID, governanceSettings{vsc}, balances)
+}
@@ -6,6 +6,7 @@ import (
 
 	"0chain.net/core/common"
 	"0chain.net/smartcontract"
+	common2 "0chain.net/smartcontract/common"
 )
 
 type VestingRestHandler struct {
@@ -27,6 +28,7 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		rest.MakeEndpoint(vesting+"/getPoolInfo", common.UserRateLimit(vrh.getPoolInfo)),
 		rest.MakeEndpoint(vesting+"/getClientPools", common.UserRateLimit(vrh.getClientPools)),
 		rest.MakeEndpoint(vesting+"/vesting-config", common.UserRateLimit(vrh.getConfig)),
+		rest.MakeEndpoint(vesting+"/pool-transfers", common.UserRateLimit(vrh.getPoolTransfers)),
 	}
 }
 
@@ -53,7 +55,7 @@ func (vrh *VestingRestHandler) getClientPools(w http.ResponseWriter, r *http.Req
 }
 
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d9/getPoolInfo getPoolInfo
-// get vesting configuration settings
+// get vesting pool information, tokens earned by destinations follow the pool schedule
 //
 // responses:
 //  200: vestingInfo
@@ -93,3 +95,57 @@ func (vrh *VestingRestHandler) getConfig(w http.ResponseWriter, r *http.Request)
 	}
 	common.Respond(w, r, conf.getConfigMap(), nil)
 }
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d9/pool-transfers pool-transfers
+// get ownership transfers and destination reassignments of a vesting pool
+//
+// parameters:
+//
+//	+name: pool_id
+//	 description: vesting pool id
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []VestingPoolTransfer
+//	400:
+//	500:
+func (vrh *VestingRestHandler) getPoolTransfers(w http.ResponseWriter, r *http.Request) {
+	poolID := r.URL.Query().Get("pool_id")
+	if poolID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing pool_id"))
+		return
+	}
+
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := vrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	transfers, err := edb.GetVestingPoolTransfers(poolID, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get pool transfers", err.Error()))
+		return
+	}
+	common.Respond(w, r, transfers, nil)
+}
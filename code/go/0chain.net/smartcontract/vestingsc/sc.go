@@ -74,6 +74,16 @@ func (vsc *VestingSmartContract) setSC(sc *smartcontractinterface.SmartContract,
 	vsc.SmartContractExecutionStats["stop"] = metrics.GetOrRegisterTimer(
 		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "stop"), nil)
 
+	// revoke a destination of a revocable pool, returning unvested tokens
+	vsc.SmartContractExecutionStats["revoke"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "revoke"), nil)
+
+	// transfer a pool to a new owner, reassign a destination to a new client
+	vsc.SmartContractExecutionStats["transfer"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "transfer"), nil)
+	vsc.SmartContractExecutionStats["reassign"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "reassign"), nil)
+
 	// tokens unlock for an existing pool (as owner, as a destination)
 	vsc.SmartContractExecutionStats["unlock"] = metrics.GetOrRegisterTimer(
 		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "unlock"), nil)
@@ -84,6 +94,14 @@ func (vsc *VestingSmartContract) setSC(sc *smartcontractinterface.SmartContract,
 
 	vsc.SmartContractExecutionStats["vestingsc-update-settings"] = metrics.GetOrRegisterTimer(
 		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "vestingsc-update-settings"), nil)
+
+	// governance of the settings changes
+	vsc.SmartContractExecutionStats["governance-propose"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "governance-propose"), nil)
+	vsc.SmartContractExecutionStats["governance-vote"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "governance-vote"), nil)
+	vsc.SmartContractExecutionStats["governance-execute"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "governance-execute"), nil)
 }
 
 func (vsc *VestingSmartContract) Execute(t *transaction.Transaction,
@@ -101,10 +119,23 @@ func (vsc *VestingSmartContract) Execute(t *transaction.Transaction,
 		resp, err = vsc.add(t, input, balances)
 	case "stop":
 		resp, err = vsc.stop(t, input, balances)
+	case "revoke":
+		resp, err = vsc.revoke(t, input, balances)
+	case "transfer":
+		resp, err = vsc.transfer(t, input, balances)
+	case "reassign":
+		resp, err = vsc.reassign(t, input, balances)
 	case "delete":
 		resp, err = vsc.delete(t, input, balances)
 	case "vestingsc-update-settings":
 		resp, err = vsc.updateConfig(t, input, balances)
+
+	case "governance-propose":
+		resp, err = vsc.governancePropose(t, input, balances)
+	case "governance-vote":
+		resp, err = vsc.governanceVote(t, input, balances)
+	case "governance-execute":
+		resp, err = vsc.governanceExecute(t, input, balances)
 	default:
 		err = common.NewError("vesting_sc_failed",
 			fmt.Sprintf("no function with %q name", function))
@@ -21,16 +21,43 @@ import (
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
 	"github.com/0chain/common/core/util"
 )
 
-//msgp:ignore info destInfo addRequest
+//msgp:ignore info destInfo addRequest consent transferRequest reassignRequest TransferType
 //go:generate msgp -io=false -tests=false -unexported=true -v
 
 // internal errors
 
 var errZeroVesting = errors.New("zero vesting for this destination and period")
 
+//
+// vesting schedule
+//
+
+// ScheduleType defines how tokens of a vesting pool are released over time.
+type ScheduleType int
+
+const (
+	// LinearSchedule releases tokens continuously from the start to the
+	// expiration. It's used by pools created without a schedule.
+	LinearSchedule ScheduleType = iota
+	// CliffSchedule releases nothing before the cliff, then all tokens
+	// accrued since the start in a lump, and linearly after that.
+	CliffSchedule
+	// StepSchedule releases equal tranches every step (e.g. monthly), the
+	// last tranche is released at the expiration.
+	StepSchedule
+)
+
+type schedule struct {
+	Type    ScheduleType     `json:"type"`
+	CliffAt common.Timestamp `json:"cliff_at,omitempty"` // cliff schedule
+	Step    common.Timestamp `json:"step,omitempty"`     // step schedule, seconds
+}
+
 //
 // lock, unlock, trigger, delete a pool
 //
@@ -56,6 +83,80 @@ func (sr *stopRequest) decode(b []byte) error {
 	return json.Unmarshal(b, sr)
 }
 
+//
+// transfer pool ownership, reassign a destination
+//
+
+// TransferType is kind of a vesting pool transfer stored in event DB.
+type TransferType int
+
+const (
+	OwnerTransfer TransferType = iota
+	DestinationTransfer
+)
+
+// consent of a client to become the owner or a destination of a pool
+type consent struct {
+	ClientID  string `json:"client_id"`
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"` // of the consent message hash
+}
+
+// ownerConsentMessage is signed by the new owner of a pool
+func ownerConsentMessage(poolID, from, to string) string {
+	return fmt.Sprintf("%s:owner:%s:%s", poolID, from, to)
+}
+
+// destinationConsentMessage is signed by the new destination
+func destinationConsentMessage(poolID, from, to string) string {
+	return fmt.Sprintf("%s:destination:%s:%s", poolID, from, to)
+}
+
+func (c *consent) verify(message string,
+	balances chainstate.StateContextI) (err error) {
+
+	if c.ClientID == "" {
+		return errors.New("missing client id")
+	}
+
+	var clientID string
+	clientID, err = encryption.GetClientIDFromPublicKey(c.PublicKey)
+	if err != nil || clientID != c.ClientID {
+		return errors.New("public key doesn't match the client id")
+	}
+
+	var scheme = balances.GetSignatureScheme()
+	if err = scheme.SetPublicKey(c.PublicKey); err != nil {
+		return fmt.Errorf("invalid public key: %v", err)
+	}
+
+	var ok bool
+	ok, err = scheme.Verify(c.Signature, encryption.Hash(message))
+	if err != nil || !ok {
+		return errors.New("invalid consent signature")
+	}
+	return
+}
+
+type transferRequest struct {
+	PoolID   string  `json:"pool_id"`
+	NewOwner consent `json:"new_owner"`
+}
+
+func (tr *transferRequest) decode(b []byte) error {
+	return json.Unmarshal(b, tr)
+}
+
+type reassignRequest struct {
+	PoolID         string  `json:"pool_id"`
+	Destination    string  `json:"destination"`
+	NewDestination consent `json:"new_destination"`
+}
+
+func (rr *reassignRequest) decode(b []byte) error {
+	return json.Unmarshal(b, rr)
+}
+
 //
 // a destination
 //
@@ -73,6 +174,9 @@ type destination struct {
 	// can produce zero tokens transfer (resolution is a second). The move
 	// will be updated only if a triggering really moves tokens (non zero).
 	Move common.Timestamp `json:"move"`
+	// RevokedAt is time the pool owner revoked the destination. Tokens
+	// not vested by the time are returned to the owner.
+	RevokedAt common.Timestamp `json:"revoked_at,omitempty"`
 }
 
 // tokens left for this destination
@@ -105,12 +209,37 @@ func (d *destination) move(now common.Timestamp, moved currency.Coin) error {
 	return nil
 }
 
-// The unlock returns amount of tokens to vest for current period.
-// The dry argument leave all inside the destination as it was and
-// used to obtain pool statistic. The now must not be later than the
-// end. Also, the now must be greater or equal to start time of related
+// The unlock returns amount of tokens to vest for current period according
+// to the schedule. The dry argument leave all inside the destination as it
+// was and used to obtain pool statistic. The now must not be later than the
+// end. Also, the now must be greater or equal to the start time of related
 // vesting pool.
-func (d *destination) unlock(now, end common.Timestamp, dry bool) (
+func (d *destination) unlock(now, start, end common.Timestamp, s *schedule,
+	dry bool) (amount currency.Coin, err error) {
+
+	switch s.Type {
+	case CliffSchedule:
+		if now >= s.CliffAt {
+			amount, err = d.linear(now, end)
+		}
+	case StepSchedule:
+		amount, err = d.stepped(now, start, end, s.Step)
+	default:
+		amount, err = d.linear(now, end)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if !dry {
+		err = d.move(now, amount)
+	}
+
+	return
+}
+
+// linear returns amount of tokens vested since the last non-zero move
+func (d *destination) linear(now, end common.Timestamp) (
 	amount currency.Coin, err error) {
 
 	var (
@@ -130,16 +259,33 @@ func (d *destination) unlock(now, end common.Timestamp, dry bool) (
 		ratio = float64(period) / float64(full)
 	}
 
-	amount, err = currency.MultFloat64(left, ratio)
+	return currency.MultFloat64(left, ratio)
+}
+
+// stepped returns amount of tokens of all tranches released by now, but
+// not vested yet
+func (d *destination) stepped(now, start, end, step common.Timestamp) (
+	amount currency.Coin, err error) {
+
+	if now == end {
+		return d.left() // pool ending, should drain all
+	}
+
+	var (
+		total  = (end - start + step - 1) / step // number of tranches
+		passed = (now - start) / step            // tranches released
+	)
+
+	released, err := currency.MultFloat64(d.Amount,
+		float64(passed)/float64(total))
 	if err != nil {
 		return 0, err
 	}
 
-	if !dry {
-		err = d.move(now, amount)
+	if released <= d.Vested {
+		return 0, nil
 	}
-
-	return
+	return currency.MinusCoin(released, d.Vested)
 }
 
 //
@@ -151,9 +297,10 @@ type destinations []*destination
 // start sets start time (the Last and the Move)
 func (ds destinations) start(now common.Timestamp) {
 	for _, d := range ds {
-		d.Last = now // } setup start time
-		d.Move = now // }
-		d.Vested = 0 // clean possible request injection
+		d.Last = now    // } setup start time
+		d.Move = now    // }
+		d.Vested = 0    // } clean possible request injection
+		d.RevokedAt = 0 // }
 	}
 }
 
@@ -166,6 +313,12 @@ type addRequest struct {
 	StartTime    common.Timestamp `json:"start_time"`            //
 	Duration     time.Duration    `json:"duration"`              //
 	Destinations destinations     `json:"destinations"`          //
+	// Schedule of the vesting, linear by default. The Cliff is required by
+	// the cliff schedule and the Step is required by the step one.
+	Schedule  ScheduleType  `json:"schedule,omitempty"`
+	Cliff     time.Duration `json:"cliff,omitempty"`
+	Step      time.Duration `json:"step,omitempty"`
+	Revocable bool          `json:"revocable,omitempty"` // owner can revoke
 }
 
 func (ar *addRequest) decode(b []byte) error {
@@ -195,6 +348,36 @@ func (ar *addRequest) validate(now common.Timestamp, conf *config) (err error) {
 	case len(ar.Destinations) > conf.MaxDestinations:
 		return errors.New("too many destinations")
 	}
+	return ar.validateSchedule()
+}
+
+func (ar *addRequest) validateSchedule() (err error) {
+	switch ar.Schedule {
+	case LinearSchedule:
+		if ar.Cliff != 0 || ar.Step != 0 {
+			return errors.New("linear schedule has no cliff and step")
+		}
+	case CliffSchedule:
+		switch {
+		case ar.Step != 0:
+			return errors.New("cliff schedule has no step")
+		case toSeconds(ar.Cliff) < 1:
+			return errors.New("missing cliff")
+		case ar.Cliff > ar.Duration:
+			return errors.New("cliff is after the vesting end")
+		}
+	case StepSchedule:
+		switch {
+		case ar.Cliff != 0:
+			return errors.New("step schedule has no cliff")
+		case toSeconds(ar.Step) < 1:
+			return errors.New("missing step")
+		case ar.Step > ar.Duration:
+			return errors.New("step is longer than the vesting duration")
+		}
+	default:
+		return fmt.Errorf("unknown schedule type %d", ar.Schedule)
+	}
 	return
 }
 
@@ -214,6 +397,8 @@ type vestingPool struct {
 	ExpireAt     common.Timestamp `json:"expire_at"`    //
 	Destinations destinations     `json:"destinations"` //
 	ClientID     string           `json:"client_id"`    // the pool owner
+	Schedule     schedule         `json:"schedule"`     //
+	Revocable    bool             `json:"revocable"`    // owner can revoke
 }
 
 // newVestingPool returns new empty uninitialized vesting pool.
@@ -235,6 +420,12 @@ func newVestingPoolFromReqeust(clientID datastore.Key, ar *addRequest) (
 	vp.ExpireAt = ar.StartTime + toSeconds(ar.Duration)
 	vp.Destinations = ar.Destinations
 	vp.Destinations.start(vp.StartTime)
+	vp.Schedule.Type = ar.Schedule
+	if ar.Schedule == CliffSchedule {
+		vp.Schedule.CliffAt = ar.StartTime + toSeconds(ar.Cliff)
+	}
+	vp.Schedule.Step = toSeconds(ar.Step)
+	vp.Revocable = ar.Revocable
 	return
 }
 
@@ -348,7 +539,7 @@ func (vp *vestingPool) trigger(t *transaction.Transaction,
 	)
 	sb.WriteByte('[')
 	for _, d := range vp.Destinations {
-		value, err := d.unlock(now, end, false)
+		value, err := d.unlock(now, vp.StartTime, end, &vp.Schedule, false)
 		if err != nil {
 			return "", err
 		}
@@ -438,7 +629,7 @@ func (vp *vestingPool) vest(vscID, destID datastore.Key, now common.Timestamp,
 		return
 	}
 
-	value, err := d.unlock(now, end, false)
+	value, err := d.unlock(now, vp.StartTime, end, &vp.Schedule, false)
 	if err != nil {
 		return "", err
 	}
@@ -453,6 +644,46 @@ func (vp *vestingPool) vest(vscID, destID datastore.Key, now common.Timestamp,
 	return
 }
 
+// revoke vests tokens released by now for the destination and returns the
+// rest of its tokens to the pool owner, keeping the destination
+func (vp *vestingPool) revoke(t *transaction.Transaction, destID string,
+	balances chainstate.StateContextI) (err error) {
+
+	var d *destination
+	if d, err = vp.find(destID); err != nil {
+		return
+	}
+	if d.RevokedAt != 0 {
+		return fmt.Errorf("destination %s is already revoked", destID)
+	}
+
+	_, err = vp.vest(t.ToClientID, destID, t.CreationDate, balances)
+	if err != nil && err != errZeroVesting {
+		return
+	}
+
+	left, err := d.left()
+	if err != nil {
+		return err
+	}
+	d.Amount = d.Vested
+	d.RevokedAt = t.CreationDate
+
+	if left == 0 {
+		return
+	}
+
+	var transfer *state.Transfer
+	transfer, _, err = vp.DrainPool(t.ToClientID, vp.ClientID, left, nil)
+	if err != nil {
+		return fmt.Errorf("returning unvested tokens: %v", err)
+	}
+	if err = balances.AddTransfer(transfer); err != nil {
+		return fmt.Errorf("adding transfer vesting_pool->owner: %v", err)
+	}
+	return
+}
+
 func (vp *vestingPool) drain(t *transaction.Transaction,
 	balances chainstate.StateContextI) (resp string, err error) {
 
@@ -480,6 +711,19 @@ func (vp *vestingPool) drain(t *transaction.Transaction,
 	return
 }
 
+func (vp *vestingPool) emitTransfer(t *transaction.Transaction,
+	tt TransferType, from, to string, balances chainstate.StateContextI) {
+
+	balances.EmitEvent(event.TypeStats, event.TagAddVestingPoolTransfer, vp.ID,
+		[]event.VestingPoolTransfer{{
+			PoolID:       vp.ID,
+			TxnHash:      t.Hash,
+			Type:         int(tt),
+			FromClientID: from,
+			ToClientID:   to,
+		}})
+}
+
 // save the pool
 func (vp *vestingPool) save(balances chainstate.StateContextI) (err error) {
 	_, err = balances.InsertTrieNode(vp.ID, vp)
@@ -502,6 +746,8 @@ func (vp *vestingPool) info(now common.Timestamp) (i *info, err error) {
 	i.Description = vp.Description
 	i.StartTime = vp.StartTime
 	i.ExpireAt = vp.ExpireAt
+	i.Schedule = vp.Schedule
+	i.Revocable = vp.Revocable
 
 	var end = i.ExpireAt
 
@@ -515,16 +761,17 @@ func (vp *vestingPool) info(now common.Timestamp) (i *info, err error) {
 
 	var dinfos = make([]*destInfo, 0, len(vp.Destinations))
 	for _, d := range vp.Destinations {
-		value, err := d.unlock(now, end, true)
+		value, err := d.unlock(now, vp.StartTime, end, &vp.Schedule, true)
 		if err != nil {
 			return nil, err
 		}
 		dinfos = append(dinfos, &destInfo{
-			ID:     d.ID,
-			Wanted: d.Amount,
-			Earned: value,
-			Vested: d.Vested,
-			Last:   d.Last,
+			ID:        d.ID,
+			Wanted:    d.Amount,
+			Earned:    value,
+			Vested:    d.Vested,
+			Last:      d.Last,
+			RevokedAt: d.RevokedAt,
 		})
 	}
 
@@ -539,6 +786,8 @@ type destInfo struct {
 	Earned currency.Coin    `json:"earned"` // can unlock
 	Vested currency.Coin    `json:"vested"` // tokens already vested
 	Last   common.Timestamp `json:"last"`   // last time unlocked
+	// revocation time, zero if not revoked
+	RevokedAt common.Timestamp `json:"revoked_at,omitempty"`
 }
 
 // swagger:model vestingInfo
@@ -551,6 +800,8 @@ type info struct {
 	ExpireAt     common.Timestamp `json:"expire_at"`    // until
 	Destinations []*destInfo      `json:"destinations"` // receivers
 	ClientID     datastore.Key    `json:"client_id"`    // owner
+	Schedule     schedule         `json:"schedule"`     // how tokens are released
+	Revocable    bool             `json:"revocable"`    // owner can revoke
 }
 
 //
@@ -778,6 +1029,194 @@ func (vsc *VestingSmartContract) delete(t *transaction.Transaction,
 	return `{"pool_id":"` + vp.ID + `","action":"deleted"}`, nil
 }
 
+// revoke a destination of a revocable pool by its owner. Unlike the stop,
+// the unvested tokens of the destination are returned to the owner and the
+// destination is kept in the pool for history.
+func (vsc *VestingSmartContract) revoke(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var rr stopRequest
+	if err = rr.decode(input); err != nil {
+		return "", common.NewError("revoke_vesting_failed",
+			"malformed request: "+err.Error())
+	}
+
+	if rr.Destination == "" {
+		return "", common.NewError("revoke_vesting_failed",
+			"missing destination to revoke")
+	}
+
+	var vp *vestingPool
+	if vp, err = vsc.getPool(rr.PoolID, balances); err != nil {
+		return "", common.NewError("revoke_vesting_failed",
+			"can't get vesting pool: "+err.Error())
+	}
+
+	if vp.ClientID != t.ClientID {
+		return "", common.NewError("revoke_vesting_failed",
+			"only owner can revoke a vesting")
+	}
+
+	if !vp.Revocable {
+		return "", common.NewError("revoke_vesting_failed",
+			"vesting pool is not revocable")
+	}
+
+	if t.CreationDate > vp.ExpireAt {
+		return "", common.NewError("revoke_vesting_failed", "expired pool")
+	}
+
+	if err = vp.revoke(t, rr.Destination, balances); err != nil {
+		return "", common.NewError("revoke_vesting_failed", err.Error())
+	}
+
+	if err = vp.save(balances); err != nil {
+		return "", common.NewError("revoke_vesting_failed",
+			"saving pool: "+err.Error())
+	}
+
+	return rr.Destination + " has revoked from the vesting pool", nil
+}
+
+// transfer the pool ownership by its owner to a client agreed to it
+func (vsc *VestingSmartContract) transfer(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var tr transferRequest
+	if err = tr.decode(input); err != nil {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"malformed request: "+err.Error())
+	}
+
+	var vp *vestingPool
+	if vp, err = vsc.getPool(tr.PoolID, balances); err != nil {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"can't get pool: "+err.Error())
+	}
+
+	if vp.ClientID != t.ClientID {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"only owner can transfer the pool")
+	}
+
+	var newOwner = tr.NewOwner.ClientID
+	if newOwner == vp.ClientID {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"the client already owns the pool")
+	}
+
+	if _, err = vp.find(newOwner); err == nil {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"new owner is a destination of the pool")
+	}
+
+	err = tr.NewOwner.verify(ownerConsentMessage(vp.ID, vp.ClientID, newOwner),
+		balances)
+	if err != nil {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"new owner consent: "+err.Error())
+	}
+
+	// move the pool between the client pools lists
+	var cp *clientPools
+	if cp, err = vsc.getOrCreateClientPools(vp.ClientID, balances); err != nil {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"unexpected error: "+err.Error())
+	}
+
+	cp.remove(vp.ID)
+	if len(cp.Pools) == 0 {
+		_, err = balances.DeleteTrieNode(clientPoolsKey(vsc.ID, vp.ClientID))
+	} else {
+		err = cp.save(vsc.ID, vp.ClientID, balances)
+	}
+	if err != nil && err != util.ErrValueNotPresent {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"can't save client's pools list: "+err.Error())
+	}
+
+	if cp, err = vsc.getOrCreateClientPools(newOwner, balances); err != nil {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"unexpected error: "+err.Error())
+	}
+
+	cp.add(vp.ID)
+	if err = cp.save(vsc.ID, newOwner, balances); err != nil {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"can't save new owner's pools list: "+err.Error())
+	}
+
+	var from = vp.ClientID
+	vp.ClientID = newOwner
+	if err = vp.save(balances); err != nil {
+		return "", common.NewError("transfer_vesting_pool_failed",
+			"saving pool: "+err.Error())
+	}
+
+	vp.emitTransfer(t, OwnerTransfer, from, newOwner, balances)
+	return string(vp.Encode()), nil
+}
+
+// reassign a destination to another client agreed to it, keeping the
+// vesting history of the destination; by the pool owner or the destination
+func (vsc *VestingSmartContract) reassign(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var rr reassignRequest
+	if err = rr.decode(input); err != nil {
+		return "", common.NewError("reassign_vesting_failed",
+			"malformed request: "+err.Error())
+	}
+
+	if rr.Destination == "" {
+		return "", common.NewError("reassign_vesting_failed",
+			"missing destination to reassign")
+	}
+
+	var vp *vestingPool
+	if vp, err = vsc.getPool(rr.PoolID, balances); err != nil {
+		return "", common.NewError("reassign_vesting_failed",
+			"can't get vesting pool: "+err.Error())
+	}
+
+	if t.ClientID != vp.ClientID && t.ClientID != rr.Destination {
+		return "", common.NewError("reassign_vesting_failed",
+			"only owner or the destination can reassign it")
+	}
+
+	var d *destination
+	if d, err = vp.find(rr.Destination); err != nil {
+		return "", common.NewError("reassign_vesting_failed", err.Error())
+	}
+
+	var newDest = rr.NewDestination.ClientID
+	if newDest == vp.ClientID {
+		return "", common.NewError("reassign_vesting_failed",
+			"new destination is the pool owner")
+	}
+
+	if _, err = vp.find(newDest); err == nil {
+		return "", common.NewError("reassign_vesting_failed",
+			"new destination is already in the pool")
+	}
+
+	err = rr.NewDestination.verify(destinationConsentMessage(vp.ID, d.ID,
+		newDest), balances)
+	if err != nil {
+		return "", common.NewError("reassign_vesting_failed",
+			"new destination consent: "+err.Error())
+	}
+
+	d.ID = newDest
+	if err = vp.save(balances); err != nil {
+		return "", common.NewError("reassign_vesting_failed",
+			"saving pool: "+err.Error())
+	}
+
+	vp.emitTransfer(t, DestinationTransfer, rr.Destination, newDest, balances)
+	return rr.Destination + " has reassigned to " + newDest, nil
+}
+
 // unlock by owner, unlock by a destination
 func (vsc *VestingSmartContract) unlock(t *transaction.Transaction,
 	input []byte, balances chainstate.StateContextI) (resp string, err error) {
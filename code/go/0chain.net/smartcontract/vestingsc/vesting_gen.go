@@ -6,12 +6,40 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// MarshalMsg implements msgp.Marshaler
+func (z ScheduleType) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ScheduleType) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = ScheduleType(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z ScheduleType) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *destination) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 5
+	// map header, size 6
 	// string "ID"
-	o = append(o, 0x85, 0xa2, 0x49, 0x44)
+	o = append(o, 0x86, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "Amount"
 	o = append(o, 0xa6, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
@@ -41,6 +69,13 @@ func (z *destination) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Move")
 		return
 	}
+	// string "RevokedAt"
+	o = append(o, 0xa9, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74)
+	o, err = z.RevokedAt.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "RevokedAt")
+		return
+	}
 	return
 }
 
@@ -92,6 +127,12 @@ func (z *destination) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Move")
 				return
 			}
+		case "RevokedAt":
+			bts, err = z.RevokedAt.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RevokedAt")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -106,7 +147,7 @@ func (z *destination) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *destination) Msgsize() (s int) {
-	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 7 + z.Amount.Msgsize() + 7 + z.Vested.Msgsize() + 5 + z.Last.Msgsize() + 5 + z.Move.Msgsize()
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 7 + z.Amount.Msgsize() + 7 + z.Vested.Msgsize() + 5 + z.Last.Msgsize() + 5 + z.Move.Msgsize() + 10 + z.RevokedAt.Msgsize()
 	return
 }
 
@@ -228,6 +269,88 @@ func (z poolRequest) Msgsize() (s int) {
 	return
 }
 
+// MarshalMsg implements msgp.Marshaler
+func (z *schedule) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Type"
+	o = append(o, 0x83, 0xa4, 0x54, 0x79, 0x70, 0x65)
+	o = msgp.AppendInt(o, int(z.Type))
+	// string "CliffAt"
+	o = append(o, 0xa7, 0x43, 0x6c, 0x69, 0x66, 0x66, 0x41, 0x74)
+	o, err = z.CliffAt.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "CliffAt")
+		return
+	}
+	// string "Step"
+	o = append(o, 0xa4, 0x53, 0x74, 0x65, 0x70)
+	o, err = z.Step.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Step")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *schedule) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Type":
+			{
+				var zb0002 int
+				zb0002, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Type")
+					return
+				}
+				z.Type = ScheduleType(zb0002)
+			}
+		case "CliffAt":
+			bts, err = z.CliffAt.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CliffAt")
+				return
+			}
+		case "Step":
+			bts, err = z.Step.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Step")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *schedule) Msgsize() (s int) {
+	s = 1 + 5 + msgp.IntSize + 8 + z.CliffAt.Msgsize() + 5 + z.Step.Msgsize()
+	return
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z stopRequest) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
@@ -292,9 +415,9 @@ func (z stopRequest) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *vestingPool) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 8
 	// string "ZcnPool"
-	o = append(o, 0x86, 0xa7, 0x5a, 0x63, 0x6e, 0x50, 0x6f, 0x6f, 0x6c)
+	o = append(o, 0x88, 0xa7, 0x5a, 0x63, 0x6e, 0x50, 0x6f, 0x6f, 0x6c)
 	o, err = z.ZcnPool.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "ZcnPool")
@@ -334,6 +457,16 @@ func (z *vestingPool) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "ClientID"
 	o = append(o, 0xa8, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ClientID)
+	// string "Schedule"
+	o = append(o, 0xa8, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65)
+	o, err = z.Schedule.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Schedule")
+		return
+	}
+	// string "Revocable"
+	o = append(o, 0xa9, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x6c, 0x65)
+	o = msgp.AppendBool(o, z.Revocable)
 	return
 }
 
@@ -415,6 +548,18 @@ func (z *vestingPool) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "ClientID")
 				return
 			}
+		case "Schedule":
+			bts, err = z.Schedule.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Schedule")
+				return
+			}
+		case "Revocable":
+			z.Revocable, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Revocable")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -437,6 +582,6 @@ func (z *vestingPool) Msgsize() (s int) {
 			s += z.Destinations[za0001].Msgsize()
 		}
 	}
-	s += 9 + msgp.StringPrefixSize + len(z.ClientID)
+	s += 9 + msgp.StringPrefixSize + len(z.ClientID) + 9 + z.Schedule.Msgsize() + 10 + msgp.BoolSize
 	return
 }
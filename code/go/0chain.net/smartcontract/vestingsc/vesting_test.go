@@ -11,6 +11,8 @@ import (
 	"github.com/0chain/common/core/currency"
 
 	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
 	"github.com/0chain/common/core/util"
 	"github.com/stretchr/testify/mock"
 
@@ -101,6 +103,87 @@ func Test_addRequest_validate(t *testing.T) {
 	assert.NoError(t, ar.validate(10, conf))
 }
 
+func Test_addRequest_validateSchedule(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		ar   addRequest
+		err  string
+	}{
+		{"linear", addRequest{}, ""},
+		{"linear cliff", addRequest{Cliff: s(1)}, "linear schedule has no cliff and step"},
+		{"cliff", addRequest{Schedule: CliffSchedule, Cliff: s(30)}, ""},
+		{"cliff missing", addRequest{Schedule: CliffSchedule}, "missing cliff"},
+		{"cliff step", addRequest{Schedule: CliffSchedule, Cliff: s(30), Step: s(1)}, "cliff schedule has no step"},
+		{"cliff after end", addRequest{Schedule: CliffSchedule, Cliff: s(61)}, "cliff is after the vesting end"},
+		{"step", addRequest{Schedule: StepSchedule, Step: s(20)}, ""},
+		{"step missing", addRequest{Schedule: StepSchedule, Step: time.Millisecond}, "missing step"},
+		{"step cliff", addRequest{Schedule: StepSchedule, Step: s(20), Cliff: s(1)}, "step schedule has no cliff"},
+		{"step too long", addRequest{Schedule: StepSchedule, Step: s(61)}, "step is longer than the vesting duration"},
+		{"unknown", addRequest{Schedule: 5}, "unknown schedule type 5"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.ar.Duration = time.Minute
+			requireErrMsg(t, tt.ar.validateSchedule(), tt.err)
+		})
+	}
+}
+
+func Test_destination_unlock(t *testing.T) {
+	t.Run("cliff", func(t *testing.T) {
+		var (
+			sch = &schedule{Type: CliffSchedule, CliffAt: 60}
+			d   = &destination{ID: "one", Amount: 100, Last: 10, Move: 10}
+		)
+		value, err := d.unlock(59, 10, 110, sch, false)
+		require.NoError(t, err)
+		assert.Zero(t, value)
+		assert.Equal(t, common.Timestamp(59), d.Last)
+		assert.Equal(t, common.Timestamp(10), d.Move)
+
+		value, err = d.unlock(60, 10, 110, sch, false)
+		require.NoError(t, err)
+		assert.Equal(t, currency.Coin(50), value)
+
+		value, err = d.unlock(85, 10, 110, sch, false)
+		require.NoError(t, err)
+		assert.Equal(t, currency.Coin(25), value)
+
+		value, err = d.unlock(110, 10, 110, sch, false)
+		require.NoError(t, err)
+		assert.Equal(t, currency.Coin(25), value)
+		assert.Equal(t, d.Amount, d.Vested)
+	})
+
+	t.Run("step", func(t *testing.T) {
+		var (
+			sch = &schedule{Type: StepSchedule, Step: 30}
+			d   = &destination{ID: "one", Amount: 100}
+		)
+		for _, tt := range []struct {
+			now  common.Timestamp
+			want currency.Coin
+		}{
+			{29, 0}, {30, 25}, {59, 0}, {65, 25}, {95, 25}, {100, 25},
+		} {
+			value, err := d.unlock(tt.now, 0, 100, sch, false)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, value, "at %d", tt.now)
+		}
+		assert.Equal(t, d.Amount, d.Vested)
+	})
+
+	t.Run("step dry", func(t *testing.T) {
+		var (
+			sch = &schedule{Type: StepSchedule, Step: 30}
+			d   = &destination{ID: "one", Amount: 100}
+		)
+		value, err := d.unlock(65, 0, 100, sch, true)
+		require.NoError(t, err)
+		assert.Equal(t, currency.Coin(50), value)
+		assert.Zero(t, d.Vested)
+	})
+}
+
 func Test_vestingPool(t *testing.T) {
 	const poolID, clientID = "pool_hex", "client_hex"
 	require.NotZero(t, poolKey(ADDRESS, poolID))
@@ -384,6 +467,266 @@ func TestVestingSmartContract_stop(t *testing.T) {
 
 }
 
+func TestVestingSmartContract_revoke(t *testing.T) {
+	var (
+		vsc      = newTestVestingSC()
+		balances = newTestBalances()
+		client   = newClient(1200e10, balances)
+		tp       = common.Timestamp(0)
+		tx       = newTransaction(client.id, vsc.ID, 0, tp)
+		rr       stopRequest
+		err      = InitConfig(balances)
+	)
+	require.NoError(t, err)
+
+	balances.txn = tx
+	configureConfig()
+
+	ar := &addRequest{
+		Description: "for something",
+		StartTime:   10,
+		Duration:    10 * time.Second,
+		Destinations: destinations{
+			&destination{ID: "one", Amount: 2000},
+			&destination{ID: "two", Amount: 4000},
+		},
+	}
+
+	// 1. not revocable
+	resp, err := client.add(t, vsc, ar, 100e10, tp, balances)
+	require.NoError(t, err)
+	var set vestingPool
+	require.NoError(t, set.Decode([]byte(resp)))
+	rr.PoolID = set.ID
+	rr.Destination = "one"
+
+	tx = newTransaction(client.id, vsc.ID, 0, 15)
+	balances.txn = tx
+	_, err = vsc.revoke(tx, mustEncode(t, &rr), balances)
+	requireErrMsg(t, err, "revoke_vesting_failed: vesting pool is not revocable")
+
+	// 2. another client
+	ar.Revocable = true
+	resp, err = client.add(t, vsc, ar, 100e10, tp, balances)
+	require.NoError(t, err)
+	require.NoError(t, set.Decode([]byte(resp)))
+	rr.PoolID = set.ID
+
+	tx = newTransaction("another_one", vsc.ID, 0, 15)
+	balances.txn = tx
+	_, err = vsc.revoke(tx, mustEncode(t, &rr), balances)
+	requireErrMsg(t, err, "revoke_vesting_failed: only owner can revoke a vesting")
+
+	// 3. revoke
+	tx = newTransaction(client.id, vsc.ID, 0, 15)
+	balances.txn = tx
+	var ownerBalance = balances.balances[client.id]
+	_, err = vsc.revoke(tx, mustEncode(t, &rr), balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(1000), balances.balances["one"])
+	assert.Equal(t, ownerBalance+1000, balances.balances[client.id])
+
+	var got *vestingPool
+	got, err = vsc.getPool(set.ID, balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(100e10-2000), got.Balance)
+	require.Len(t, got.Destinations, 2)
+	assert.Equal(t, currency.Coin(1000), got.Destinations[0].Amount)
+	assert.Equal(t, common.Timestamp(15), got.Destinations[0].RevokedAt)
+
+	// 4. already revoked
+	_, err = vsc.revoke(tx, mustEncode(t, &rr), balances)
+	requireErrMsg(t, err, "revoke_vesting_failed: destination one is already revoked")
+
+	// 5. the revoked destination doesn't vest anymore
+	inf, err := got.info(20)
+	require.NoError(t, err)
+	assert.Equal(t, &destInfo{ID: "one", Wanted: 1000, Vested: 1000, Last: 15,
+		RevokedAt: 15}, inf.Destinations[0])
+	assert.Equal(t, currency.Coin(4000), inf.Destinations[1].Earned)
+	assert.True(t, inf.Revocable)
+}
+
+func (c *Client) consent(t *testing.T, message string) consent {
+	signature, err := c.scheme.Sign(encryption.Hash(message))
+	require.NoError(t, err)
+	return consent{ClientID: c.id, PublicKey: c.pk, Signature: signature}
+}
+
+func TestVestingSmartContract_transfer(t *testing.T) {
+	var (
+		vsc      = newTestVestingSC()
+		balances = newTestBalances()
+		client   = newClient(1200e10, balances)
+		newOwner = newClient(0, balances)
+		other    = newClient(0, balances)
+		tx       = newTransaction(client.id, vsc.ID, 0, 0)
+		tr       transferRequest
+		err      = InitConfig(balances)
+	)
+	require.NoError(t, err)
+
+	balances.txn = tx
+	configureConfig()
+
+	// 1. malformed
+	_, err = vsc.transfer(tx, []byte("} malformed {"), balances)
+	requireErrMsg(t, err, "transfer_vesting_pool_failed: malformed request:"+
+		" invalid character '}' looking for beginning of value")
+
+	resp, err := client.add(t, vsc, &addRequest{
+		Description: "for something",
+		StartTime:   10,
+		Duration:    10 * time.Second,
+		Destinations: destinations{
+			&destination{ID: "one", Amount: 2000},
+		},
+	}, 100e10, 0, balances)
+	require.NoError(t, err)
+	var set vestingPool
+	require.NoError(t, set.Decode([]byte(resp)))
+	tr.PoolID = set.ID
+	tr.NewOwner = newOwner.consent(t,
+		ownerConsentMessage(set.ID, client.id, newOwner.id))
+
+	// 2. not owner
+	tx = newTransaction(other.id, vsc.ID, 0, 15)
+	balances.txn = tx
+	_, err = vsc.transfer(tx, mustEncode(t, &tr), balances)
+	requireErrMsg(t, err, "transfer_vesting_pool_failed: "+
+		"only owner can transfer the pool")
+
+	// 3. consent signed by another client
+	tx = newTransaction(client.id, vsc.ID, 0, 15)
+	balances.txn = tx
+	var forged = tr
+	forged.NewOwner = other.consent(t,
+		ownerConsentMessage(set.ID, client.id, newOwner.id))
+	forged.NewOwner.ClientID = newOwner.id
+	_, err = vsc.transfer(tx, mustEncode(t, &forged), balances)
+	requireErrMsg(t, err, "transfer_vesting_pool_failed: "+
+		"new owner consent: public key doesn't match the client id")
+	forged.NewOwner = newOwner.consent(t,
+		ownerConsentMessage(set.ID, client.id, other.id))
+	_, err = vsc.transfer(tx, mustEncode(t, &forged), balances)
+	requireErrMsg(t, err, "transfer_vesting_pool_failed: "+
+		"new owner consent: invalid consent signature")
+
+	// 4. transfer
+	_, err = vsc.transfer(tx, mustEncode(t, &tr), balances)
+	require.NoError(t, err)
+
+	got, err := vsc.getPool(set.ID, balances)
+	require.NoError(t, err)
+	assert.Equal(t, newOwner.id, got.ClientID)
+	assert.Zero(t, balances.tree[clientPoolsKey(vsc.ID, client.id)])
+	cp, err := vsc.getClientPools(newOwner.id, balances)
+	require.NoError(t, err)
+	assert.Equal(t, []string{set.ID}, cp.Pools)
+
+	require.NotEmpty(t, balances.events)
+	e := balances.events[len(balances.events)-1]
+	assert.Equal(t, event.TagAddVestingPoolTransfer, e.Tag)
+	assert.Equal(t, []event.VestingPoolTransfer{{
+		PoolID:       set.ID,
+		TxnHash:      tx.Hash,
+		Type:         int(OwnerTransfer),
+		FromClientID: client.id,
+		ToClientID:   newOwner.id,
+	}}, e.Data)
+
+	// 5. the old owner can't use the signature again
+	_, err = vsc.transfer(tx, mustEncode(t, &tr), balances)
+	requireErrMsg(t, err, "transfer_vesting_pool_failed: "+
+		"only owner can transfer the pool")
+}
+
+func TestVestingSmartContract_reassign(t *testing.T) {
+	var (
+		vsc      = newTestVestingSC()
+		balances = newTestBalances()
+		client   = newClient(1200e10, balances)
+		dest     = newClient(0, balances)
+		newDest  = newClient(0, balances)
+		tx       = newTransaction(client.id, vsc.ID, 0, 0)
+		rr       reassignRequest
+		err      = InitConfig(balances)
+	)
+	require.NoError(t, err)
+
+	balances.txn = tx
+	configureConfig()
+
+	resp, err := client.add(t, vsc, &addRequest{
+		Description: "for something",
+		StartTime:   10,
+		Duration:    10 * time.Second,
+		Destinations: destinations{
+			&destination{ID: dest.id, Amount: 2000},
+			&destination{ID: "two", Amount: 4000},
+		},
+	}, 100e10, 0, balances)
+	require.NoError(t, err)
+	var set vestingPool
+	require.NoError(t, set.Decode([]byte(resp)))
+
+	// vest a half by the destination
+	tx = newTransaction(dest.id, vsc.ID, 0, 15)
+	balances.txn = tx
+	_, err = vsc.unlock(tx, mustEncode(t, &poolRequest{PoolID: set.ID}), balances)
+	require.NoError(t, err)
+
+	rr.PoolID = set.ID
+	rr.Destination = dest.id
+	rr.NewDestination = newDest.consent(t,
+		destinationConsentMessage(set.ID, dest.id, newDest.id))
+
+	// 1. neither owner, nor the destination
+	tx = newTransaction(newDest.id, vsc.ID, 0, 16)
+	balances.txn = tx
+	_, err = vsc.reassign(tx, mustEncode(t, &rr), balances)
+	requireErrMsg(t, err, "reassign_vesting_failed: "+
+		"only owner or the destination can reassign it")
+
+	// 2. already in the pool
+	tx = newTransaction(dest.id, vsc.ID, 0, 16)
+	balances.txn = tx
+	var dup = rr
+	dup.NewDestination.ClientID = "two"
+	_, err = vsc.reassign(tx, mustEncode(t, &dup), balances)
+	requireErrMsg(t, err, "reassign_vesting_failed: "+
+		"new destination is already in the pool")
+
+	// 3. reassign by the destination
+	before, err := vsc.getPool(set.ID, balances)
+	require.NoError(t, err)
+	_, err = vsc.reassign(tx, mustEncode(t, &rr), balances)
+	require.NoError(t, err)
+
+	got, err := vsc.getPool(set.ID, balances)
+	require.NoError(t, err)
+	_, err = got.find(dest.id)
+	require.Error(t, err)
+	d, err := got.find(newDest.id)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(1000), d.Vested)
+	assert.Equal(t, before.Destinations[0].Last, d.Last)
+	assert.Equal(t, before.Destinations[0].Move, d.Move)
+
+	e := balances.events[len(balances.events)-1]
+	assert.Equal(t, event.TagAddVestingPoolTransfer, e.Tag)
+	assert.Equal(t, int(DestinationTransfer),
+		e.Data.([]event.VestingPoolTransfer)[0].Type)
+
+	// 4. the new destination vests the rest
+	tx = newTransaction(newDest.id, vsc.ID, 0, 20)
+	balances.txn = tx
+	_, err = vsc.unlock(tx, mustEncode(t, &poolRequest{PoolID: set.ID}), balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(1000), balances.balances[dest.id])
+	assert.Equal(t, currency.Coin(1000), balances.balances[newDest.id])
+}
+
 func TestVestingSmartContract_unlock(t *testing.T) {
 	var (
 		vsc      = newTestVestingSC()
@@ -555,4 +898,23 @@ func TestVestingSmartContract_getPoolInfoHandler(t *testing.T) {
 	resp, err = vsc.getPoolInfoHandler(ctx, params, balances)
 	require.NoError(t, err)
 	require.IsType(t, &info{}, resp)
+	assert.Equal(t, schedule{}, resp.(*info).Schedule)
+
+	set, err = client.add(t, vsc, &addRequest{
+		Description: "for something",
+		StartTime:   10,
+		Duration:    10 * time.Second,
+		Destinations: destinations{
+			&destination{ID: "one", Amount: 10},
+		},
+		Schedule: StepSchedule,
+		Step:     4 * time.Second,
+	}, 100e10, 0, balances)
+	require.NoError(t, err)
+	require.NoError(t, deco.Decode([]byte(set)))
+
+	params.Set("pool_id", deco.ID)
+	resp, err = vsc.getPoolInfoHandler(ctx, params, balances)
+	require.NoError(t, err)
+	assert.Equal(t, schedule{Type: StepSchedule, Step: 4}, resp.(*info).Schedule)
 }
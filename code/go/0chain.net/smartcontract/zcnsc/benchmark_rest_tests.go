@@ -27,6 +27,13 @@ func BenchmarkRestTests(data benchmark.BenchData, _ benchmark.SignatureScheme) b
 				},
 				Endpoint: zrh.getAuthorizer,
 			},
+			{
+				FuncName: "getUserUnbondingPools",
+				Params: map[string]string{
+					"client_id": data.Clients[mockUnbondingDelegate],
+				},
+				Endpoint: zrh.getUserUnbondingPools,
+			},
 			{
 				FuncName: "mint_nonce",
 				Params: map[string]string{
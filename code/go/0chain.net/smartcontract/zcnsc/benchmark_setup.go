@@ -11,6 +11,7 @@ import (
 	"0chain.net/core/encryption"
 	"0chain.net/smartcontract/benchmark/main/cmd/log"
 	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/governance"
 	"0chain.net/smartcontract/stakepool"
 	"0chain.net/smartcontract/stakepool/spenum"
 
@@ -31,6 +32,7 @@ func Setup(eventDb *event.EventDb, clients, publicKeys []string, balances cstate
 	addMockUserNodes(clients, balances)
 	addMockAuthorizers(eventDb, clients, publicKeys, balances)
 	addMockStakePools(clients, balances)
+	addMockGovernanceProposals(eventDb, clients, balances)
 }
 
 func addMockGlobalNode(balances cstate.StateContextI) {
@@ -66,6 +68,10 @@ func addMockGlobalNode(balances cstate.StateContextI) {
 	gn.BurnAddress = config.SmartContractConfig.GetString(benchmark.ZcnBurnAddress)
 	gn.MaxDelegates = viper.GetInt(benchmark.ZcnMaxDelegates)
 	gn.HealthCheckPeriod = viper.GetDuration(benchmark.HealthCheckPeriod)
+	gn.Governance, err = governance.ReadConfig(benchmark.SmartContract + benchmark.ZcnSc)
+	if err != nil {
+		panic(err)
+	}
 	_, err = balances.InsertTrieNode(gn.GetKey(), gn)
 	if err != nil {
 		log.Fatal(err)
@@ -109,6 +115,9 @@ func addMockAuthorizers(eventDb *event.EventDb, clients, publicKeys []string, ct
 	}
 }
 
+// mockUnbondingDelegate of the first authorizer is released to be claimed
+const mockUnbondingDelegate = 1
+
 func addMockStakePools(clients []string, ctx cstate.StateContextI) {
 	numAuthorizers := viper.GetInt(benchmark.NumAuthorizers)
 	numDelegates := viper.GetInt(benchmark.ZcnMaxDelegates) - 1
@@ -118,6 +127,11 @@ func addMockStakePools(clients []string, ctx cstate.StateContextI) {
 		for j := 0; j < numDelegates; j++ {
 			sp.Pools[clients[j]] = getMockDelegatePool(clients[j])
 		}
+		if dp, ok := sp.Pools[clients[mockUnbondingDelegate]]; ok && i == 0 {
+			// released, to claim
+			dp.Status = spenum.Unbonding
+			dp.ReleaseRound = 1
+		}
 		sp.Reward = 11
 		sp.Minter = cstate.MinterZcn
 		_, err := ctx.InsertTrieNode(stakepool.StakePoolKey(spenum.Authorizer, clients[i]), sp)
@@ -128,6 +142,15 @@ func addMockStakePools(clients []string, ctx cstate.StateContextI) {
 	}
 }
 
+// addMockGovernanceProposals adds the open proposal to vote on and the one
+// voted by the first authorizer delegate to execute
+func addMockGovernanceProposals(eventDb *event.EventDb, clients []string, balances cstate.StateContextI) {
+	governance.AddMockProposals(ADDRESS, clients[0], clients[0], getMockDelegatePool(clients[0]).Balance,
+		[]governance.StakeRef{{ProviderType: spenum.Authorizer, ProviderID: clients[0]}},
+		config.StringMap{Fields: map[string]string{MinAuthorizers: "2"}},
+		eventDb, balances)
+}
+
 func addMockUserNodes(clients []string, balances cstate.StateContextI) {
 	for _, clientId := range clients {
 		un := NewUserNode(clientId)
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	config2 "0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"0chain.net/smartcontract/provider"
 
 	"github.com/0chain/common/core/currency"
@@ -179,6 +180,71 @@ func BenchmarkTests(data benchmark.BenchData, scheme benchmark.SignatureScheme)
 					ProviderType: spenum.Authorizer,
 				}).Encode(),
 			},
+			{
+				name:     benchmark.ZcnSc + ClaimUnbondedDelegatePoolFunc,
+				endpoint: sc.ClaimUnbondedDelegatePool,
+				txn:      createTransaction(data.Clients[mockUnbondingDelegate], data.PublicKeys[mockUnbondingDelegate], 0),
+				input: (&stakepool.StakePoolRequest{
+					ProviderID:   data.Clients[0],
+					ProviderType: spenum.Authorizer,
+				}).Encode(),
+			},
+			{
+				name:     benchmark.ZcnSc + RedelegatePoolFunc,
+				endpoint: sc.RedelegatePool,
+				txn:      createTransaction(data.Clients[0], data.PublicKeys[0], 0),
+				input: (&stakepool.RedelegateRequest{
+					ProviderType:   spenum.Authorizer,
+					ProviderID:     data.Clients[0],
+					ToProviderType: spenum.Authorizer,
+					ToProviderID:   data.Clients[1],
+				}).Encode(),
+			},
+			{
+				name:     benchmark.ZcnSc + AutoCompoundDelegatePoolFunc,
+				endpoint: sc.AutoCompoundDelegatePool,
+				txn:      createTransaction(data.Clients[0], data.PublicKeys[0], 0),
+				input: (&stakepool.AutoCompoundRequest{
+					ProviderType: spenum.Authorizer,
+					ProviderID:   data.Clients[0],
+					AutoCompound: true,
+				}).Encode(),
+			},
+			{
+				name:     benchmark.ZcnSc + GovernanceProposeFunc,
+				endpoint: sc.GovernancePropose,
+				txn:      createTransaction(data.Clients[0], data.PublicKeys[0], 0),
+				input: (&governance.ProposeRequest{
+					Changes: config2.StringMap{
+						Fields: map[string]string{MinAuthorizers: "3"},
+					},
+					Stakes: []governance.StakeRef{{
+						ProviderType: spenum.Authorizer,
+						ProviderID:   data.Clients[0],
+					}},
+				}).Encode(),
+			},
+			{
+				name:     benchmark.ZcnSc + GovernanceVoteFunc,
+				endpoint: sc.GovernanceVote,
+				txn:      createTransaction(data.Clients[0], data.PublicKeys[0], 0),
+				input: (&governance.VoteRequest{
+					ProposalID: governance.GetMockProposalId(ADDRESS, 0),
+					Approve:    true,
+					Stakes: []governance.StakeRef{{
+						ProviderType: spenum.Authorizer,
+						ProviderID:   data.Clients[0],
+					}},
+				}).Encode(),
+			},
+			{
+				name:     benchmark.ZcnSc + GovernanceExecuteFunc,
+				endpoint: sc.GovernanceExecute,
+				txn:      createTransaction(data.Clients[1], data.PublicKeys[1], 0),
+				input: (&governance.ExecuteRequest{
+					ProposalID: governance.GetMockProposalId(ADDRESS, 1),
+				}).Encode(),
+			},
 		},
 	)
 }
@@ -6,6 +6,7 @@ import (
 
 	"0chain.net/chaincore/chain/state"
 	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/util"
 
@@ -36,6 +37,7 @@ const (
 	Cost                = "cost"
 	MaxDelegates        = "max_delegates"
 	HealthCheckPeriod   = "health_check_period"
+	UnbondingRounds     = "unbonding_rounds"
 )
 
 var CostFunctions = []string{
@@ -87,6 +89,10 @@ func (zcn *ZCNSmartContract) UpdateGlobalConfig(t *transaction.Transaction, inpu
 		return "", errors.Wrap(err, Code)
 	}
 
+	if err := governance.CheckOwnerChanges(input); err != nil {
+		return "", errors.Wrap(err, Code)
+	}
+
 	if err := gn.UpdateConfig(&input); err != nil {
 		return "", errors.Wrap(err, Code)
 	}
@@ -118,11 +124,15 @@ func (gn *GlobalNode) ToStringMap() config.StringMap {
 		OwnerID:             fmt.Sprintf("%v", gn.OwnerId),
 		MaxDelegates:        fmt.Sprintf("%v", gn.MaxDelegates),
 		HealthCheckPeriod:   fmt.Sprintf("%v", gn.HealthCheckPeriod),
+		UnbondingRounds:     fmt.Sprintf("%v", gn.UnbondingRounds),
 	}
 
 	for _, key := range CostFunctions {
 		fields[fmt.Sprintf("cost.%s", key)] = fmt.Sprintf("%0v", gn.Cost[strings.ToLower(key)])
 	}
+	for key, value := range gn.Governance.Fields() {
+		fields[key] = value
+	}
 
 	return config.StringMap{
 		Fields: fields,
@@ -167,6 +177,11 @@ func getConfig() (conf *ZCNSConfig, err error) {
 	conf.Cost = cfg.GetStringMapInt(postfix(Cost))
 	conf.MaxDelegates = cfg.GetInt(postfix(MaxDelegates))
 	conf.HealthCheckPeriod = cfg.GetDuration(postfix(HealthCheckPeriod))
+	conf.UnbondingRounds = cfg.GetInt64(postfix(UnbondingRounds))
+	conf.Governance, err = governance.ReadConfig(postfix(""))
+	if err != nil {
+		return nil, err
+	}
 
 	return conf, nil
 }
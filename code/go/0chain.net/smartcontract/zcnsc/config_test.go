@@ -5,6 +5,8 @@ import (
 	"strings"
 	"testing"
 
+	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	. "0chain.net/smartcontract/zcnsc"
 
 	"github.com/stretchr/testify/require"
@@ -33,7 +35,7 @@ func TestConfigMap_Get(t *testing.T) {
 
 	stringMap := cfg.ToStringMap()
 
-	require.Equal(t, 17, len(stringMap.Fields))
+	require.Equal(t, 25, len(stringMap.Fields))
 	require.Contains(t, stringMap.Fields, OwnerID)
 	require.Contains(t, stringMap.Fields, MinBurnAmount)
 	require.Contains(t, stringMap.Fields, MinMintAmount)
@@ -51,6 +53,9 @@ func TestConfigMap_Get(t *testing.T) {
 	for _, costFunction := range CostFunctions {
 		require.Contains(t, stringMap.Fields, fmt.Sprintf("%s.%s", Cost, costFunction))
 	}
+	for key := range cfg.Governance.Fields() {
+		require.Contains(t, stringMap.Fields, key)
+	}
 
 	require.Equal(t, fmt.Sprintf("%v", cfg.OwnerId), stringMap.Fields[OwnerID])
 	require.Equal(t, fmt.Sprintf("%v", cfg.MinBurnAmount), stringMap.Fields[MinBurnAmount])
@@ -72,3 +77,20 @@ func TestConfigMap_Get(t *testing.T) {
 		require.Equal(t, fmt.Sprintf("%d", cfg.Cost[strings.ToLower(costFunction)]), stringMap.Fields[fmt.Sprintf("%s.%s", Cost, costFunction)])
 	}
 }
+
+func TestGlobalNode_UpdateGovernance(t *testing.T) {
+	gn := &GlobalNode{ZCNSConfig: &ZCNSConfig{}}
+
+	err := gn.UpdateConfig(&config.StringMap{Fields: map[string]string{
+		governance.Prefix + governance.Quorum:    "5",
+		governance.Prefix + governance.MaxVoters: "3",
+	}})
+	require.NoError(t, err)
+	require.EqualValues(t, 5e10, gn.Governance.Quorum)
+	require.Equal(t, 3, gn.Governance.MaxVoters)
+
+	err = gn.UpdateConfig(&config.StringMap{Fields: map[string]string{
+		governance.Prefix + "unknown": "1",
+	}})
+	require.EqualError(t, err, "unknown governance setting unknown")
+}
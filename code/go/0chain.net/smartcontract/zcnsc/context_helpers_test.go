@@ -365,7 +365,7 @@ func MakeMockStateContextWithoutAutorizers() *mockStateContext {
 
 func createTestStakingPools(ctx *mockStateContext, delegateID string) *StakePool {
 	sp := NewStakePool()
-	sp.Minter = cstate.MinterStorage
+	sp.Minter = cstate.MinterZcn
 	sp.Settings.DelegateWallet = delegateID
 
 	ctx.stakingPools[sp.GetKey()] = sp
@@ -0,0 +1,84 @@
+package zcnsc
+
+import (
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+const (
+	GovernanceProposeFunc = "governance-propose"
+	GovernanceVoteFunc    = "governance-vote"
+	GovernanceExecuteFunc = "governance-execute"
+)
+
+func init() {
+	governance.RegisterStakePools(spenum.Authorizer,
+		func(providerID string, balances cstate.CommonStateContextI) (stakepool.AbstractStakePool, error) {
+			sp := NewStakePool()
+			if err := balances.GetTrieNode(stakepool.StakePoolKey(spenum.Authorizer, providerID), sp); err != nil {
+				return nil, err
+			}
+			return sp, nil
+		})
+}
+
+// governanceSettings are the zcnsc settings changed by the governance, the
+// same way UpdateGlobalConfig does
+type governanceSettings struct{}
+
+func (governanceSettings) Config(balances cstate.StateContextI) (*governance.Config, error) {
+	gn, err := GetGlobalNode(balances)
+	if err != nil {
+		return nil, err
+	}
+	return &gn.Governance, nil
+}
+
+func (governanceSettings) update(changes config.StringMap, balances cstate.StateContextI) (*GlobalNode, error) {
+	gn, err := GetGlobalNode(balances)
+	if err != nil {
+		return nil, err
+	}
+	if err := gn.UpdateConfig(&changes); err != nil {
+		return nil, err
+	}
+	if err := gn.Validate(); err != nil {
+		return nil, err
+	}
+	return gn, nil
+}
+
+func (s governanceSettings) Validate(changes config.StringMap, balances cstate.StateContextI) error {
+	_, err := s.update(changes, balances)
+	return err
+}
+
+func (s governanceSettings) Apply(changes config.StringMap, balances cstate.StateContextI) error {
+	gn, err := s.update(changes, balances)
+	if err != nil {
+		return err
+	}
+	return gn.Save(balances)
+}
+
+// GovernancePropose submits the proposal of the zcnsc settings changes, the
+// proposer is a council member or a staker
+func (zcn *ZCNSmartContract) GovernancePropose(t *transaction.Transaction, input []byte, ctx cstate.StateContextI) (string, error) {
+	return governance.Propose(t, input, zcn.ID, governanceSettings{}, ctx)
+}
+
+// GovernanceVote on the open proposal with the stake of the voter, a vote
+// again replaces the former one
+func (zcn *ZCNSmartContract) GovernanceVote(t *transaction.Transaction, input []byte, ctx cstate.StateContextI) (string, error) {
+	return governance.CastVote(t, input, zcn.ID, governanceSettings{}, ctx)
+}
+
+// GovernanceExecute closes the proposal after the voting, the passed one is
+// applied to the settings after the timelock. Anyone can do it.
+func (zcn *ZCNSmartContract) GovernanceExecute(t *transaction.Transaction, input []byte, ctx cstate.StateContextI) (string, error) {
+	return governance.Execute(t, input, zcn.ID, governanceSettings{}, ctx)
+}
@@ -5,7 +5,9 @@ import (
 	"sort"
 	"strconv"
 
+	common2 "0chain.net/smartcontract/common"
 	"0chain.net/smartcontract/rest"
+	"0chain.net/smartcontract/stakepool/spenum"
 
 	"github.com/0chain/common/core/currency"
 
@@ -35,6 +37,7 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		{URI: zcn + "/getAuthorizerNodes", Handler: common.UserRateLimit(zrh.getAuthorizerNodes)},
 		{URI: zcn + "/getGlobalConfig", Handler: common.UserRateLimit(zrh.GetGlobalConfig)},
 		{URI: zcn + "/getAuthorizer", Handler: common.UserRateLimit(zrh.getAuthorizer)},
+		{URI: zcn + "/getUserUnbondingPools", Handler: common.UserRateLimit(zrh.getUserUnbondingPools)},
 		{URI: zcn + "/v1/mint_nonce", Handler: common.UserRateLimit(zrh.MintNonceHandler)},
 		{URI: zcn + "/v1/not_processed_burn_tickets", Handler: common.UserRateLimit(zrh.NotProcessedBurnTicketsHandler)},
 	}
@@ -119,6 +122,62 @@ func (zrh *ZcnRestHandler) getAuthorizer(w http.ResponseWriter, r *http.Request)
 	common.Respond(w, r, rtv, nil)
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e0/getUserUnbondingPools getZCNUserUnbondingPools
+// get the pending withdrawals of a user from the authorizers stake pools
+// with the rounds they can be claimed from
+//
+// parameters:
+//
+//	+name: client_id
+//	 description: client for which to get the unbonding pools
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: sort
+//	 description: desc or asc
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []DelegatePool
+//	400:
+//	500:
+func (zrh *ZcnRestHandler) getUserUnbondingPools(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		common.Respond(w, r, nil, common.NewErrBadRequest("missing client_id"))
+		return
+	}
+
+	pagination, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := zrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	pools, err := edb.GetUserUnbondingPools(clientID, []spenum.Provider{spenum.Authorizer}, pagination)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get unbonding pools", err.Error()))
+		return
+	}
+
+	common.Respond(w, r, pools, nil)
+}
+
 // MintNonceHandler returns the latest mint nonce for the client with the help of the given client id
 func (zrh *ZcnRestHandler) MintNonceHandler(w http.ResponseWriter, r *http.Request) {
 	edb := zrh.GetQueryStateContext().GetEventDB()
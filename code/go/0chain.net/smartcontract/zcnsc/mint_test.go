@@ -365,6 +365,77 @@ func Test_CheckAuthorizerStakePoolDistributedRewards(t *testing.T) {
 	require.NotEqual(t, rewardAfter, rewardBefore)
 }
 
+func Test_MintCompoundsAuthorizerDelegateRewards(t *testing.T) {
+	ctx := MakeMockStateContext()
+
+	tr := CreateDefaultTransactionToZcnsc()
+	eventDb, err := event.NewInMemoryEventDb(config.DbAccess{}, config.DbSettings{
+		Debug:                 true,
+		PartitionChangePeriod: 1,
+	})
+	require.NoError(t, err)
+
+	err = eventDb.Get().Model(&event.User{}).Create(&event.User{
+		UserID:    tr.ClientID,
+		MintNonce: 0,
+	}).Error
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = eventDb.Drop()
+		require.NoError(t, err)
+
+		eventDb.Close()
+	})
+
+	ctx.SetEventDb(eventDb)
+
+	payload, err := CreateMintPayload(ctx, defaultClient)
+	require.NoError(t, err)
+	payload.Nonce = 1
+
+	gn, err := GetGlobalNode(ctx)
+	require.NoError(t, err)
+	gn.ZCNSConfig.MaxFee = 100
+	err = gn.Save(ctx)
+	require.NoError(t, err)
+
+	rand.Seed(ctx.GetBlock().GetRoundRandomSeed())
+	sig := payload.Signatures[rand.Intn(len(payload.Signatures))]
+
+	key := stakepool.StakePoolKey(spenum.Authorizer, sig.ID)
+	sp := NewStakePool()
+	err = ctx.GetTrieNode(key, sp)
+	require.NoError(t, err)
+	sp.Pools["delegate"] = &stakepool.DelegatePool{
+		Balance:      10,
+		Status:       spenum.Active,
+		DelegateID:   "delegate",
+		AutoCompound: true,
+	}
+	_, err = ctx.InsertTrieNode(key, sp)
+	require.NoError(t, err)
+
+	_, err = CreateZCNSmartContract().Mint(tr, payload.Encode(), ctx)
+	require.NoError(t, err)
+
+	sp = NewStakePool()
+	err = ctx.GetTrieNode(key, sp)
+	require.NoError(t, err)
+	compounded := sp.Pools["delegate"].Balance - 10
+	require.NotZero(t, compounded)
+
+	// the compounded rewards are minted to the zcnsc, by the zcnsc
+	var minted currency.Coin
+	for _, m := range ctx.GetMints() {
+		require.Equal(t, tr.ToClientID, m.Minter)
+		if m.ToClientID == ADDRESS {
+			minted += m.Amount
+		}
+	}
+	require.Equal(t, compounded, minted)
+}
+
 func TestZCNSmartContractMintNonce(t *testing.T) {
 	tt := []struct {
 		name         string
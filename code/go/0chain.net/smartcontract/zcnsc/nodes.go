@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"0chain.net/core/config"
+	"0chain.net/smartcontract/governance"
 	"0chain.net/smartcontract/stakepool/spenum"
 
 	"0chain.net/smartcontract/provider"
@@ -42,6 +43,9 @@ type ZCNSConfig struct {
 	Cost                map[string]int `json:"cost"`
 	MaxDelegates        int            `json:"max_delegates"`       // MaxDelegates per stake pool
 	HealthCheckPeriod   time.Duration  `json:"health_check_period"` // MaxDelegates per stake pool
+	UnbondingRounds     int64          `json:"unbonding_rounds"`    // unlocked delegate pools wait before the claim
+	// Governance of the zcnsc settings changes
+	Governance governance.Config `json:"governance"`
 }
 
 type GlobalNode struct {
@@ -51,6 +55,13 @@ type GlobalNode struct {
 
 func (gn *GlobalNode) UpdateConfig(cfg *config.StringMap) (err error) {
 	for key, value := range cfg.Fields {
+		if strings.HasPrefix(key, governance.Prefix) {
+			if err := gn.Governance.Set(strings.TrimPrefix(key, governance.Prefix), value); err != nil {
+				return err
+			}
+			continue
+		}
+
 		switch key {
 		case MinMintAmount:
 			amount, err := strconv.ParseFloat(value, 64)
@@ -145,6 +156,11 @@ func (gn *GlobalNode) UpdateConfig(cfg *config.StringMap) (err error) {
 				return fmt.Errorf("cannot convert key %s value %v to duration: %v", key, value, err)
 			}
 			gn.HealthCheckPeriod = v
+		case UnbondingRounds:
+			gn.UnbondingRounds, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("key %s, unable to convert %v to int64", key, value)
+			}
 		default:
 			return fmt.Errorf("key %s, unable to convert %v to currency.Coin", key, value)
 		}
@@ -208,9 +224,14 @@ func (gn *GlobalNode) Validate() error {
 		return common.NewError(Code, fmt.Sprintf("max delegate count (%v) is less than 0", gn.MaxDelegates))
 	case gn.HealthCheckPeriod <= 0:
 		return common.NewError(Code, fmt.Sprintf("health check period (%v) is less than 0", gn.HealthCheckPeriod))
+	case gn.UnbondingRounds < 0:
+		return common.NewError(Code, fmt.Sprintf("unbonding rounds (%v) is less than 0", gn.UnbondingRounds))
 	case gn.MinLockAmount == 0:
 		return common.NewError(Code, fmt.Sprintf("min lock amount (%v) is equal to 0", gn.MinLockAmount))
 	}
+	if err := gn.Governance.Validate(); err != nil {
+		return common.NewError(Code, fmt.Sprintf("invalid governance: %v", err))
+	}
 	return nil
 }
 
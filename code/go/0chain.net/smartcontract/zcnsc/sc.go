@@ -26,6 +26,9 @@ const (
 	BurnFunc                      = "burn"
 	AddToDelegatePoolFunc         = "add-to-delegate-pool"
 	DeleteFromDelegatePoolFunc    = "delete-from-delegate-pool"
+	ClaimUnbondedDelegatePoolFunc = "claim-unbonded-delegate-pool"
+	RedelegatePoolFunc            = "redelegate-pool"
+	AutoCompoundDelegatePoolFunc  = "auto-compound-delegate-pool"
 	UpdateAuthorizerStakePoolFunc = "update-authorizer-stake-pool"
 	CollectRewardsFunc            = "collect-rewards"
 )
@@ -66,6 +69,13 @@ func (zcn *ZCNSmartContract) InitSC() {
 	zcn.smartContractFunctions[CollectRewardsFunc] = zcn.CollectRewards
 	zcn.smartContractFunctions[AddToDelegatePoolFunc] = zcn.AddToDelegatePool           // stakepool lock
 	zcn.smartContractFunctions[DeleteFromDelegatePoolFunc] = zcn.DeleteFromDelegatePool // stakepool unlock
+	zcn.smartContractFunctions[ClaimUnbondedDelegatePoolFunc] = zcn.ClaimUnbondedDelegatePool
+	zcn.smartContractFunctions[RedelegatePoolFunc] = zcn.RedelegatePool
+	zcn.smartContractFunctions[AutoCompoundDelegatePoolFunc] = zcn.AutoCompoundDelegatePool
+	// Governance
+	zcn.smartContractFunctions[GovernanceProposeFunc] = zcn.GovernancePropose
+	zcn.smartContractFunctions[GovernanceVoteFunc] = zcn.GovernanceVote
+	zcn.smartContractFunctions[GovernanceExecuteFunc] = zcn.GovernanceExecute
 }
 
 // SetSC ...
@@ -89,6 +99,20 @@ func (zcn *ZCNSmartContract) setSC(sc *smartcontractinterface.SmartContract, _ s
 		metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", zcn.ID, AddToDelegatePoolFunc), nil)
 	zcn.SmartContractExecutionStats[DeleteFromDelegatePoolFunc] =
 		metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", zcn.ID, DeleteFromDelegatePoolFunc), nil)
+	zcn.SmartContractExecutionStats[ClaimUnbondedDelegatePoolFunc] =
+		metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", zcn.ID, ClaimUnbondedDelegatePoolFunc), nil)
+	zcn.SmartContractExecutionStats[RedelegatePoolFunc] =
+		metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", zcn.ID, RedelegatePoolFunc), nil)
+	zcn.SmartContractExecutionStats[AutoCompoundDelegatePoolFunc] =
+		metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", zcn.ID, AutoCompoundDelegatePoolFunc), nil)
+
+	// Governance
+	zcn.SmartContractExecutionStats[GovernanceProposeFunc] =
+		metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", zcn.ID, GovernanceProposeFunc), nil)
+	zcn.SmartContractExecutionStats[GovernanceVoteFunc] =
+		metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", zcn.ID, GovernanceVoteFunc), nil)
+	zcn.SmartContractExecutionStats[GovernanceExecuteFunc] =
+		metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", zcn.ID, GovernanceExecuteFunc), nil)
 }
 
 // GetName ...
@@ -11,6 +11,7 @@ import (
 	"0chain.net/core/datastore"
 	"0chain.net/smartcontract/stakepool"
 	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/util"
 )
 
@@ -71,13 +72,7 @@ func (sp *StakePool) empty(sscID, clientID string, balances cstate.StateContextI
 
 // getStakePool of given authorizer
 func (zcn *ZCNSmartContract) getStakePool(authorizerID datastore.Key, balances cstate.StateContextI) (sp *StakePool, err error) {
-	sp = NewStakePool()
-	err = balances.GetTrieNode(stakepool.StakePoolKey(spenum.Authorizer, authorizerID), sp)
-	if err != nil {
-		return nil, err
-	}
-
-	return sp, nil
+	return zcn.getStakePoolForAdapter(spenum.Authorizer, authorizerID, balances)
 }
 
 func (zcn *ZCNSmartContract) getStakePoolForAdapter(_ spenum.Provider, providerID datastore.Key, balances cstate.CommonStateContextI) (sp *StakePool, err error) {
@@ -87,6 +82,9 @@ func (zcn *ZCNSmartContract) getStakePoolForAdapter(_ spenum.Provider, providerI
 		return nil, err
 	}
 
+	// the authorizer stake is held and rewarded by the zcnsc, the stake pools
+	// created before were given the storage sc minter
+	sp.Minter = cstate.MinterZcn
 	return sp, nil
 }
 
@@ -123,7 +121,7 @@ func (zcn *ZCNSmartContract) getOrUpdateStakePool(gn *GlobalNode,
 			return nil, fmt.Errorf("unexpected error: %v", err)
 		}
 		sp = NewStakePool()
-		sp.Minter = cstate.MinterStorage
+		sp.Minter = cstate.MinterZcn
 		sp.Settings.DelegateWallet = settings.DelegateWallet
 		changed = true
 	}
@@ -160,6 +158,16 @@ func validateStakePoolSettings(poolSettings stakepool.Settings) error {
 	return nil
 }
 
+func init() {
+	stakepool.RegisterMaxStake(spenum.Authorizer, func(balances cstate.CommonStateContextI) (currency.Coin, error) {
+		gn, err := GetGlobalNode(balances)
+		if err != nil {
+			return 0, err
+		}
+		return gn.MaxStakeAmount, nil
+	})
+}
+
 func (zcn *ZCNSmartContract) AddToDelegatePool(t *transaction.Transaction,
 	input []byte, balances cstate.StateContextI) (
 	resp string, err error) {
@@ -180,5 +188,48 @@ func (zcn *ZCNSmartContract) DeleteFromDelegatePool(
 	t *transaction.Transaction, inputData []byte,
 	balances cstate.StateContextI) (resp string, err error) {
 
-	return stakepool.StakePoolUnlock(t, inputData, balances, zcn.getStakePoolAdapter)
+	gn, err := GetGlobalNode(balances)
+	if err != nil {
+		return "", common.NewErrorf("delete-from-delegate-pool-failed",
+			"failed to get global node error: %v", err)
+	}
+
+	return stakepool.StakePoolUnlock(t, inputData, balances, gn.UnbondingRounds, zcn.getStakePoolAdapter)
+}
+
+// ClaimUnbondedDelegatePool pays out the unbonding delegate pool after its
+// release round
+func (zcn *ZCNSmartContract) ClaimUnbondedDelegatePool(
+	t *transaction.Transaction, inputData []byte,
+	balances cstate.StateContextI) (resp string, err error) {
+
+	return stakepool.StakePoolClaimUnbonded(t, inputData, balances, zcn.getStakePoolAdapter)
+}
+
+// AutoCompoundDelegatePool turns the auto compounding of the rewards of the
+// delegate pool on or off
+func (zcn *ZCNSmartContract) AutoCompoundDelegatePool(
+	t *transaction.Transaction, inputData []byte,
+	balances cstate.StateContextI) (resp string, err error) {
+
+	return stakepool.StakePoolAutoCompound(t, inputData, balances, zcn.getStakePoolAdapter)
+}
+
+// RedelegatePool moves the delegate pool to the stake pool of another
+// authorizer without unlocking it
+func (zcn *ZCNSmartContract) RedelegatePool(
+	t *transaction.Transaction, inputData []byte,
+	balances cstate.StateContextI) (resp string, err error) {
+
+	gn, err := GetGlobalNode(balances)
+	if err != nil {
+		return "", common.NewErrorf("redelegate-pool-failed",
+			"failed to get global node error: %v", err)
+	}
+
+	return stakepool.StakePoolRedelegate(t, inputData, balances, gn.UnbondingRounds, stakepool.ValidationSettings{
+		MinStake:        gn.MinStakeAmount,
+		MaxStake:        gn.MaxStakeAmount,
+		MaxNumDelegates: gn.MaxDelegates,
+	}, zcn.getStakePoolAdapter)
 }